@@ -19,11 +19,48 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	TodoService_CreateTodo_FullMethodName      = "/todo.v1.TodoService/CreateTodo"
-	TodoService_ListTodos_FullMethodName       = "/todo.v1.TodoService/ListTodos"
-	TodoService_DeleteTodo_FullMethodName      = "/todo.v1.TodoService/DeleteTodo"
-	TodoService_UpdateTodo_FullMethodName      = "/todo.v1.TodoService/UpdateTodo"
-	TodoService_ListTodosStream_FullMethodName = "/todo.v1.TodoService/ListTodosStream"
+	TodoService_CreateTodo_FullMethodName              = "/todo.v1.TodoService/CreateTodo"
+	TodoService_GetTodo_FullMethodName                 = "/todo.v1.TodoService/GetTodo"
+	TodoService_ListTodos_FullMethodName               = "/todo.v1.TodoService/ListTodos"
+	TodoService_DeleteTodo_FullMethodName              = "/todo.v1.TodoService/DeleteTodo"
+	TodoService_UpdateTodo_FullMethodName              = "/todo.v1.TodoService/UpdateTodo"
+	TodoService_ListTodosStream_FullMethodName         = "/todo.v1.TodoService/ListTodosStream"
+	TodoService_ListDeletedTodos_FullMethodName        = "/todo.v1.TodoService/ListDeletedTodos"
+	TodoService_RestoreTodo_FullMethodName             = "/todo.v1.TodoService/RestoreTodo"
+	TodoService_PurgeTodo_FullMethodName               = "/todo.v1.TodoService/PurgeTodo"
+	TodoService_ListLabels_FullMethodName              = "/todo.v1.TodoService/ListLabels"
+	TodoService_CreateLabel_FullMethodName             = "/todo.v1.TodoService/CreateLabel"
+	TodoService_UpdateLabel_FullMethodName             = "/todo.v1.TodoService/UpdateLabel"
+	TodoService_DeleteLabel_FullMethodName             = "/todo.v1.TodoService/DeleteLabel"
+	TodoService_AttachLabels_FullMethodName            = "/todo.v1.TodoService/AttachLabels"
+	TodoService_DetachLabels_FullMethodName            = "/todo.v1.TodoService/DetachLabels"
+	TodoService_MoveTodo_FullMethodName                = "/todo.v1.TodoService/MoveTodo"
+	TodoService_AddChecklistItem_FullMethodName        = "/todo.v1.TodoService/AddChecklistItem"
+	TodoService_ToggleChecklistItem_FullMethodName     = "/todo.v1.TodoService/ToggleChecklistItem"
+	TodoService_RemoveChecklistItem_FullMethodName     = "/todo.v1.TodoService/RemoveChecklistItem"
+	TodoService_ReorderChecklistItems_FullMethodName   = "/todo.v1.TodoService/ReorderChecklistItems"
+	TodoService_ListTodoHistory_FullMethodName         = "/todo.v1.TodoService/ListTodoHistory"
+	TodoService_BatchCreateTodos_FullMethodName        = "/todo.v1.TodoService/BatchCreateTodos"
+	TodoService_BatchUpdateTodos_FullMethodName        = "/todo.v1.TodoService/BatchUpdateTodos"
+	TodoService_BatchDeleteTodos_FullMethodName        = "/todo.v1.TodoService/BatchDeleteTodos"
+	TodoService_WatchTodos_FullMethodName              = "/todo.v1.TodoService/WatchTodos"
+	TodoService_SearchTodos_FullMethodName             = "/todo.v1.TodoService/SearchTodos"
+	TodoService_ExportTodos_FullMethodName             = "/todo.v1.TodoService/ExportTodos"
+	TodoService_ImportTodos_FullMethodName             = "/todo.v1.TodoService/ImportTodos"
+	TodoService_IssueCalendarFeedToken_FullMethodName  = "/todo.v1.TodoService/IssueCalendarFeedToken"
+	TodoService_RevokeCalendarFeedToken_FullMethodName = "/todo.v1.TodoService/RevokeCalendarFeedToken"
+	TodoService_GetCalendarFeed_FullMethodName         = "/todo.v1.TodoService/GetCalendarFeed"
+	TodoService_AddCollaborator_FullMethodName         = "/todo.v1.TodoService/AddCollaborator"
+	TodoService_ChangeCollaboratorRole_FullMethodName  = "/todo.v1.TodoService/ChangeCollaboratorRole"
+	TodoService_RemoveCollaborator_FullMethodName      = "/todo.v1.TodoService/RemoveCollaborator"
+	TodoService_ListCollaborators_FullMethodName       = "/todo.v1.TodoService/ListCollaborators"
+	TodoService_ListSharedWithMe_FullMethodName        = "/todo.v1.TodoService/ListSharedWithMe"
+	TodoService_AssignTodo_FullMethodName              = "/todo.v1.TodoService/AssignTodo"
+	TodoService_UnassignTodo_FullMethodName            = "/todo.v1.TodoService/UnassignTodo"
+	TodoService_AddComment_FullMethodName              = "/todo.v1.TodoService/AddComment"
+	TodoService_ListComments_FullMethodName            = "/todo.v1.TodoService/ListComments"
+	TodoService_EditComment_FullMethodName             = "/todo.v1.TodoService/EditComment"
+	TodoService_DeleteComment_FullMethodName           = "/todo.v1.TodoService/DeleteComment"
 )
 
 // TodoServiceClient is the client API for TodoService service.
@@ -32,13 +69,120 @@ const (
 type TodoServiceClient interface {
 	// POST /v1/todos
 	CreateTodo(ctx context.Context, in *CreateTodoRequest, opts ...grpc.CallOption) (*Todo, error)
-	// GET /v1/todos
+	// GET /v1/todos/{id}
+	GetTodo(ctx context.Context, in *GetTodoRequest, opts ...grpc.CallOption) (*Todo, error)
+	// GET /v1/todos?done=false&title_contains=foo&order_by=created_at%20desc
 	ListTodos(ctx context.Context, in *ListTodosRequest, opts ...grpc.CallOption) (*ListTodosResponse, error)
 	// DELETE /v1/todos/{id}
+	// 論理削除（ゴミ箱へ移す）。保持期間を過ぎるとサーバが物理削除する。
 	DeleteTodo(ctx context.Context, in *DeleteTodoRequest, opts ...grpc.CallOption) (*DeleteTodoResponse, error)
 	// PATCH /v1/todos/{id}
 	UpdateTodo(ctx context.Context, in *UpdateTodoRequest, opts ...grpc.CallOption) (*Todo, error)
 	ListTodosStream(ctx context.Context, in *ListTodosRequest, opts ...grpc.CallOption) (TodoService_ListTodosStreamClient, error)
+	// GET /v1/trash/todos
+	// 絞り込み・並び替え・ページングは ListTodos と同じ。
+	ListDeletedTodos(ctx context.Context, in *ListTodosRequest, opts ...grpc.CallOption) (*ListTodosResponse, error)
+	// POST /v1/trash/todos/{id}:restore
+	RestoreTodo(ctx context.Context, in *RestoreTodoRequest, opts ...grpc.CallOption) (*Todo, error)
+	// DELETE /v1/trash/todos/{id}
+	// ゴミ箱にある Todo だけを即時に物理削除する。
+	PurgeTodo(ctx context.Context, in *PurgeTodoRequest, opts ...grpc.CallOption) (*PurgeTodoResponse, error)
+	// GET /v1/labels
+	ListLabels(ctx context.Context, in *ListLabelsRequest, opts ...grpc.CallOption) (*ListLabelsResponse, error)
+	// POST /v1/labels
+	CreateLabel(ctx context.Context, in *CreateLabelRequest, opts ...grpc.CallOption) (*Label, error)
+	// PATCH /v1/labels/{id}
+	UpdateLabel(ctx context.Context, in *UpdateLabelRequest, opts ...grpc.CallOption) (*Label, error)
+	// DELETE /v1/labels/{id}
+	// 付いている Todo からも外れる。
+	DeleteLabel(ctx context.Context, in *DeleteLabelRequest, opts ...grpc.CallOption) (*DeleteLabelResponse, error)
+	// POST /v1/todos/{id}:attachLabels
+	// 既に付いているラベルは無視する。Todo の version が進む。
+	AttachLabels(ctx context.Context, in *ChangeTodoLabelsRequest, opts ...grpc.CallOption) (*Todo, error)
+	// POST /v1/todos/{id}:detachLabels
+	// 付いていないラベルは無視する。Todo の version が進む。
+	DetachLabels(ctx context.Context, in *ChangeTodoLabelsRequest, opts ...grpc.CallOption) (*Todo, error)
+	// POST /v1/todos/{id}:move
+	// リストの移動と、before_id / after_id による並び替え。Todo の version が進む
+	// （並び替えのために他の Todo の position が振り直されても、それらの version は変わらない）。
+	MoveTodo(ctx context.Context, in *MoveTodoRequest, opts ...grpc.CallOption) (*Todo, error)
+	// POST /v1/todos/{todo_id}/items
+	// 末尾に未完了で追加する。完了済みの Todo は未完了に戻る。
+	AddChecklistItem(ctx context.Context, in *AddChecklistItemRequest, opts ...grpc.CallOption) (*Todo, error)
+	// POST /v1/todos/{todo_id}/items/{id}:toggle
+	ToggleChecklistItem(ctx context.Context, in *ToggleChecklistItemRequest, opts ...grpc.CallOption) (*Todo, error)
+	// DELETE /v1/todos/{todo_id}/items/{id}
+	RemoveChecklistItem(ctx context.Context, in *RemoveChecklistItemRequest, opts ...grpc.CallOption) (*Todo, error)
+	// POST /v1/todos/{todo_id}/items:reorder
+	ReorderChecklistItems(ctx context.Context, in *ReorderChecklistItemsRequest, opts ...grpc.CallOption) (*Todo, error)
+	// GET /v1/todos/{todo_id}/history
+	// ゴミ箱の中・物理削除済みの Todo の履歴も見られる。
+	ListTodoHistory(ctx context.Context, in *ListTodoHistoryRequest, opts ...grpc.CallOption) (*ListTodoHistoryResponse, error)
+	// POST /v1/todos:batchCreate
+	BatchCreateTodos(ctx context.Context, in *BatchCreateTodosRequest, opts ...grpc.CallOption) (*BatchCreateTodosResponse, error)
+	// POST /v1/todos:batchUpdate
+	BatchUpdateTodos(ctx context.Context, in *BatchUpdateTodosRequest, opts ...grpc.CallOption) (*BatchUpdateTodosResponse, error)
+	// POST /v1/todos:batchDelete
+	// 1 件ずつの DeleteTodo と同じく、ゴミ箱へ移すだけ。
+	BatchDeleteTodos(ctx context.Context, in *BatchDeleteTodosRequest, opts ...grpc.CallOption) (*BatchDeleteTodosResponse, error)
+	// GET /v1/todos:watch（改行区切りの JSON で流し続ける）
+	// 自分の Todo の作成・更新・削除を、コミットされた順に流し続ける（クライアントが切るまで終わらない）。
+	// スナップショットと変更が重なることがあるので、同じ Todo は version の大きい方を採ること。
+	// 読むのが遅くて溜まりすぎると RESOURCE_EXHAUSTED で切られるので、最後の resume_token で再接続すること。
+	// 変更はサーバのプロセス内で配信するので、複数レプリカでは別のレプリカで行った変更は届かない。
+	WatchTodos(ctx context.Context, in *WatchTodosRequest, opts ...grpc.CallOption) (TodoService_WatchTodosClient, error)
+	// GET /v1/todos:search?query=...
+	// タイトルとメモを全文検索する（ゴミ箱の中は含めない）。
+	SearchTodos(ctx context.Context, in *SearchTodosRequest, opts ...grpc.CallOption) (*SearchTodosResponse, error)
+	// GET /v1/todos:export?format=...
+	// 自分の Todo（ゴミ箱の中は除く）を並び順で書き出す。ファイルを分けて流す。
+	ExportTodos(ctx context.Context, in *ExportTodosRequest, opts ...grpc.CallOption) (TodoService_ExportTodosClient, error)
+	// POST /v1/todos:import（改行区切りの JSON で ImportTodosRequest を送る）
+	// 1 行 1 件の Todo をインボックスの末尾に作成する（最大 10000 件）。検証に通らなかった行は飛ばして errors に返す。
+	// 作成は 100 件ずつ別のトランザクションで行うので、途中で失敗するとそれまでに作成した分は残る
+	// （エラーのメッセージに作成した件数を含める）。idempotency-key には対応しない。
+	ImportTodos(ctx context.Context, opts ...grpc.CallOption) (TodoService_ImportTodosClient, error)
+	// POST /v1/calendarFeedToken
+	// フィードトークンを発行する（発行済みなら置き換え、前のトークンは使えなくなる）。
+	IssueCalendarFeedToken(ctx context.Context, in *IssueCalendarFeedTokenRequest, opts ...grpc.CallOption) (*CalendarFeedToken, error)
+	// DELETE /v1/calendarFeedToken
+	// フィードトークンを無効にする。発行していなければ NOT_FOUND。
+	RevokeCalendarFeedToken(ctx context.Context, in *RevokeCalendarFeedTokenRequest, opts ...grpc.CallOption) (*RevokeCalendarFeedTokenResponse, error)
+	// フィードトークンの持ち主の、期限のある Todo を iCalendar で流す（ExportTodos の TODO_FORMAT_ICALENDAR と同じ中身）。
+	// Bearer トークンは要らない（feed_token で認証する）。HTTP ゲートウェイが GET /calendar/<feed_token>.ics で呼ぶ。
+	GetCalendarFeed(ctx context.Context, in *GetCalendarFeedRequest, opts ...grpc.CallOption) (TodoService_GetCalendarFeedClient, error)
+	// POST /v1/collaborators
+	// target を user_id に共有する。所有者と OWNER で共有された人だけができる。既に共有していれば ALREADY_EXISTS。
+	AddCollaborator(ctx context.Context, in *AddCollaboratorRequest, opts ...grpc.CallOption) (*Collaborator, error)
+	// PATCH /v1/collaborators
+	// 共有した人の役割を変える。所有者と OWNER で共有された人だけができる。
+	ChangeCollaboratorRole(ctx context.Context, in *ChangeCollaboratorRoleRequest, opts ...grpc.CallOption) (*Collaborator, error)
+	// DELETE /v1/collaborators?target.todo_id=...&user_id=...
+	// 共有をやめる。所有者と OWNER で共有された人のほか、共有された本人も自分の分はやめられる。
+	RemoveCollaborator(ctx context.Context, in *RemoveCollaboratorRequest, opts ...grpc.CallOption) (*RemoveCollaboratorResponse, error)
+	// GET /v1/collaborators?target.list_id=...
+	// target の共有の一覧。target が見える人なら誰でも見られる。
+	ListCollaborators(ctx context.Context, in *ListCollaboratorsRequest, opts ...grpc.CallOption) (*ListCollaboratorsResponse, error)
+	// GET /v1/sharedWithMe
+	ListSharedWithMe(ctx context.Context, in *ListSharedWithMeRequest, opts ...grpc.CallOption) (*ListSharedWithMeResponse, error)
+	// POST /v1/todos/{id}:assign
+	// 担当者を割り当てる（割り当て済みなら置き換える）。EDITOR 以上で共有された人もできる。
+	// 担当者にできるのは Todo の所有者と、Todo かそのリストを共有された人だけで、それ以外（存在しないユーザーを含む）は
+	// INVALID_ARGUMENT（共有していない人がいるかどうかは返さない）。自分が担当の Todo は ListTodos の assigned_to_me で取る。
+	AssignTodo(ctx context.Context, in *AssignTodoRequest, opts ...grpc.CallOption) (*Todo, error)
+	// POST /v1/todos/{id}:unassign
+	// 担当者を外す（未割り当てでもエラーにしない）。
+	UnassignTodo(ctx context.Context, in *UnassignTodoRequest, opts ...grpc.CallOption) (*Todo, error)
+	// POST /v1/todos/{todo_id}/comments
+	AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*Comment, error)
+	// GET /v1/todos/{todo_id}/comments
+	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error)
+	// PATCH /v1/todos/{todo_id}/comments/{id}
+	// 本文を変える。書いた本人だけができる（他人のコメントと、VIEWER に下げられた本人は PERMISSION_DENIED）。
+	EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*Comment, error)
+	// DELETE /v1/todos/{todo_id}/comments/{id}
+	// 書いた本人（VIEWER に下げられていても）のほか、Todo の所有者と OWNER で共有された人も消せる。
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
 }
 
 type todoServiceClient struct {
@@ -58,6 +202,15 @@ func (c *todoServiceClient) CreateTodo(ctx context.Context, in *CreateTodoReques
 	return out, nil
 }
 
+func (c *todoServiceClient) GetTodo(ctx context.Context, in *GetTodoRequest, opts ...grpc.CallOption) (*Todo, error) {
+	out := new(Todo)
+	err := c.cc.Invoke(ctx, TodoService_GetTodo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *todoServiceClient) ListTodos(ctx context.Context, in *ListTodosRequest, opts ...grpc.CallOption) (*ListTodosResponse, error) {
 	out := new(ListTodosResponse)
 	err := c.cc.Invoke(ctx, TodoService_ListTodos_FullMethodName, in, out, opts...)
@@ -67,227 +220,2028 @@ func (c *todoServiceClient) ListTodos(ctx context.Context, in *ListTodosRequest,
 	return out, nil
 }
 
-func (c *todoServiceClient) DeleteTodo(ctx context.Context, in *DeleteTodoRequest, opts ...grpc.CallOption) (*DeleteTodoResponse, error) {
-	out := new(DeleteTodoResponse)
-	err := c.cc.Invoke(ctx, TodoService_DeleteTodo_FullMethodName, in, out, opts...)
+func (c *todoServiceClient) DeleteTodo(ctx context.Context, in *DeleteTodoRequest, opts ...grpc.CallOption) (*DeleteTodoResponse, error) {
+	out := new(DeleteTodoResponse)
+	err := c.cc.Invoke(ctx, TodoService_DeleteTodo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) UpdateTodo(ctx context.Context, in *UpdateTodoRequest, opts ...grpc.CallOption) (*Todo, error) {
+	out := new(Todo)
+	err := c.cc.Invoke(ctx, TodoService_UpdateTodo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ListTodosStream(ctx context.Context, in *ListTodosRequest, opts ...grpc.CallOption) (TodoService_ListTodosStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TodoService_ServiceDesc.Streams[0], TodoService_ListTodosStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &todoServiceListTodosStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TodoService_ListTodosStreamClient interface {
+	Recv() (*Todo, error)
+	grpc.ClientStream
+}
+
+type todoServiceListTodosStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *todoServiceListTodosStreamClient) Recv() (*Todo, error) {
+	m := new(Todo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *todoServiceClient) ListDeletedTodos(ctx context.Context, in *ListTodosRequest, opts ...grpc.CallOption) (*ListTodosResponse, error) {
+	out := new(ListTodosResponse)
+	err := c.cc.Invoke(ctx, TodoService_ListDeletedTodos_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) RestoreTodo(ctx context.Context, in *RestoreTodoRequest, opts ...grpc.CallOption) (*Todo, error) {
+	out := new(Todo)
+	err := c.cc.Invoke(ctx, TodoService_RestoreTodo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) PurgeTodo(ctx context.Context, in *PurgeTodoRequest, opts ...grpc.CallOption) (*PurgeTodoResponse, error) {
+	out := new(PurgeTodoResponse)
+	err := c.cc.Invoke(ctx, TodoService_PurgeTodo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ListLabels(ctx context.Context, in *ListLabelsRequest, opts ...grpc.CallOption) (*ListLabelsResponse, error) {
+	out := new(ListLabelsResponse)
+	err := c.cc.Invoke(ctx, TodoService_ListLabels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) CreateLabel(ctx context.Context, in *CreateLabelRequest, opts ...grpc.CallOption) (*Label, error) {
+	out := new(Label)
+	err := c.cc.Invoke(ctx, TodoService_CreateLabel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) UpdateLabel(ctx context.Context, in *UpdateLabelRequest, opts ...grpc.CallOption) (*Label, error) {
+	out := new(Label)
+	err := c.cc.Invoke(ctx, TodoService_UpdateLabel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) DeleteLabel(ctx context.Context, in *DeleteLabelRequest, opts ...grpc.CallOption) (*DeleteLabelResponse, error) {
+	out := new(DeleteLabelResponse)
+	err := c.cc.Invoke(ctx, TodoService_DeleteLabel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) AttachLabels(ctx context.Context, in *ChangeTodoLabelsRequest, opts ...grpc.CallOption) (*Todo, error) {
+	out := new(Todo)
+	err := c.cc.Invoke(ctx, TodoService_AttachLabels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) DetachLabels(ctx context.Context, in *ChangeTodoLabelsRequest, opts ...grpc.CallOption) (*Todo, error) {
+	out := new(Todo)
+	err := c.cc.Invoke(ctx, TodoService_DetachLabels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) MoveTodo(ctx context.Context, in *MoveTodoRequest, opts ...grpc.CallOption) (*Todo, error) {
+	out := new(Todo)
+	err := c.cc.Invoke(ctx, TodoService_MoveTodo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) AddChecklistItem(ctx context.Context, in *AddChecklistItemRequest, opts ...grpc.CallOption) (*Todo, error) {
+	out := new(Todo)
+	err := c.cc.Invoke(ctx, TodoService_AddChecklistItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ToggleChecklistItem(ctx context.Context, in *ToggleChecklistItemRequest, opts ...grpc.CallOption) (*Todo, error) {
+	out := new(Todo)
+	err := c.cc.Invoke(ctx, TodoService_ToggleChecklistItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) RemoveChecklistItem(ctx context.Context, in *RemoveChecklistItemRequest, opts ...grpc.CallOption) (*Todo, error) {
+	out := new(Todo)
+	err := c.cc.Invoke(ctx, TodoService_RemoveChecklistItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ReorderChecklistItems(ctx context.Context, in *ReorderChecklistItemsRequest, opts ...grpc.CallOption) (*Todo, error) {
+	out := new(Todo)
+	err := c.cc.Invoke(ctx, TodoService_ReorderChecklistItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ListTodoHistory(ctx context.Context, in *ListTodoHistoryRequest, opts ...grpc.CallOption) (*ListTodoHistoryResponse, error) {
+	out := new(ListTodoHistoryResponse)
+	err := c.cc.Invoke(ctx, TodoService_ListTodoHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) BatchCreateTodos(ctx context.Context, in *BatchCreateTodosRequest, opts ...grpc.CallOption) (*BatchCreateTodosResponse, error) {
+	out := new(BatchCreateTodosResponse)
+	err := c.cc.Invoke(ctx, TodoService_BatchCreateTodos_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) BatchUpdateTodos(ctx context.Context, in *BatchUpdateTodosRequest, opts ...grpc.CallOption) (*BatchUpdateTodosResponse, error) {
+	out := new(BatchUpdateTodosResponse)
+	err := c.cc.Invoke(ctx, TodoService_BatchUpdateTodos_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) BatchDeleteTodos(ctx context.Context, in *BatchDeleteTodosRequest, opts ...grpc.CallOption) (*BatchDeleteTodosResponse, error) {
+	out := new(BatchDeleteTodosResponse)
+	err := c.cc.Invoke(ctx, TodoService_BatchDeleteTodos_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) WatchTodos(ctx context.Context, in *WatchTodosRequest, opts ...grpc.CallOption) (TodoService_WatchTodosClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TodoService_ServiceDesc.Streams[1], TodoService_WatchTodos_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &todoServiceWatchTodosClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TodoService_WatchTodosClient interface {
+	Recv() (*TodoEvent, error)
+	grpc.ClientStream
+}
+
+type todoServiceWatchTodosClient struct {
+	grpc.ClientStream
+}
+
+func (x *todoServiceWatchTodosClient) Recv() (*TodoEvent, error) {
+	m := new(TodoEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *todoServiceClient) SearchTodos(ctx context.Context, in *SearchTodosRequest, opts ...grpc.CallOption) (*SearchTodosResponse, error) {
+	out := new(SearchTodosResponse)
+	err := c.cc.Invoke(ctx, TodoService_SearchTodos_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ExportTodos(ctx context.Context, in *ExportTodosRequest, opts ...grpc.CallOption) (TodoService_ExportTodosClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TodoService_ServiceDesc.Streams[2], TodoService_ExportTodos_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &todoServiceExportTodosClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TodoService_ExportTodosClient interface {
+	Recv() (*ExportTodosResponse, error)
+	grpc.ClientStream
+}
+
+type todoServiceExportTodosClient struct {
+	grpc.ClientStream
+}
+
+func (x *todoServiceExportTodosClient) Recv() (*ExportTodosResponse, error) {
+	m := new(ExportTodosResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *todoServiceClient) ImportTodos(ctx context.Context, opts ...grpc.CallOption) (TodoService_ImportTodosClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TodoService_ServiceDesc.Streams[3], TodoService_ImportTodos_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &todoServiceImportTodosClient{stream}
+	return x, nil
+}
+
+type TodoService_ImportTodosClient interface {
+	Send(*ImportTodosRequest) error
+	CloseAndRecv() (*ImportTodosResponse, error)
+	grpc.ClientStream
+}
+
+type todoServiceImportTodosClient struct {
+	grpc.ClientStream
+}
+
+func (x *todoServiceImportTodosClient) Send(m *ImportTodosRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *todoServiceImportTodosClient) CloseAndRecv() (*ImportTodosResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportTodosResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *todoServiceClient) IssueCalendarFeedToken(ctx context.Context, in *IssueCalendarFeedTokenRequest, opts ...grpc.CallOption) (*CalendarFeedToken, error) {
+	out := new(CalendarFeedToken)
+	err := c.cc.Invoke(ctx, TodoService_IssueCalendarFeedToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) RevokeCalendarFeedToken(ctx context.Context, in *RevokeCalendarFeedTokenRequest, opts ...grpc.CallOption) (*RevokeCalendarFeedTokenResponse, error) {
+	out := new(RevokeCalendarFeedTokenResponse)
+	err := c.cc.Invoke(ctx, TodoService_RevokeCalendarFeedToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) GetCalendarFeed(ctx context.Context, in *GetCalendarFeedRequest, opts ...grpc.CallOption) (TodoService_GetCalendarFeedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TodoService_ServiceDesc.Streams[4], TodoService_GetCalendarFeed_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &todoServiceGetCalendarFeedClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TodoService_GetCalendarFeedClient interface {
+	Recv() (*ExportTodosResponse, error)
+	grpc.ClientStream
+}
+
+type todoServiceGetCalendarFeedClient struct {
+	grpc.ClientStream
+}
+
+func (x *todoServiceGetCalendarFeedClient) Recv() (*ExportTodosResponse, error) {
+	m := new(ExportTodosResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *todoServiceClient) AddCollaborator(ctx context.Context, in *AddCollaboratorRequest, opts ...grpc.CallOption) (*Collaborator, error) {
+	out := new(Collaborator)
+	err := c.cc.Invoke(ctx, TodoService_AddCollaborator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ChangeCollaboratorRole(ctx context.Context, in *ChangeCollaboratorRoleRequest, opts ...grpc.CallOption) (*Collaborator, error) {
+	out := new(Collaborator)
+	err := c.cc.Invoke(ctx, TodoService_ChangeCollaboratorRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) RemoveCollaborator(ctx context.Context, in *RemoveCollaboratorRequest, opts ...grpc.CallOption) (*RemoveCollaboratorResponse, error) {
+	out := new(RemoveCollaboratorResponse)
+	err := c.cc.Invoke(ctx, TodoService_RemoveCollaborator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ListCollaborators(ctx context.Context, in *ListCollaboratorsRequest, opts ...grpc.CallOption) (*ListCollaboratorsResponse, error) {
+	out := new(ListCollaboratorsResponse)
+	err := c.cc.Invoke(ctx, TodoService_ListCollaborators_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ListSharedWithMe(ctx context.Context, in *ListSharedWithMeRequest, opts ...grpc.CallOption) (*ListSharedWithMeResponse, error) {
+	out := new(ListSharedWithMeResponse)
+	err := c.cc.Invoke(ctx, TodoService_ListSharedWithMe_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) AssignTodo(ctx context.Context, in *AssignTodoRequest, opts ...grpc.CallOption) (*Todo, error) {
+	out := new(Todo)
+	err := c.cc.Invoke(ctx, TodoService_AssignTodo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) UnassignTodo(ctx context.Context, in *UnassignTodoRequest, opts ...grpc.CallOption) (*Todo, error) {
+	out := new(Todo)
+	err := c.cc.Invoke(ctx, TodoService_UnassignTodo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, TodoService_AddComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error) {
+	out := new(ListCommentsResponse)
+	err := c.cc.Invoke(ctx, TodoService_ListComments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, TodoService_EditComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
+	out := new(DeleteCommentResponse)
+	err := c.cc.Invoke(ctx, TodoService_DeleteComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TodoServiceServer is the server API for TodoService service.
+// All implementations must embed UnimplementedTodoServiceServer
+// for forward compatibility
+type TodoServiceServer interface {
+	// POST /v1/todos
+	CreateTodo(context.Context, *CreateTodoRequest) (*Todo, error)
+	// GET /v1/todos/{id}
+	GetTodo(context.Context, *GetTodoRequest) (*Todo, error)
+	// GET /v1/todos?done=false&title_contains=foo&order_by=created_at%20desc
+	ListTodos(context.Context, *ListTodosRequest) (*ListTodosResponse, error)
+	// DELETE /v1/todos/{id}
+	// 論理削除（ゴミ箱へ移す）。保持期間を過ぎるとサーバが物理削除する。
+	DeleteTodo(context.Context, *DeleteTodoRequest) (*DeleteTodoResponse, error)
+	// PATCH /v1/todos/{id}
+	UpdateTodo(context.Context, *UpdateTodoRequest) (*Todo, error)
+	ListTodosStream(*ListTodosRequest, TodoService_ListTodosStreamServer) error
+	// GET /v1/trash/todos
+	// 絞り込み・並び替え・ページングは ListTodos と同じ。
+	ListDeletedTodos(context.Context, *ListTodosRequest) (*ListTodosResponse, error)
+	// POST /v1/trash/todos/{id}:restore
+	RestoreTodo(context.Context, *RestoreTodoRequest) (*Todo, error)
+	// DELETE /v1/trash/todos/{id}
+	// ゴミ箱にある Todo だけを即時に物理削除する。
+	PurgeTodo(context.Context, *PurgeTodoRequest) (*PurgeTodoResponse, error)
+	// GET /v1/labels
+	ListLabels(context.Context, *ListLabelsRequest) (*ListLabelsResponse, error)
+	// POST /v1/labels
+	CreateLabel(context.Context, *CreateLabelRequest) (*Label, error)
+	// PATCH /v1/labels/{id}
+	UpdateLabel(context.Context, *UpdateLabelRequest) (*Label, error)
+	// DELETE /v1/labels/{id}
+	// 付いている Todo からも外れる。
+	DeleteLabel(context.Context, *DeleteLabelRequest) (*DeleteLabelResponse, error)
+	// POST /v1/todos/{id}:attachLabels
+	// 既に付いているラベルは無視する。Todo の version が進む。
+	AttachLabels(context.Context, *ChangeTodoLabelsRequest) (*Todo, error)
+	// POST /v1/todos/{id}:detachLabels
+	// 付いていないラベルは無視する。Todo の version が進む。
+	DetachLabels(context.Context, *ChangeTodoLabelsRequest) (*Todo, error)
+	// POST /v1/todos/{id}:move
+	// リストの移動と、before_id / after_id による並び替え。Todo の version が進む
+	// （並び替えのために他の Todo の position が振り直されても、それらの version は変わらない）。
+	MoveTodo(context.Context, *MoveTodoRequest) (*Todo, error)
+	// POST /v1/todos/{todo_id}/items
+	// 末尾に未完了で追加する。完了済みの Todo は未完了に戻る。
+	AddChecklistItem(context.Context, *AddChecklistItemRequest) (*Todo, error)
+	// POST /v1/todos/{todo_id}/items/{id}:toggle
+	ToggleChecklistItem(context.Context, *ToggleChecklistItemRequest) (*Todo, error)
+	// DELETE /v1/todos/{todo_id}/items/{id}
+	RemoveChecklistItem(context.Context, *RemoveChecklistItemRequest) (*Todo, error)
+	// POST /v1/todos/{todo_id}/items:reorder
+	ReorderChecklistItems(context.Context, *ReorderChecklistItemsRequest) (*Todo, error)
+	// GET /v1/todos/{todo_id}/history
+	// ゴミ箱の中・物理削除済みの Todo の履歴も見られる。
+	ListTodoHistory(context.Context, *ListTodoHistoryRequest) (*ListTodoHistoryResponse, error)
+	// POST /v1/todos:batchCreate
+	BatchCreateTodos(context.Context, *BatchCreateTodosRequest) (*BatchCreateTodosResponse, error)
+	// POST /v1/todos:batchUpdate
+	BatchUpdateTodos(context.Context, *BatchUpdateTodosRequest) (*BatchUpdateTodosResponse, error)
+	// POST /v1/todos:batchDelete
+	// 1 件ずつの DeleteTodo と同じく、ゴミ箱へ移すだけ。
+	BatchDeleteTodos(context.Context, *BatchDeleteTodosRequest) (*BatchDeleteTodosResponse, error)
+	// GET /v1/todos:watch（改行区切りの JSON で流し続ける）
+	// 自分の Todo の作成・更新・削除を、コミットされた順に流し続ける（クライアントが切るまで終わらない）。
+	// スナップショットと変更が重なることがあるので、同じ Todo は version の大きい方を採ること。
+	// 読むのが遅くて溜まりすぎると RESOURCE_EXHAUSTED で切られるので、最後の resume_token で再接続すること。
+	// 変更はサーバのプロセス内で配信するので、複数レプリカでは別のレプリカで行った変更は届かない。
+	WatchTodos(*WatchTodosRequest, TodoService_WatchTodosServer) error
+	// GET /v1/todos:search?query=...
+	// タイトルとメモを全文検索する（ゴミ箱の中は含めない）。
+	SearchTodos(context.Context, *SearchTodosRequest) (*SearchTodosResponse, error)
+	// GET /v1/todos:export?format=...
+	// 自分の Todo（ゴミ箱の中は除く）を並び順で書き出す。ファイルを分けて流す。
+	ExportTodos(*ExportTodosRequest, TodoService_ExportTodosServer) error
+	// POST /v1/todos:import（改行区切りの JSON で ImportTodosRequest を送る）
+	// 1 行 1 件の Todo をインボックスの末尾に作成する（最大 10000 件）。検証に通らなかった行は飛ばして errors に返す。
+	// 作成は 100 件ずつ別のトランザクションで行うので、途中で失敗するとそれまでに作成した分は残る
+	// （エラーのメッセージに作成した件数を含める）。idempotency-key には対応しない。
+	ImportTodos(TodoService_ImportTodosServer) error
+	// POST /v1/calendarFeedToken
+	// フィードトークンを発行する（発行済みなら置き換え、前のトークンは使えなくなる）。
+	IssueCalendarFeedToken(context.Context, *IssueCalendarFeedTokenRequest) (*CalendarFeedToken, error)
+	// DELETE /v1/calendarFeedToken
+	// フィードトークンを無効にする。発行していなければ NOT_FOUND。
+	RevokeCalendarFeedToken(context.Context, *RevokeCalendarFeedTokenRequest) (*RevokeCalendarFeedTokenResponse, error)
+	// フィードトークンの持ち主の、期限のある Todo を iCalendar で流す（ExportTodos の TODO_FORMAT_ICALENDAR と同じ中身）。
+	// Bearer トークンは要らない（feed_token で認証する）。HTTP ゲートウェイが GET /calendar/<feed_token>.ics で呼ぶ。
+	GetCalendarFeed(*GetCalendarFeedRequest, TodoService_GetCalendarFeedServer) error
+	// POST /v1/collaborators
+	// target を user_id に共有する。所有者と OWNER で共有された人だけができる。既に共有していれば ALREADY_EXISTS。
+	AddCollaborator(context.Context, *AddCollaboratorRequest) (*Collaborator, error)
+	// PATCH /v1/collaborators
+	// 共有した人の役割を変える。所有者と OWNER で共有された人だけができる。
+	ChangeCollaboratorRole(context.Context, *ChangeCollaboratorRoleRequest) (*Collaborator, error)
+	// DELETE /v1/collaborators?target.todo_id=...&user_id=...
+	// 共有をやめる。所有者と OWNER で共有された人のほか、共有された本人も自分の分はやめられる。
+	RemoveCollaborator(context.Context, *RemoveCollaboratorRequest) (*RemoveCollaboratorResponse, error)
+	// GET /v1/collaborators?target.list_id=...
+	// target の共有の一覧。target が見える人なら誰でも見られる。
+	ListCollaborators(context.Context, *ListCollaboratorsRequest) (*ListCollaboratorsResponse, error)
+	// GET /v1/sharedWithMe
+	ListSharedWithMe(context.Context, *ListSharedWithMeRequest) (*ListSharedWithMeResponse, error)
+	// POST /v1/todos/{id}:assign
+	// 担当者を割り当てる（割り当て済みなら置き換える）。EDITOR 以上で共有された人もできる。
+	// 担当者にできるのは Todo の所有者と、Todo かそのリストを共有された人だけで、それ以外（存在しないユーザーを含む）は
+	// INVALID_ARGUMENT（共有していない人がいるかどうかは返さない）。自分が担当の Todo は ListTodos の assigned_to_me で取る。
+	AssignTodo(context.Context, *AssignTodoRequest) (*Todo, error)
+	// POST /v1/todos/{id}:unassign
+	// 担当者を外す（未割り当てでもエラーにしない）。
+	UnassignTodo(context.Context, *UnassignTodoRequest) (*Todo, error)
+	// POST /v1/todos/{todo_id}/comments
+	AddComment(context.Context, *AddCommentRequest) (*Comment, error)
+	// GET /v1/todos/{todo_id}/comments
+	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error)
+	// PATCH /v1/todos/{todo_id}/comments/{id}
+	// 本文を変える。書いた本人だけができる（他人のコメントと、VIEWER に下げられた本人は PERMISSION_DENIED）。
+	EditComment(context.Context, *EditCommentRequest) (*Comment, error)
+	// DELETE /v1/todos/{todo_id}/comments/{id}
+	// 書いた本人（VIEWER に下げられていても）のほか、Todo の所有者と OWNER で共有された人も消せる。
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	mustEmbedUnimplementedTodoServiceServer()
+}
+
+// UnimplementedTodoServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTodoServiceServer struct {
+}
+
+func (UnimplementedTodoServiceServer) CreateTodo(context.Context, *CreateTodoRequest) (*Todo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTodo not implemented")
+}
+func (UnimplementedTodoServiceServer) GetTodo(context.Context, *GetTodoRequest) (*Todo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTodo not implemented")
+}
+func (UnimplementedTodoServiceServer) ListTodos(context.Context, *ListTodosRequest) (*ListTodosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTodos not implemented")
+}
+func (UnimplementedTodoServiceServer) DeleteTodo(context.Context, *DeleteTodoRequest) (*DeleteTodoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTodo not implemented")
+}
+func (UnimplementedTodoServiceServer) UpdateTodo(context.Context, *UpdateTodoRequest) (*Todo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTodo not implemented")
+}
+func (UnimplementedTodoServiceServer) ListTodosStream(*ListTodosRequest, TodoService_ListTodosStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListTodosStream not implemented")
+}
+func (UnimplementedTodoServiceServer) ListDeletedTodos(context.Context, *ListTodosRequest) (*ListTodosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedTodos not implemented")
+}
+func (UnimplementedTodoServiceServer) RestoreTodo(context.Context, *RestoreTodoRequest) (*Todo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTodo not implemented")
+}
+func (UnimplementedTodoServiceServer) PurgeTodo(context.Context, *PurgeTodoRequest) (*PurgeTodoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTodo not implemented")
+}
+func (UnimplementedTodoServiceServer) ListLabels(context.Context, *ListLabelsRequest) (*ListLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLabels not implemented")
+}
+func (UnimplementedTodoServiceServer) CreateLabel(context.Context, *CreateLabelRequest) (*Label, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLabel not implemented")
+}
+func (UnimplementedTodoServiceServer) UpdateLabel(context.Context, *UpdateLabelRequest) (*Label, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLabel not implemented")
+}
+func (UnimplementedTodoServiceServer) DeleteLabel(context.Context, *DeleteLabelRequest) (*DeleteLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLabel not implemented")
+}
+func (UnimplementedTodoServiceServer) AttachLabels(context.Context, *ChangeTodoLabelsRequest) (*Todo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachLabels not implemented")
+}
+func (UnimplementedTodoServiceServer) DetachLabels(context.Context, *ChangeTodoLabelsRequest) (*Todo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachLabels not implemented")
+}
+func (UnimplementedTodoServiceServer) MoveTodo(context.Context, *MoveTodoRequest) (*Todo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveTodo not implemented")
+}
+func (UnimplementedTodoServiceServer) AddChecklistItem(context.Context, *AddChecklistItemRequest) (*Todo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddChecklistItem not implemented")
+}
+func (UnimplementedTodoServiceServer) ToggleChecklistItem(context.Context, *ToggleChecklistItemRequest) (*Todo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToggleChecklistItem not implemented")
+}
+func (UnimplementedTodoServiceServer) RemoveChecklistItem(context.Context, *RemoveChecklistItemRequest) (*Todo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveChecklistItem not implemented")
+}
+func (UnimplementedTodoServiceServer) ReorderChecklistItems(context.Context, *ReorderChecklistItemsRequest) (*Todo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderChecklistItems not implemented")
+}
+func (UnimplementedTodoServiceServer) ListTodoHistory(context.Context, *ListTodoHistoryRequest) (*ListTodoHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTodoHistory not implemented")
+}
+func (UnimplementedTodoServiceServer) BatchCreateTodos(context.Context, *BatchCreateTodosRequest) (*BatchCreateTodosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateTodos not implemented")
+}
+func (UnimplementedTodoServiceServer) BatchUpdateTodos(context.Context, *BatchUpdateTodosRequest) (*BatchUpdateTodosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateTodos not implemented")
+}
+func (UnimplementedTodoServiceServer) BatchDeleteTodos(context.Context, *BatchDeleteTodosRequest) (*BatchDeleteTodosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteTodos not implemented")
+}
+func (UnimplementedTodoServiceServer) WatchTodos(*WatchTodosRequest, TodoService_WatchTodosServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTodos not implemented")
+}
+func (UnimplementedTodoServiceServer) SearchTodos(context.Context, *SearchTodosRequest) (*SearchTodosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTodos not implemented")
+}
+func (UnimplementedTodoServiceServer) ExportTodos(*ExportTodosRequest, TodoService_ExportTodosServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportTodos not implemented")
+}
+func (UnimplementedTodoServiceServer) ImportTodos(TodoService_ImportTodosServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportTodos not implemented")
+}
+func (UnimplementedTodoServiceServer) IssueCalendarFeedToken(context.Context, *IssueCalendarFeedTokenRequest) (*CalendarFeedToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueCalendarFeedToken not implemented")
+}
+func (UnimplementedTodoServiceServer) RevokeCalendarFeedToken(context.Context, *RevokeCalendarFeedTokenRequest) (*RevokeCalendarFeedTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCalendarFeedToken not implemented")
+}
+func (UnimplementedTodoServiceServer) GetCalendarFeed(*GetCalendarFeedRequest, TodoService_GetCalendarFeedServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetCalendarFeed not implemented")
+}
+func (UnimplementedTodoServiceServer) AddCollaborator(context.Context, *AddCollaboratorRequest) (*Collaborator, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollaborator not implemented")
+}
+func (UnimplementedTodoServiceServer) ChangeCollaboratorRole(context.Context, *ChangeCollaboratorRoleRequest) (*Collaborator, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeCollaboratorRole not implemented")
+}
+func (UnimplementedTodoServiceServer) RemoveCollaborator(context.Context, *RemoveCollaboratorRequest) (*RemoveCollaboratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollaborator not implemented")
+}
+func (UnimplementedTodoServiceServer) ListCollaborators(context.Context, *ListCollaboratorsRequest) (*ListCollaboratorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollaborators not implemented")
+}
+func (UnimplementedTodoServiceServer) ListSharedWithMe(context.Context, *ListSharedWithMeRequest) (*ListSharedWithMeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSharedWithMe not implemented")
+}
+func (UnimplementedTodoServiceServer) AssignTodo(context.Context, *AssignTodoRequest) (*Todo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignTodo not implemented")
+}
+func (UnimplementedTodoServiceServer) UnassignTodo(context.Context, *UnassignTodoRequest) (*Todo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignTodo not implemented")
+}
+func (UnimplementedTodoServiceServer) AddComment(context.Context, *AddCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddComment not implemented")
+}
+func (UnimplementedTodoServiceServer) ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedTodoServiceServer) EditComment(context.Context, *EditCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditComment not implemented")
+}
+func (UnimplementedTodoServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedTodoServiceServer) mustEmbedUnimplementedTodoServiceServer() {}
+
+// UnsafeTodoServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TodoServiceServer will
+// result in compilation errors.
+type UnsafeTodoServiceServer interface {
+	mustEmbedUnimplementedTodoServiceServer()
+}
+
+func RegisterTodoServiceServer(s grpc.ServiceRegistrar, srv TodoServiceServer) {
+	s.RegisterService(&TodoService_ServiceDesc, srv)
+}
+
+func _TodoService_CreateTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).CreateTodo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_CreateTodo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).CreateTodo(ctx, req.(*CreateTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_GetTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).GetTodo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_GetTodo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).GetTodo(ctx, req.(*GetTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ListTodos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTodosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ListTodos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_ListTodos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ListTodos(ctx, req.(*ListTodosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_DeleteTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).DeleteTodo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_DeleteTodo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).DeleteTodo(ctx, req.(*DeleteTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_UpdateTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).UpdateTodo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_UpdateTodo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).UpdateTodo(ctx, req.(*UpdateTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ListTodosStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListTodosRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TodoServiceServer).ListTodosStream(m, &todoServiceListTodosStreamServer{stream})
+}
+
+type TodoService_ListTodosStreamServer interface {
+	Send(*Todo) error
+	grpc.ServerStream
+}
+
+type todoServiceListTodosStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *todoServiceListTodosStreamServer) Send(m *Todo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TodoService_ListDeletedTodos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTodosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ListDeletedTodos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_ListDeletedTodos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ListDeletedTodos(ctx, req.(*ListTodosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_RestoreTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).RestoreTodo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_RestoreTodo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).RestoreTodo(ctx, req.(*RestoreTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_PurgeTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).PurgeTodo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_PurgeTodo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).PurgeTodo(ctx, req.(*PurgeTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ListLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ListLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_ListLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ListLabels(ctx, req.(*ListLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_CreateLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).CreateLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_CreateLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).CreateLabel(ctx, req.(*CreateLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_UpdateLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).UpdateLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_UpdateLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).UpdateLabel(ctx, req.(*UpdateLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_DeleteLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).DeleteLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_DeleteLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).DeleteLabel(ctx, req.(*DeleteLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_AttachLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeTodoLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).AttachLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_AttachLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).AttachLabels(ctx, req.(*ChangeTodoLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_DetachLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeTodoLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).DetachLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_DetachLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).DetachLabels(ctx, req.(*ChangeTodoLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_MoveTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).MoveTodo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_MoveTodo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).MoveTodo(ctx, req.(*MoveTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_AddChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).AddChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_AddChecklistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).AddChecklistItem(ctx, req.(*AddChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ToggleChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ToggleChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ToggleChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_ToggleChecklistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ToggleChecklistItem(ctx, req.(*ToggleChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_RemoveChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).RemoveChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_RemoveChecklistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).RemoveChecklistItem(ctx, req.(*RemoveChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ReorderChecklistItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderChecklistItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ReorderChecklistItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_ReorderChecklistItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ReorderChecklistItems(ctx, req.(*ReorderChecklistItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ListTodoHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTodoHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ListTodoHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_ListTodoHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ListTodoHistory(ctx, req.(*ListTodoHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_BatchCreateTodos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateTodosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).BatchCreateTodos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_BatchCreateTodos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).BatchCreateTodos(ctx, req.(*BatchCreateTodosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_BatchUpdateTodos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateTodosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).BatchUpdateTodos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_BatchUpdateTodos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).BatchUpdateTodos(ctx, req.(*BatchUpdateTodosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_BatchDeleteTodos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteTodosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).BatchDeleteTodos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_BatchDeleteTodos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).BatchDeleteTodos(ctx, req.(*BatchDeleteTodosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_WatchTodos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTodosRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TodoServiceServer).WatchTodos(m, &todoServiceWatchTodosServer{stream})
+}
+
+type TodoService_WatchTodosServer interface {
+	Send(*TodoEvent) error
+	grpc.ServerStream
+}
+
+type todoServiceWatchTodosServer struct {
+	grpc.ServerStream
+}
+
+func (x *todoServiceWatchTodosServer) Send(m *TodoEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TodoService_SearchTodos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTodosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).SearchTodos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_SearchTodos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).SearchTodos(ctx, req.(*SearchTodosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ExportTodos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportTodosRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TodoServiceServer).ExportTodos(m, &todoServiceExportTodosServer{stream})
+}
+
+type TodoService_ExportTodosServer interface {
+	Send(*ExportTodosResponse) error
+	grpc.ServerStream
+}
+
+type todoServiceExportTodosServer struct {
+	grpc.ServerStream
+}
+
+func (x *todoServiceExportTodosServer) Send(m *ExportTodosResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TodoService_ImportTodos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TodoServiceServer).ImportTodos(&todoServiceImportTodosServer{stream})
+}
+
+type TodoService_ImportTodosServer interface {
+	SendAndClose(*ImportTodosResponse) error
+	Recv() (*ImportTodosRequest, error)
+	grpc.ServerStream
+}
+
+type todoServiceImportTodosServer struct {
+	grpc.ServerStream
+}
+
+func (x *todoServiceImportTodosServer) SendAndClose(m *ImportTodosResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *todoServiceImportTodosServer) Recv() (*ImportTodosRequest, error) {
+	m := new(ImportTodosRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _TodoService_IssueCalendarFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueCalendarFeedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).IssueCalendarFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_IssueCalendarFeedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).IssueCalendarFeedToken(ctx, req.(*IssueCalendarFeedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_RevokeCalendarFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCalendarFeedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).RevokeCalendarFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_RevokeCalendarFeedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).RevokeCalendarFeedToken(ctx, req.(*RevokeCalendarFeedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_GetCalendarFeed_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCalendarFeedRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TodoServiceServer).GetCalendarFeed(m, &todoServiceGetCalendarFeedServer{stream})
+}
+
+type TodoService_GetCalendarFeedServer interface {
+	Send(*ExportTodosResponse) error
+	grpc.ServerStream
+}
+
+type todoServiceGetCalendarFeedServer struct {
+	grpc.ServerStream
+}
+
+func (x *todoServiceGetCalendarFeedServer) Send(m *ExportTodosResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TodoService_AddCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).AddCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_AddCollaborator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).AddCollaborator(ctx, req.(*AddCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ChangeCollaboratorRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeCollaboratorRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ChangeCollaboratorRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_ChangeCollaboratorRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ChangeCollaboratorRole(ctx, req.(*ChangeCollaboratorRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_RemoveCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).RemoveCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_RemoveCollaborator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).RemoveCollaborator(ctx, req.(*RemoveCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ListCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollaboratorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ListCollaborators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_ListCollaborators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ListCollaborators(ctx, req.(*ListCollaboratorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ListSharedWithMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSharedWithMeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ListSharedWithMe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_ListSharedWithMe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ListSharedWithMe(ctx, req.(*ListSharedWithMeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_AssignTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).AssignTodo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_AssignTodo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).AssignTodo(ctx, req.(*AssignTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_UnassignTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnassignTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).UnassignTodo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_UnassignTodo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).UnassignTodo(ctx, req.(*UnassignTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_AddComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).AddComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_AddComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).AddComment(ctx, req.(*AddCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ListComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_ListComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ListComments(ctx, req.(*ListCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_EditComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).EditComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_EditComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).EditComment(ctx, req.(*EditCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TodoService_ServiceDesc is the grpc.ServiceDesc for TodoService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TodoService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "todo.v1.TodoService",
+	HandlerType: (*TodoServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTodo",
+			Handler:    _TodoService_CreateTodo_Handler,
+		},
+		{
+			MethodName: "GetTodo",
+			Handler:    _TodoService_GetTodo_Handler,
+		},
+		{
+			MethodName: "ListTodos",
+			Handler:    _TodoService_ListTodos_Handler,
+		},
+		{
+			MethodName: "DeleteTodo",
+			Handler:    _TodoService_DeleteTodo_Handler,
+		},
+		{
+			MethodName: "UpdateTodo",
+			Handler:    _TodoService_UpdateTodo_Handler,
+		},
+		{
+			MethodName: "ListDeletedTodos",
+			Handler:    _TodoService_ListDeletedTodos_Handler,
+		},
+		{
+			MethodName: "RestoreTodo",
+			Handler:    _TodoService_RestoreTodo_Handler,
+		},
+		{
+			MethodName: "PurgeTodo",
+			Handler:    _TodoService_PurgeTodo_Handler,
+		},
+		{
+			MethodName: "ListLabels",
+			Handler:    _TodoService_ListLabels_Handler,
+		},
+		{
+			MethodName: "CreateLabel",
+			Handler:    _TodoService_CreateLabel_Handler,
+		},
+		{
+			MethodName: "UpdateLabel",
+			Handler:    _TodoService_UpdateLabel_Handler,
+		},
+		{
+			MethodName: "DeleteLabel",
+			Handler:    _TodoService_DeleteLabel_Handler,
+		},
+		{
+			MethodName: "AttachLabels",
+			Handler:    _TodoService_AttachLabels_Handler,
+		},
+		{
+			MethodName: "DetachLabels",
+			Handler:    _TodoService_DetachLabels_Handler,
+		},
+		{
+			MethodName: "MoveTodo",
+			Handler:    _TodoService_MoveTodo_Handler,
+		},
+		{
+			MethodName: "AddChecklistItem",
+			Handler:    _TodoService_AddChecklistItem_Handler,
+		},
+		{
+			MethodName: "ToggleChecklistItem",
+			Handler:    _TodoService_ToggleChecklistItem_Handler,
+		},
+		{
+			MethodName: "RemoveChecklistItem",
+			Handler:    _TodoService_RemoveChecklistItem_Handler,
+		},
+		{
+			MethodName: "ReorderChecklistItems",
+			Handler:    _TodoService_ReorderChecklistItems_Handler,
+		},
+		{
+			MethodName: "ListTodoHistory",
+			Handler:    _TodoService_ListTodoHistory_Handler,
+		},
+		{
+			MethodName: "BatchCreateTodos",
+			Handler:    _TodoService_BatchCreateTodos_Handler,
+		},
+		{
+			MethodName: "BatchUpdateTodos",
+			Handler:    _TodoService_BatchUpdateTodos_Handler,
+		},
+		{
+			MethodName: "BatchDeleteTodos",
+			Handler:    _TodoService_BatchDeleteTodos_Handler,
+		},
+		{
+			MethodName: "SearchTodos",
+			Handler:    _TodoService_SearchTodos_Handler,
+		},
+		{
+			MethodName: "IssueCalendarFeedToken",
+			Handler:    _TodoService_IssueCalendarFeedToken_Handler,
+		},
+		{
+			MethodName: "RevokeCalendarFeedToken",
+			Handler:    _TodoService_RevokeCalendarFeedToken_Handler,
+		},
+		{
+			MethodName: "AddCollaborator",
+			Handler:    _TodoService_AddCollaborator_Handler,
+		},
+		{
+			MethodName: "ChangeCollaboratorRole",
+			Handler:    _TodoService_ChangeCollaboratorRole_Handler,
+		},
+		{
+			MethodName: "RemoveCollaborator",
+			Handler:    _TodoService_RemoveCollaborator_Handler,
+		},
+		{
+			MethodName: "ListCollaborators",
+			Handler:    _TodoService_ListCollaborators_Handler,
+		},
+		{
+			MethodName: "ListSharedWithMe",
+			Handler:    _TodoService_ListSharedWithMe_Handler,
+		},
+		{
+			MethodName: "AssignTodo",
+			Handler:    _TodoService_AssignTodo_Handler,
+		},
+		{
+			MethodName: "UnassignTodo",
+			Handler:    _TodoService_UnassignTodo_Handler,
+		},
+		{
+			MethodName: "AddComment",
+			Handler:    _TodoService_AddComment_Handler,
+		},
+		{
+			MethodName: "ListComments",
+			Handler:    _TodoService_ListComments_Handler,
+		},
+		{
+			MethodName: "EditComment",
+			Handler:    _TodoService_EditComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _TodoService_DeleteComment_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListTodosStream",
+			Handler:       _TodoService_ListTodosStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchTodos",
+			Handler:       _TodoService_WatchTodos_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportTodos",
+			Handler:       _TodoService_ExportTodos_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportTodos",
+			Handler:       _TodoService_ImportTodos_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetCalendarFeed",
+			Handler:       _TodoService_GetCalendarFeed_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/todo/v1/todo.proto",
+}
+
+const (
+	TodoListService_ListTodoLists_FullMethodName     = "/todo.v1.TodoListService/ListTodoLists"
+	TodoListService_GetTodoList_FullMethodName       = "/todo.v1.TodoListService/GetTodoList"
+	TodoListService_CreateTodoList_FullMethodName    = "/todo.v1.TodoListService/CreateTodoList"
+	TodoListService_UpdateTodoList_FullMethodName    = "/todo.v1.TodoListService/UpdateTodoList"
+	TodoListService_ArchiveTodoList_FullMethodName   = "/todo.v1.TodoListService/ArchiveTodoList"
+	TodoListService_UnarchiveTodoList_FullMethodName = "/todo.v1.TodoListService/UnarchiveTodoList"
+	TodoListService_DeleteTodoList_FullMethodName    = "/todo.v1.TodoListService/DeleteTodoList"
+)
+
+// TodoListServiceClient is the client API for TodoListService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TodoListServiceClient interface {
+	// GET /v1/lists
+	ListTodoLists(ctx context.Context, in *ListTodoListsRequest, opts ...grpc.CallOption) (*ListTodoListsResponse, error)
+	// GET /v1/lists/{id}
+	GetTodoList(ctx context.Context, in *GetTodoListRequest, opts ...grpc.CallOption) (*TodoList, error)
+	// POST /v1/lists
+	CreateTodoList(ctx context.Context, in *CreateTodoListRequest, opts ...grpc.CallOption) (*TodoList, error)
+	// PATCH /v1/lists/{id}
+	UpdateTodoList(ctx context.Context, in *UpdateTodoListRequest, opts ...grpc.CallOption) (*TodoList, error)
+	// POST /v1/lists/{id}:archive
+	// 中の Todo はそのまま残る。既にアーカイブ済みなら何もしない。
+	ArchiveTodoList(ctx context.Context, in *ArchiveTodoListRequest, opts ...grpc.CallOption) (*TodoList, error)
+	// POST /v1/lists/{id}:unarchive
+	UnarchiveTodoList(ctx context.Context, in *ArchiveTodoListRequest, opts ...grpc.CallOption) (*TodoList, error)
+	// DELETE /v1/lists/{id}
+	// Todo が残っているリストは削除できない（FAILED_PRECONDITION）。アーカイブするか Todo を移動すること。
+	DeleteTodoList(ctx context.Context, in *DeleteTodoListRequest, opts ...grpc.CallOption) (*DeleteTodoListResponse, error)
+}
+
+type todoListServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTodoListServiceClient(cc grpc.ClientConnInterface) TodoListServiceClient {
+	return &todoListServiceClient{cc}
+}
+
+func (c *todoListServiceClient) ListTodoLists(ctx context.Context, in *ListTodoListsRequest, opts ...grpc.CallOption) (*ListTodoListsResponse, error) {
+	out := new(ListTodoListsResponse)
+	err := c.cc.Invoke(ctx, TodoListService_ListTodoLists_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoListServiceClient) GetTodoList(ctx context.Context, in *GetTodoListRequest, opts ...grpc.CallOption) (*TodoList, error) {
+	out := new(TodoList)
+	err := c.cc.Invoke(ctx, TodoListService_GetTodoList_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *todoServiceClient) UpdateTodo(ctx context.Context, in *UpdateTodoRequest, opts ...grpc.CallOption) (*Todo, error) {
-	out := new(Todo)
-	err := c.cc.Invoke(ctx, TodoService_UpdateTodo_FullMethodName, in, out, opts...)
+func (c *todoListServiceClient) CreateTodoList(ctx context.Context, in *CreateTodoListRequest, opts ...grpc.CallOption) (*TodoList, error) {
+	out := new(TodoList)
+	err := c.cc.Invoke(ctx, TodoListService_CreateTodoList_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *todoServiceClient) ListTodosStream(ctx context.Context, in *ListTodosRequest, opts ...grpc.CallOption) (TodoService_ListTodosStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &TodoService_ServiceDesc.Streams[0], TodoService_ListTodosStream_FullMethodName, opts...)
+func (c *todoListServiceClient) UpdateTodoList(ctx context.Context, in *UpdateTodoListRequest, opts ...grpc.CallOption) (*TodoList, error) {
+	out := new(TodoList)
+	err := c.cc.Invoke(ctx, TodoListService_UpdateTodoList_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &todoServiceListTodosStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+	return out, nil
 }
 
-type TodoService_ListTodosStreamClient interface {
-	Recv() (*Todo, error)
-	grpc.ClientStream
+func (c *todoListServiceClient) ArchiveTodoList(ctx context.Context, in *ArchiveTodoListRequest, opts ...grpc.CallOption) (*TodoList, error) {
+	out := new(TodoList)
+	err := c.cc.Invoke(ctx, TodoListService_ArchiveTodoList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type todoServiceListTodosStreamClient struct {
-	grpc.ClientStream
+func (c *todoListServiceClient) UnarchiveTodoList(ctx context.Context, in *ArchiveTodoListRequest, opts ...grpc.CallOption) (*TodoList, error) {
+	out := new(TodoList)
+	err := c.cc.Invoke(ctx, TodoListService_UnarchiveTodoList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *todoServiceListTodosStreamClient) Recv() (*Todo, error) {
-	m := new(Todo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *todoListServiceClient) DeleteTodoList(ctx context.Context, in *DeleteTodoListRequest, opts ...grpc.CallOption) (*DeleteTodoListResponse, error) {
+	out := new(DeleteTodoListResponse)
+	err := c.cc.Invoke(ctx, TodoListService_DeleteTodoList_FullMethodName, in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-// TodoServiceServer is the server API for TodoService service.
-// All implementations must embed UnimplementedTodoServiceServer
+// TodoListServiceServer is the server API for TodoListService service.
+// All implementations must embed UnimplementedTodoListServiceServer
 // for forward compatibility
-type TodoServiceServer interface {
-	// POST /v1/todos
-	CreateTodo(context.Context, *CreateTodoRequest) (*Todo, error)
-	// GET /v1/todos
-	ListTodos(context.Context, *ListTodosRequest) (*ListTodosResponse, error)
-	// DELETE /v1/todos/{id}
-	DeleteTodo(context.Context, *DeleteTodoRequest) (*DeleteTodoResponse, error)
-	// PATCH /v1/todos/{id}
-	UpdateTodo(context.Context, *UpdateTodoRequest) (*Todo, error)
-	ListTodosStream(*ListTodosRequest, TodoService_ListTodosStreamServer) error
-	mustEmbedUnimplementedTodoServiceServer()
+type TodoListServiceServer interface {
+	// GET /v1/lists
+	ListTodoLists(context.Context, *ListTodoListsRequest) (*ListTodoListsResponse, error)
+	// GET /v1/lists/{id}
+	GetTodoList(context.Context, *GetTodoListRequest) (*TodoList, error)
+	// POST /v1/lists
+	CreateTodoList(context.Context, *CreateTodoListRequest) (*TodoList, error)
+	// PATCH /v1/lists/{id}
+	UpdateTodoList(context.Context, *UpdateTodoListRequest) (*TodoList, error)
+	// POST /v1/lists/{id}:archive
+	// 中の Todo はそのまま残る。既にアーカイブ済みなら何もしない。
+	ArchiveTodoList(context.Context, *ArchiveTodoListRequest) (*TodoList, error)
+	// POST /v1/lists/{id}:unarchive
+	UnarchiveTodoList(context.Context, *ArchiveTodoListRequest) (*TodoList, error)
+	// DELETE /v1/lists/{id}
+	// Todo が残っているリストは削除できない（FAILED_PRECONDITION）。アーカイブするか Todo を移動すること。
+	DeleteTodoList(context.Context, *DeleteTodoListRequest) (*DeleteTodoListResponse, error)
+	mustEmbedUnimplementedTodoListServiceServer()
 }
 
-// UnimplementedTodoServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedTodoServiceServer struct {
+// UnimplementedTodoListServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTodoListServiceServer struct {
 }
 
-func (UnimplementedTodoServiceServer) CreateTodo(context.Context, *CreateTodoRequest) (*Todo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTodo not implemented")
+func (UnimplementedTodoListServiceServer) ListTodoLists(context.Context, *ListTodoListsRequest) (*ListTodoListsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTodoLists not implemented")
 }
-func (UnimplementedTodoServiceServer) ListTodos(context.Context, *ListTodosRequest) (*ListTodosResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTodos not implemented")
+func (UnimplementedTodoListServiceServer) GetTodoList(context.Context, *GetTodoListRequest) (*TodoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTodoList not implemented")
 }
-func (UnimplementedTodoServiceServer) DeleteTodo(context.Context, *DeleteTodoRequest) (*DeleteTodoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTodo not implemented")
+func (UnimplementedTodoListServiceServer) CreateTodoList(context.Context, *CreateTodoListRequest) (*TodoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTodoList not implemented")
 }
-func (UnimplementedTodoServiceServer) UpdateTodo(context.Context, *UpdateTodoRequest) (*Todo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTodo not implemented")
+func (UnimplementedTodoListServiceServer) UpdateTodoList(context.Context, *UpdateTodoListRequest) (*TodoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTodoList not implemented")
 }
-func (UnimplementedTodoServiceServer) ListTodosStream(*ListTodosRequest, TodoService_ListTodosStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListTodosStream not implemented")
+func (UnimplementedTodoListServiceServer) ArchiveTodoList(context.Context, *ArchiveTodoListRequest) (*TodoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveTodoList not implemented")
 }
-func (UnimplementedTodoServiceServer) mustEmbedUnimplementedTodoServiceServer() {}
+func (UnimplementedTodoListServiceServer) UnarchiveTodoList(context.Context, *ArchiveTodoListRequest) (*TodoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveTodoList not implemented")
+}
+func (UnimplementedTodoListServiceServer) DeleteTodoList(context.Context, *DeleteTodoListRequest) (*DeleteTodoListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTodoList not implemented")
+}
+func (UnimplementedTodoListServiceServer) mustEmbedUnimplementedTodoListServiceServer() {}
 
-// UnsafeTodoServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to TodoServiceServer will
+// UnsafeTodoListServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TodoListServiceServer will
 // result in compilation errors.
-type UnsafeTodoServiceServer interface {
-	mustEmbedUnimplementedTodoServiceServer()
+type UnsafeTodoListServiceServer interface {
+	mustEmbedUnimplementedTodoListServiceServer()
 }
 
-func RegisterTodoServiceServer(s grpc.ServiceRegistrar, srv TodoServiceServer) {
-	s.RegisterService(&TodoService_ServiceDesc, srv)
+func RegisterTodoListServiceServer(s grpc.ServiceRegistrar, srv TodoListServiceServer) {
+	s.RegisterService(&TodoListService_ServiceDesc, srv)
 }
 
-func _TodoService_CreateTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTodoRequest)
+func _TodoListService_ListTodoLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTodoListsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TodoServiceServer).CreateTodo(ctx, in)
+		return srv.(TodoListServiceServer).ListTodoLists(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TodoService_CreateTodo_FullMethodName,
+		FullMethod: TodoListService_ListTodoLists_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).CreateTodo(ctx, req.(*CreateTodoRequest))
+		return srv.(TodoListServiceServer).ListTodoLists(ctx, req.(*ListTodoListsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TodoService_ListTodos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTodosRequest)
+func _TodoListService_GetTodoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTodoListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TodoServiceServer).ListTodos(ctx, in)
+		return srv.(TodoListServiceServer).GetTodoList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TodoService_ListTodos_FullMethodName,
+		FullMethod: TodoListService_GetTodoList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).ListTodos(ctx, req.(*ListTodosRequest))
+		return srv.(TodoListServiceServer).GetTodoList(ctx, req.(*GetTodoListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TodoService_DeleteTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTodoRequest)
+func _TodoListService_CreateTodoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTodoListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TodoServiceServer).DeleteTodo(ctx, in)
+		return srv.(TodoListServiceServer).CreateTodoList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TodoService_DeleteTodo_FullMethodName,
+		FullMethod: TodoListService_CreateTodoList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).DeleteTodo(ctx, req.(*DeleteTodoRequest))
+		return srv.(TodoListServiceServer).CreateTodoList(ctx, req.(*CreateTodoListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TodoService_UpdateTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTodoRequest)
+func _TodoListService_UpdateTodoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTodoListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TodoServiceServer).UpdateTodo(ctx, in)
+		return srv.(TodoListServiceServer).UpdateTodoList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TodoService_UpdateTodo_FullMethodName,
+		FullMethod: TodoListService_UpdateTodoList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).UpdateTodo(ctx, req.(*UpdateTodoRequest))
+		return srv.(TodoListServiceServer).UpdateTodoList(ctx, req.(*UpdateTodoListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TodoService_ListTodosStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListTodosRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _TodoListService_ArchiveTodoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveTodoListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(TodoServiceServer).ListTodosStream(m, &todoServiceListTodosStreamServer{stream})
-}
-
-type TodoService_ListTodosStreamServer interface {
-	Send(*Todo) error
-	grpc.ServerStream
+	if interceptor == nil {
+		return srv.(TodoListServiceServer).ArchiveTodoList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoListService_ArchiveTodoList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoListServiceServer).ArchiveTodoList(ctx, req.(*ArchiveTodoListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type todoServiceListTodosStreamServer struct {
-	grpc.ServerStream
+func _TodoListService_UnarchiveTodoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveTodoListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoListServiceServer).UnarchiveTodoList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoListService_UnarchiveTodoList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoListServiceServer).UnarchiveTodoList(ctx, req.(*ArchiveTodoListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *todoServiceListTodosStreamServer) Send(m *Todo) error {
-	return x.ServerStream.SendMsg(m)
+func _TodoListService_DeleteTodoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTodoListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoListServiceServer).DeleteTodoList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoListService_DeleteTodoList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoListServiceServer).DeleteTodoList(ctx, req.(*DeleteTodoListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// TodoService_ServiceDesc is the grpc.ServiceDesc for TodoService service.
+// TodoListService_ServiceDesc is the grpc.ServiceDesc for TodoListService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var TodoService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "todo.v1.TodoService",
-	HandlerType: (*TodoServiceServer)(nil),
+var TodoListService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "todo.v1.TodoListService",
+	HandlerType: (*TodoListServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateTodo",
-			Handler:    _TodoService_CreateTodo_Handler,
+			MethodName: "ListTodoLists",
+			Handler:    _TodoListService_ListTodoLists_Handler,
 		},
 		{
-			MethodName: "ListTodos",
-			Handler:    _TodoService_ListTodos_Handler,
+			MethodName: "GetTodoList",
+			Handler:    _TodoListService_GetTodoList_Handler,
 		},
 		{
-			MethodName: "DeleteTodo",
-			Handler:    _TodoService_DeleteTodo_Handler,
+			MethodName: "CreateTodoList",
+			Handler:    _TodoListService_CreateTodoList_Handler,
 		},
 		{
-			MethodName: "UpdateTodo",
-			Handler:    _TodoService_UpdateTodo_Handler,
+			MethodName: "UpdateTodoList",
+			Handler:    _TodoListService_UpdateTodoList_Handler,
 		},
-	},
-	Streams: []grpc.StreamDesc{
 		{
-			StreamName:    "ListTodosStream",
-			Handler:       _TodoService_ListTodosStream_Handler,
-			ServerStreams: true,
+			MethodName: "ArchiveTodoList",
+			Handler:    _TodoListService_ArchiveTodoList_Handler,
+		},
+		{
+			MethodName: "UnarchiveTodoList",
+			Handler:    _TodoListService_UnarchiveTodoList_Handler,
+		},
+		{
+			MethodName: "DeleteTodoList",
+			Handler:    _TodoListService_DeleteTodoList_Handler,
 		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/todo/v1/todo.proto",
 }
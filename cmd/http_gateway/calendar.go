@@ -0,0 +1,82 @@
+package main
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	todov1 "github.com/hijjiri/grpc-echo/api/todo/v1"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// calendarFeedHandler は iCalendar のフィードを text/calendar のまま返す（gRPC-Gateway の JSON を通さない）。
+//
+//	GET /calendar/feed.ics          Authorization: Bearer <JWT>（ExportTodos の TODO_FORMAT_ICALENDAR）
+//	GET /calendar/<feed_token>.ics  Authorization ヘッダを送れないカレンダーアプリ向け（GetCalendarFeed）
+//
+// feed_token は URL に入るので、アクセスログなどに残さないこと。
+func calendarFeedHandler(client todov1.TodoServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name, ok := strings.CutSuffix(r.PathValue("file"), ".ics")
+		if !ok || name == "" {
+			http.NotFound(w, r)
+			return
+		}
+
+		ctx := r.Context()
+		var (
+			stream chunkStream
+			err    error
+		)
+		if name == "feed" {
+			if auth := r.Header.Get("Authorization"); auth != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", auth)
+			}
+			stream, err = client.ExportTodos(ctx, &todov1.ExportTodosRequest{Format: todov1.TodoFormat_TODO_FORMAT_ICALENDAR})
+		} else {
+			stream, err = client.GetCalendarFeed(ctx, &todov1.GetCalendarFeedRequest{FeedToken: name})
+		}
+		if err != nil {
+			writeGRPCError(w, err)
+			return
+		}
+		copyCalendar(w, stream)
+	}
+}
+
+// chunkStream は ExportTodosResponse を順に受け取る stream（ExportTodos / GetCalendarFeed）
+type chunkStream interface {
+	Recv() (*todov1.ExportTodosResponse, error)
+}
+
+// copyCalendar は stream の中身をそのまま書く。
+// エラーは最初のチャンクより前なら HTTP のステータスで返し、途中なら接続を切る
+// （途中までのフィードをカレンダーアプリに正しいものとして読ませない）。
+func copyCalendar(w http.ResponseWriter, stream chunkStream) {
+	first, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Cache-Control", "private, no-cache")
+	for msg := first; err == nil; msg, err = stream.Recv() {
+		if _, werr := w.Write(msg.GetData()); werr != nil {
+			return
+		}
+	}
+	if err != io.EOF {
+		log.Printf("calendar feed aborted: %v", err)
+		panic(http.ErrAbortHandler)
+	}
+}
+
+func writeGRPCError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+}
@@ -2,9 +2,11 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"strings"
 
 	authv1 "github.com/hijjiri/grpc-echo/api/auth/v1"
 	todov1 "github.com/hijjiri/grpc-echo/api/todo/v1"
@@ -22,6 +24,28 @@ func getenv(key, def string) string {
 	return def
 }
 
+// incomingHeaderMatcher は HTTP リクエストヘッダのうち gRPC metadata に転送するものを決める。
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, "If-Match") {
+		return "if-match", true
+	}
+	if strings.EqualFold(key, "Idempotency-Key") {
+		return "idempotency-key", true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher は gRPC レスポンスヘッダを HTTP ヘッダにどう載せるかを決める。
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == "etag" {
+		return "ETag", true
+	}
+	if key == "idempotent-replayed" {
+		return "Idempotent-Replayed", true
+	}
+	return fmt.Sprintf("%s%s", runtime.MetadataHeaderPrefix, key), true
+}
+
 func main() {
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
@@ -33,7 +57,12 @@ func main() {
 	httpAddr := getenv("HTTP_LISTEN_ADDR", ":8081")
 
 	// --- gRPC-Gateway Mux ---
-	gwMux := runtime.NewServeMux()
+	gwMux := runtime.NewServeMux(
+		// If-Match を gRPC metadata（if-match）として転送する（楽観ロック用）。Idempotency-Key も同様
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		// gRPC の etag ヘッダを HTTP の ETag として返す
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+	)
 
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -49,6 +78,16 @@ func main() {
 		log.Fatalf("failed to register todo gateway: %v", err)
 	}
 
+	// TodoListService のハンドラ登録 (/v1/lists...)
+	if err := todov1.RegisterTodoListServiceHandlerFromEndpoint(
+		ctx,
+		gwMux,
+		grpcAddr,
+		opts,
+	); err != nil {
+		log.Fatalf("failed to register todo list gateway: %v", err)
+	}
+
 	// AuthService のハンドラ登録 (/auth/login)
 	if err := authv1.RegisterAuthServiceHandlerFromEndpoint(
 		ctx,
@@ -59,9 +98,19 @@ func main() {
 		log.Fatalf("failed to register auth gateway: %v", err)
 	}
 
+	// カレンダーのフィードは JSON ではなく text/calendar で返すので、gRPC クライアントで直接呼ぶ
+	conn, err := grpc.NewClient(grpcAddr, opts...)
+	if err != nil {
+		log.Fatalf("failed to create grpc client: %v", err)
+	}
+	defer conn.Close()
+
 	// ルート用 mux （/healthz と Gateway を共存）
 	rootMux := http.NewServeMux()
 
+	// iCalendar のフィード（/calendar/feed.ics, /calendar/<feed_token>.ics）
+	rootMux.HandleFunc("GET /calendar/{file}", calendarFeedHandler(todov1.NewTodoServiceClient(conn)))
+
 	// gRPC-Gateway (REST エンドポイント: /v1/..., /auth/...)
 	rootMux.Handle("/", gwMux)
 
@@ -75,7 +124,8 @@ func main() {
 	handler := cors.New(cors.Options{
 		AllowedOrigins:   []string{"http://localhost:5173"},
 		AllowedMethods:   []string{"GET", "POST", "PATCH", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Content-Type", "Authorization"},
+		AllowedHeaders:   []string{"Content-Type", "Authorization", "If-Match", "Idempotency-Key"},
+		ExposedHeaders:   []string{"ETag", "Idempotent-Replayed"},
 		AllowCredentials: true,
 	}).Handler(rootMux)
 
@@ -14,8 +14,11 @@ import (
 	todov1 "github.com/hijjiri/grpc-echo/api/todo/v1"
 	"github.com/hijjiri/grpc-echo/internal/auth"
 	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"github.com/hijjiri/grpc-echo/internal/infrastructure/eventbus"
+	"github.com/hijjiri/grpc-echo/internal/infrastructure/memsearch"
 	mysqlrepo "github.com/hijjiri/grpc-echo/internal/infrastructure/mysql"
 	grpcadapter "github.com/hijjiri/grpc-echo/internal/interface/grpc"
+	idempotency_usecase "github.com/hijjiri/grpc-echo/internal/usecase/idempotency"
 	todo_usecase "github.com/hijjiri/grpc-echo/internal/usecase/todo"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -38,6 +41,22 @@ func getenv(key, def string) string {
 	return def
 }
 
+// getenvDuration は time.ParseDuration できない値を warn してデフォルトに落とす
+func getenvDuration(logger *zap.Logger, key string, def time.Duration) time.Duration {
+	raw := getenv(key, def.String())
+	d, err := time.ParseDuration(raw)
+	if err != nil || d <= 0 {
+		// 本番目線：起動失敗にせず、warn して安全なデフォルトに落とす
+		logger.Warn("invalid "+key+", fallback to default",
+			zap.String("raw", raw),
+			zap.Duration("default", def),
+			zap.Error(err),
+		)
+		return def
+	}
+	return d
+}
+
 //----------------------
 // Config struct
 //----------------------
@@ -56,9 +75,21 @@ type Config struct {
 	DB                   DBConfig
 	OTELExporterEndpoint string
 	AuthSecret           string
+	PageTokenSecret      string
 
 	// 追加：gRPC request timeout
 	GRPCRequestTimeout time.Duration
+
+	// ゴミ箱の保持期間と、期限切れを物理削除する間隔
+	TrashRetention     time.Duration
+	TrashPurgeInterval time.Duration
+
+	// 冪等キーの保持期間（この間は同じキーの再送に保存した結果を返す）と、期限切れを削除する間隔
+	IdempotencyKeyTTL           time.Duration
+	IdempotencyKeyPurgeInterval time.Duration
+
+	// SearchTodos の索引: "mysql"（FULLTEXT 索引）/ "memory"（ローカル実行用。起動後の変更だけが載る）
+	SearchIndex string
 }
 
 // env から Config を読み込む（既存の挙動と齟齬が出ないようにする）
@@ -85,9 +116,15 @@ func loadConfig(logger *zap.Logger) Config {
 			Password: getenv("DB_PASSWORD", "root"),
 			Name:     getenv("DB_NAME", "grpcdb"),
 		},
-		OTELExporterEndpoint: getenv("OTEL_EXPORTER_OTLP_ENDPOINT", "otel-collector:4317"),
-		AuthSecret:           getenv("AUTH_SECRET", "my-dev-secret-key"),
-		GRPCRequestTimeout:   timeout,
+		OTELExporterEndpoint:        getenv("OTEL_EXPORTER_OTLP_ENDPOINT", "otel-collector:4317"),
+		AuthSecret:                  getenv("AUTH_SECRET", "my-dev-secret-key"),
+		PageTokenSecret:             getenv("PAGE_TOKEN_SECRET", "my-dev-page-token-secret"),
+		GRPCRequestTimeout:          timeout,
+		TrashRetention:              getenvDuration(logger, "TRASH_RETENTION", 30*24*time.Hour),
+		TrashPurgeInterval:          getenvDuration(logger, "TRASH_PURGE_INTERVAL", time.Hour),
+		IdempotencyKeyTTL:           getenvDuration(logger, "IDEMPOTENCY_KEY_TTL", idempotency_usecase.DefaultTTL),
+		IdempotencyKeyPurgeInterval: getenvDuration(logger, "IDEMPOTENCY_PURGE_INTERVAL", time.Hour),
+		SearchIndex:                 getenv("SEARCH_INDEX", "mysql"),
 	}
 }
 
@@ -127,6 +164,54 @@ func pingMySQLWithRetry(ctx context.Context, db *sql.DB, logger *zap.Logger, max
 	return fmt.Errorf("failed to ping db after %d attempts", maxAttempts)
 }
 
+//----------------------
+// ゴミ箱の purger
+//----------------------
+
+// runTrashPurger は interval ごとに、retention を過ぎたゴミ箱の Todo を物理削除する。
+// 失敗しても次の周期で再試行するだけ（サーバは止めない）。
+func runTrashPurger(ctx context.Context, uc todo_usecase.Usecase, logger *zap.Logger, retention, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// 1 回の purge が次の周期に食い込まないよう上限を付ける
+		purgeCtx, cancel := context.WithTimeout(ctx, interval)
+		n, err := uc.PurgeExpired(purgeCtx, retention)
+		cancel()
+		if err != nil {
+			logger.Warn("trash purge failed", zap.Int64("purged", n), zap.Error(err))
+		}
+	}
+}
+
+// runIdempotencyKeyPurger は interval ごとに、期限切れの冪等キーを削除する（期限切れのキーは読まれないので、掃除だけ）。
+func runIdempotencyKeyPurger(ctx context.Context, uc idempotency_usecase.Usecase, logger *zap.Logger, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		purgeCtx, cancel := context.WithTimeout(ctx, interval)
+		n, err := uc.PurgeExpired(purgeCtx)
+		cancel()
+		if err != nil {
+			logger.Warn("idempotency key purge failed", zap.Int64("purged", n), zap.Error(err))
+		}
+	}
+}
+
 //----------------------
 // main
 //----------------------
@@ -149,6 +234,10 @@ func main() {
 		zap.String("db_name", cfg.DB.Name),
 		zap.String("otel_exporter_endpoint", cfg.OTELExporterEndpoint),
 		zap.Duration("grpc_request_timeout", cfg.GRPCRequestTimeout),
+		zap.Duration("trash_retention", cfg.TrashRetention),
+		zap.Duration("trash_purge_interval", cfg.TrashPurgeInterval),
+		zap.Duration("idempotency_key_ttl", cfg.IdempotencyKeyTTL),
+		zap.Duration("idempotency_purge_interval", cfg.IdempotencyKeyPurgeInterval),
 	)
 
 	// ---- DB 接続 ----
@@ -177,18 +266,25 @@ func main() {
 	// ---- Auth（JWT）----
 	authz := auth.NewAuthenticator(logger, cfg.AuthSecret)
 
+	// ---- 冪等キー ----
+	idemRepo := mysqlrepo.NewIdempotencyRepository(db, logger)
+	idemUC := idempotency_usecase.New(idemRepo, cfg.IdempotencyKeyTTL, logger)
+
 	// ---- gRPC Server + Interceptor ----
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		grpcadapter.NewRecoveryUnaryInterceptor(logger),
 		grpcadapter.NewTimeoutUnaryInterceptor(cfg.GRPCRequestTimeout),
 		grpcadapter.NewLoggingUnaryInterceptor(logger),
 		grpcadapter.NewAuthUnaryInterceptor(logger, authz),
+		// 認証済みのユーザーごとにキーを分けるので Auth の後ろ
+		grpcadapter.NewIdempotencyUnaryInterceptor(logger, idemUC),
 	}
 
 	streamInterceptors := []grpc.StreamServerInterceptor{
 		grpcadapter.NewRecoveryStreamInterceptor(logger),
 		grpcadapter.NewTimeoutStreamInterceptor(cfg.GRPCRequestTimeout),
 		grpcadapter.NewLoggingStreamInterceptor(logger),
+		grpcadapter.NewAuthStreamInterceptor(logger, authz),
 	}
 
 	grpcServer := grpc.NewServer(
@@ -202,13 +298,36 @@ func main() {
 	reflection.Register(grpcServer)
 
 	// ---- Todo Service ----
-	var repo domain_todo.Repository = mysqlrepo.NewTodoRepository(db, logger)
-	uc := todo_usecase.New(repo, txMgr, logger)
+	todoRepo := mysqlrepo.NewTodoRepository(db, logger)
+	var repo domain_todo.Repository = todoRepo
+	var searchIndex domain_todo.SearchIndex = mysqlrepo.NewTodoSearchIndex(todoRepo)
+	if cfg.SearchIndex == "memory" {
+		searchIndex = memsearch.New()
+	}
+	// ログインしたユーザーを記録し、担当者の存在確認に使う（AuthHandler と共用）
+	userRepo := mysqlrepo.NewUserRepository(db, logger)
+	// 変更と冪等キーの行を同じ Tx でコミットする
+	uc := todo_usecase.New(repo, idempotency_usecase.NewTxManager(txMgr, idemRepo), logger,
+		todo_usecase.WithPageTokenKey([]byte(cfg.PageTokenSecret)),
+		todo_usecase.WithTodoListRepository(mysqlrepo.NewTodoListRepository(db, logger)),
+		todo_usecase.WithAuditContext(grpcadapter.UserIDFromContext, grpcadapter.RequestIDFromContext),
+		// WatchTodos 用。プロセス内のブローカーなので、レプリカを跨いだ変更は届かない
+		todo_usecase.WithEventBroker(eventbus.NewBroker(logger)),
+		todo_usecase.WithSearchIndex(searchIndex),
+		todo_usecase.WithFeedTokenRepository(mysqlrepo.NewFeedTokenRepository(db, logger)),
+		todo_usecase.WithGrantRepository(mysqlrepo.NewGrantRepository(db, logger)),
+		todo_usecase.WithUserDirectory(userRepo),
+		todo_usecase.WithCommentRepository(mysqlrepo.NewCommentRepository(db, logger)),
+	)
 	handler := grpcadapter.NewTodoHandler(uc)
 	todov1.RegisterTodoServiceServer(grpcServer, handler)
+	todov1.RegisterTodoListServiceServer(grpcServer, grpcadapter.NewTodoListHandler(uc))
+
+	go runTrashPurger(ctx, uc, logger, cfg.TrashRetention, cfg.TrashPurgeInterval)
+	go runIdempotencyKeyPurger(ctx, idemUC, logger, cfg.IdempotencyKeyPurgeInterval)
 
 	// ---- Auth Service ----
-	authHandler := grpcadapter.NewAuthHandler(logger, cfg.AuthSecret)
+	authHandler := grpcadapter.NewAuthHandler(logger, cfg.AuthSecret, userRepo)
 	authv1.RegisterAuthServiceServer(grpcServer, authHandler)
 
 	// ---- metrics HTTP サーバ (/metrics) ----
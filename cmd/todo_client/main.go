@@ -12,16 +12,34 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func main() {
 	addr := flag.String("addr", "localhost:50051", "gRPC server address")
-	mode := flag.String("mode", "list", "mode: create | list | delete | update")
+	mode := flag.String("mode", "list", "mode: create | get | list | delete | update")
 	title := flag.String("title", "", "title for create/update")
-	id := flag.Int64("id", 0, "todo id (delete/update 共通)")
+	id := flag.Int64("id", 0, "todo id (get/delete/update 共通)")
 	done := flag.Bool("done", false, "done flag (update 用)")
+	pageSize := flag.Int("page-size", 0, "page size (list 用, 0 ならサーバのデフォルト)")
+	pageToken := flag.String("page-token", "", "page token (list 用)")
+	due := flag.String("due", "", "due date, RFC 3339 (create/update 用, update で空文字なら期限なし)")
+	priority := flag.Int("priority", 0, "priority 0-3 (create/update 用)")
+	notes := flag.String("notes", "", "notes (create/update 用)")
+	overdue := flag.Bool("overdue", false, "期限切れのみ (list 用)")
+	dueWithin := flag.Int("due-within", 0, "期限が N 日以内のもののみ (list 用)")
 	flag.Parse()
 
+	var dueAt *timestamppb.Timestamp
+	if *due != "" {
+		t, err := time.Parse(time.RFC3339, *due)
+		if err != nil {
+			log.Fatalf("invalid -due: %v", err)
+		}
+		dueAt = timestamppb.New(t)
+	}
+
 	conn, err := grpc.Dial(
 		*addr,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -42,7 +60,10 @@ func main() {
 			log.Fatal("title is required for create")
 		}
 		res, err := client.CreateTodo(ctx, &todov1.CreateTodoRequest{
-			Title: *title,
+			Title:    *title,
+			DueAt:    dueAt,
+			Priority: todov1.Priority(*priority),
+			Notes:    *notes,
 		})
 		if err != nil {
 			st, ok := status.FromError(err)
@@ -53,8 +74,29 @@ func main() {
 		}
 		fmt.Printf("created: id=%d title=%s done=%v\n", res.GetId(), res.GetTitle(), res.GetDone())
 
+	case "get":
+		if *id == 0 {
+			log.Fatal("id is required for get")
+		}
+		res, err := client.GetTodo(ctx, &todov1.GetTodoRequest{
+			Id: *id,
+		})
+		if err != nil {
+			st, ok := status.FromError(err)
+			if ok {
+				log.Fatalf("GetTodo failed: code=%s msg=%s", st.Code(), st.Message())
+			}
+			log.Fatalf("GetTodo failed: %v", err)
+		}
+		fmt.Printf("todo: id=%d title=%s done=%v\n", res.GetId(), res.GetTitle(), res.GetDone())
+
 	case "list":
-		res, err := client.ListTodos(ctx, &todov1.ListTodosRequest{})
+		res, err := client.ListTodos(ctx, &todov1.ListTodosRequest{
+			PageSize:      int32(*pageSize),
+			PageToken:     *pageToken,
+			Overdue:       *overdue,
+			DueWithinDays: int32(*dueWithin),
+		})
 		if err != nil {
 			log.Fatalf("ListTodos failed: %v", err)
 		}
@@ -66,6 +108,9 @@ func main() {
 		for _, t := range res.GetTodos() {
 			fmt.Printf("- id=%d title=%s done=%v\n", t.GetId(), t.GetTitle(), t.GetDone())
 		}
+		if next := res.GetNextPageToken(); next != "" {
+			fmt.Printf("next page token: %s\n", next)
+		}
 
 	case "delete":
 		if *id == 0 {
@@ -84,10 +129,28 @@ func main() {
 		fmt.Printf("delete result: ok=%v\n", res.GetOk())
 
 	case "update":
+		// 明示的に指定されたフラグだけを update_mask に載せる
 		req := &todov1.UpdateTodoRequest{
-			Id:    *id,
-			Title: *title,
-			Done:  *done,
+			Id: *id,
+			Todo: &todov1.Todo{
+				Title:    *title,
+				Done:     *done,
+				DueAt:    dueAt,
+				Priority: todov1.Priority(*priority),
+				Notes:    *notes,
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{},
+		}
+		flag.Visit(func(f *flag.Flag) {
+			switch f.Name {
+			case "title", "done", "priority", "notes":
+				req.UpdateMask.Paths = append(req.UpdateMask.Paths, f.Name)
+			case "due":
+				req.UpdateMask.Paths = append(req.UpdateMask.Paths, "due_at")
+			}
+		})
+		if len(req.UpdateMask.Paths) == 0 {
+			log.Fatal("title, done, due, priority or notes is required for update")
 		}
 		resp, err := client.UpdateTodo(ctx, req)
 		if err != nil {
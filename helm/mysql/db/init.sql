@@ -1,8 +1,142 @@
+CREATE TABLE IF NOT EXISTS todo_lists (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  owner_id VARCHAR(255) NOT NULL,
+  name VARCHAR(100) NOT NULL,
+  archived_at DATETIME NULL DEFAULT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  KEY idx_todo_lists_owner_name (owner_id, name, id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
 CREATE TABLE IF NOT EXISTS todos (
   id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  owner_id VARCHAR(255) NOT NULL,
+  assignee_id VARCHAR(255) NULL DEFAULT NULL,
+  list_id BIGINT UNSIGNED NULL DEFAULT NULL,
+  title VARCHAR(255) NOT NULL,
+  done TINYINT(1) NOT NULL DEFAULT 0,
+  due_at DATETIME NULL DEFAULT NULL,
+  priority TINYINT UNSIGNED NOT NULL DEFAULT 0,
+  notes TEXT NOT NULL,
+  auto_complete TINYINT(1) NOT NULL DEFAULT 0,
+  position BIGINT NOT NULL DEFAULT 0,
+  recurrence VARCHAR(255) NOT NULL DEFAULT '',
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  version BIGINT UNSIGNED NOT NULL DEFAULT 1,
+  deleted_at DATETIME NULL DEFAULT NULL,
+  PRIMARY KEY (id),
+  KEY idx_todos_owner_id (owner_id, id),
+  KEY idx_todos_owner_created_at (owner_id, created_at, id),
+  KEY idx_todos_owner_updated_at (owner_id, updated_at, id),
+  KEY idx_todos_owner_title (owner_id, title, id),
+  KEY idx_todos_owner_due_at (owner_id, due_at),
+  KEY idx_todos_deleted_at (deleted_at),
+  KEY idx_todos_owner_list (owner_id, list_id, id),
+  KEY idx_todos_owner_position (owner_id, position, id),
+  KEY idx_todos_assignee (assignee_id, id),
+  FULLTEXT KEY ft_todos_title_notes (title, notes) WITH PARSER ngram,
+  CONSTRAINT fk_todos_list FOREIGN KEY (list_id) REFERENCES todo_lists (id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS labels (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  owner_id VARCHAR(255) NOT NULL,
+  name VARCHAR(64) NOT NULL,
+  color CHAR(7) NOT NULL DEFAULT '',
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE KEY uq_labels_owner_name (owner_id, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS todo_labels (
+  todo_id BIGINT UNSIGNED NOT NULL,
+  label_id BIGINT UNSIGNED NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (todo_id, label_id),
+  KEY idx_todo_labels_label_id (label_id, todo_id),
+  CONSTRAINT fk_todo_labels_todo FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE,
+  CONSTRAINT fk_todo_labels_label FOREIGN KEY (label_id) REFERENCES labels (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS todo_checklist_items (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  todo_id BIGINT UNSIGNED NOT NULL,
   title VARCHAR(255) NOT NULL,
   done TINYINT(1) NOT NULL DEFAULT 0,
+  position INT UNSIGNED NOT NULL,
   created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
   updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
-  PRIMARY KEY (id)
+  PRIMARY KEY (id),
+  KEY idx_todo_checklist_items_todo_position (todo_id, position),
+  CONSTRAINT fk_todo_checklist_items_todo FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS todo_history (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  owner_id VARCHAR(255) NOT NULL,
+  todo_id BIGINT UNSIGNED NOT NULL,
+  action TINYINT UNSIGNED NOT NULL,
+  actor VARCHAR(255) NOT NULL,
+  request_id VARCHAR(255) NOT NULL DEFAULT '',
+  before_snapshot JSON NULL DEFAULT NULL,
+  after_snapshot JSON NULL DEFAULT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  KEY idx_todo_history_owner_todo (owner_id, todo_id, id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+  owner_id VARCHAR(255) NOT NULL,
+  idempotency_key VARCHAR(255) CHARACTER SET ascii COLLATE ascii_bin NOT NULL,
+  method VARCHAR(255) NOT NULL,
+  request_hash BINARY(32) NOT NULL,
+  response MEDIUMBLOB NULL DEFAULT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  expires_at DATETIME NOT NULL,
+  PRIMARY KEY (owner_id, idempotency_key),
+  KEY idx_idempotency_keys_expires_at (expires_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS calendar_feed_tokens (
+  owner_id VARCHAR(255) NOT NULL,
+  token_hash BINARY(32) NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (owner_id),
+  UNIQUE KEY uq_calendar_feed_tokens_token_hash (token_hash)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS todo_grants (
+  resource_type TINYINT UNSIGNED NOT NULL,
+  resource_id BIGINT UNSIGNED NOT NULL,
+  owner_id VARCHAR(255) NOT NULL,
+  grantee_id VARCHAR(255) NOT NULL,
+  role TINYINT UNSIGNED NOT NULL,
+  granted_by VARCHAR(255) NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (resource_type, resource_id, grantee_id),
+  KEY idx_todo_grants_grantee (grantee_id, created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS users (
+  user_id VARCHAR(255) NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  last_login_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (user_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS todo_comments (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  todo_id BIGINT UNSIGNED NOT NULL,
+  author_id VARCHAR(255) NOT NULL,
+  body TEXT NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  edited TINYINT(1) NOT NULL DEFAULT 0,
+  PRIMARY KEY (id),
+  KEY idx_todo_comments_todo (todo_id, id),
+  CONSTRAINT fk_todo_comments_todo FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,7 @@
+-- 既存の Todo は owner_id が空になり、誰からも見えない。README.md の手順で所有者を埋めること。
+ALTER TABLE todos
+  ADD COLUMN owner_id VARCHAR(255) NOT NULL DEFAULT '' AFTER id,
+  ADD KEY idx_todos_owner_id (owner_id, id);
+
+ALTER TABLE todos
+  ALTER COLUMN owner_id DROP DEFAULT;
@@ -0,0 +1,4 @@
+ALTER TABLE todos
+  ADD KEY idx_todos_owner_created_at (owner_id, created_at, id),
+  ADD KEY idx_todos_owner_updated_at (owner_id, updated_at, id),
+  ADD KEY idx_todos_owner_title (owner_id, title, id);
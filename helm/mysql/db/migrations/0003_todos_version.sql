@@ -0,0 +1,2 @@
+ALTER TABLE todos
+  ADD COLUMN version BIGINT UNSIGNED NOT NULL DEFAULT 1 AFTER updated_at;
@@ -0,0 +1,5 @@
+ALTER TABLE todos
+  ADD COLUMN due_at DATETIME NULL DEFAULT NULL AFTER done,
+  ADD COLUMN priority TINYINT UNSIGNED NOT NULL DEFAULT 0 AFTER due_at,
+  ADD COLUMN notes TEXT NOT NULL AFTER priority,
+  ADD KEY idx_todos_owner_due_at (owner_id, due_at);
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS labels (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  owner_id VARCHAR(255) NOT NULL,
+  name VARCHAR(64) NOT NULL,
+  color CHAR(7) NOT NULL DEFAULT '',
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  UNIQUE KEY uq_labels_owner_name (owner_id, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS todo_labels (
+  todo_id BIGINT UNSIGNED NOT NULL,
+  label_id BIGINT UNSIGNED NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (todo_id, label_id),
+  KEY idx_todo_labels_label_id (label_id, todo_id),
+  CONSTRAINT fk_todo_labels_todo FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE,
+  CONSTRAINT fk_todo_labels_label FOREIGN KEY (label_id) REFERENCES labels (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS todo_lists (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  owner_id VARCHAR(255) NOT NULL,
+  name VARCHAR(100) NOT NULL,
+  archived_at DATETIME NULL DEFAULT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  KEY idx_todo_lists_owner_name (owner_id, name, id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+ALTER TABLE todos
+  ADD COLUMN list_id BIGINT UNSIGNED NULL DEFAULT NULL AFTER owner_id,
+  ADD KEY idx_todos_owner_list (owner_id, list_id, id),
+  ADD CONSTRAINT fk_todos_list FOREIGN KEY (list_id) REFERENCES todo_lists (id) ON DELETE SET NULL;
@@ -0,0 +1,15 @@
+ALTER TABLE todos
+  ADD COLUMN auto_complete TINYINT(1) NOT NULL DEFAULT 0 AFTER notes;
+
+CREATE TABLE IF NOT EXISTS todo_checklist_items (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  todo_id BIGINT UNSIGNED NOT NULL,
+  title VARCHAR(255) NOT NULL,
+  done TINYINT(1) NOT NULL DEFAULT 0,
+  position INT UNSIGNED NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  KEY idx_todo_checklist_items_todo_position (todo_id, position),
+  CONSTRAINT fk_todo_checklist_items_todo FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
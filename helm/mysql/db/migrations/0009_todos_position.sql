@@ -0,0 +1,6 @@
+ALTER TABLE todos
+  ADD COLUMN position BIGINT NOT NULL DEFAULT 0 AFTER auto_complete,
+  ADD KEY idx_todos_owner_position (owner_id, position, id);
+
+-- 既存の Todo は作成順のまま PositionGap（65536）間隔に並べる
+UPDATE todos SET position = id * 65536;
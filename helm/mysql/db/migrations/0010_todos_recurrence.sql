@@ -0,0 +1,2 @@
+ALTER TABLE todos
+  ADD COLUMN recurrence VARCHAR(255) NOT NULL DEFAULT '' AFTER position;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS todo_history (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  owner_id VARCHAR(255) NOT NULL,
+  todo_id BIGINT UNSIGNED NOT NULL,
+  action TINYINT UNSIGNED NOT NULL,
+  actor VARCHAR(255) NOT NULL,
+  request_id VARCHAR(255) NOT NULL DEFAULT '',
+  before_snapshot JSON NULL DEFAULT NULL,
+  after_snapshot JSON NULL DEFAULT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  KEY idx_todo_history_owner_todo (owner_id, todo_id, id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
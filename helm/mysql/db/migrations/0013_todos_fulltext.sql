@@ -0,0 +1,2 @@
+ALTER TABLE todos
+  ADD FULLTEXT KEY ft_todos_title_notes (title, notes) WITH PARSER ngram;
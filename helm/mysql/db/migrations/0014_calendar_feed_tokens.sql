@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS calendar_feed_tokens (
+  owner_id VARCHAR(255) NOT NULL,
+  token_hash BINARY(32) NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (owner_id),
+  UNIQUE KEY uq_calendar_feed_tokens_token_hash (token_hash)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS todo_grants (
+  resource_type TINYINT UNSIGNED NOT NULL,
+  resource_id BIGINT UNSIGNED NOT NULL,
+  owner_id VARCHAR(255) NOT NULL,
+  grantee_id VARCHAR(255) NOT NULL,
+  role TINYINT UNSIGNED NOT NULL,
+  granted_by VARCHAR(255) NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (resource_type, resource_id, grantee_id),
+  KEY idx_todo_grants_grantee (grantee_id, created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
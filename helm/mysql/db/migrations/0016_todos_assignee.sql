@@ -0,0 +1,10 @@
+ALTER TABLE todos
+  ADD COLUMN assignee_id VARCHAR(255) NULL DEFAULT NULL AFTER owner_id,
+  ADD KEY idx_todos_assignee (assignee_id, id);
+
+CREATE TABLE IF NOT EXISTS users (
+  user_id VARCHAR(255) NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  last_login_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (user_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
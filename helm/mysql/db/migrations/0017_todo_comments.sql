@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS todo_comments (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  todo_id BIGINT UNSIGNED NOT NULL,
+  author_id VARCHAR(255) NOT NULL,
+  body TEXT NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  edited TINYINT(1) NOT NULL DEFAULT 0,
+  PRIMARY KEY (id),
+  KEY idx_todo_comments_todo (todo_id, id),
+  CONSTRAINT fk_todo_comments_todo FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
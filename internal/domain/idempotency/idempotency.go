@@ -0,0 +1,64 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+)
+
+// MaxKeyLength は冪等キーの最大長（バイト数）
+const MaxKeyLength = 255
+
+var (
+	ErrNotFound   = errors.New("idempotency key not found")
+	ErrKeyInUse   = errors.New("idempotency key is already in use")
+	ErrInvalidKey = errors.New("invalid idempotency key")
+)
+
+// Record は冪等キー 1 件。キーは所有者ごとに一意で、ExpiresAt を過ぎたものは無いものとして扱う。
+type Record struct {
+	OwnerID     string
+	Key         string
+	Method      string // gRPC のフルメソッド名
+	RequestHash []byte // メソッドとリクエストの SHA-256（同じキーで別のリクエストを送っていないかの確認用）
+	Response    []byte // 記録した結果。nil ならまだ記録していない（処理中、または結果を記録する前に落ちた）
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+// Completed は結果を記録済みかどうか。
+func (r *Record) Completed() bool {
+	return r.Response != nil
+}
+
+// Matches は r が method / requestHash と同じリクエストのものかどうか。
+func (r *Record) Matches(method string, requestHash []byte) bool {
+	return r.Method == method && bytes.Equal(r.RequestHash, requestHash)
+}
+
+// ValidateKey はキーが 1〜MaxKeyLength バイトの印字可能な ASCII かどうかを確認する。
+func ValidateKey(key string) error {
+	if key == "" || len(key) > MaxKeyLength {
+		return ErrInvalidKey
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < 0x20 || key[i] > 0x7e {
+			return ErrInvalidKey
+		}
+	}
+	return nil
+}
+
+// 冪等キーのリポジトリインターフェース。ownerID でスコープされる。
+type Repository interface {
+	// Get は期限内（now より後に期限が来る）のキーを返す。無ければ ErrNotFound。
+	Get(ctx context.Context, ownerID, key string, now time.Time) (*Record, error)
+	// Insert は r を追加し、r.CreatedAt を埋める。期限内の同じキーがあれば ErrKeyInUse（期限切れの行は置き換える）。
+	// Tx 内で呼ぶと、その Tx が終わるまで同じキーの Insert は待たされる。
+	Insert(ctx context.Context, r *Record) error
+	// SaveResponse は結果を記録する。
+	SaveResponse(ctx context.Context, ownerID, key string, response []byte) error
+	// DeleteExpired は owner を跨いで、before までに期限が切れたキーを最大 limit 件削除する。戻り値は削除した件数。
+	DeleteExpired(ctx context.Context, before time.Time, limit int) (int64, error)
+}
@@ -0,0 +1,34 @@
+package todo
+
+import "errors"
+
+// 担当者。作成した人（所有者）とは別に、Todo を 1 人に割り当てられる。
+// 担当者は所有者か、Todo（か Todo の入っているリスト）を共有された人で、UserDirectory にいるユーザーでなければならない
+// （確認は usecase で行う）。担当を割り当てても共有（share.go）はされないので、共有をやめると担当者からも見えなくなる。
+
+// Assign は担当者を userID にする。
+func (t *Todo) Assign(userID string) error {
+	if err := ValidateOwnerID(userID); err != nil {
+		return ErrInvalidAssignee
+	}
+	t.AssigneeID = userID
+	return nil
+}
+
+// Unassign は担当者を外す（未割り当てなら何もしない）。
+func (t *Todo) Unassign() {
+	t.AssigneeID = ""
+}
+
+// IsAssigned は担当者が割り当てられているかどうか。
+func (t *Todo) IsAssigned() bool {
+	return t.AssigneeID != ""
+}
+
+var (
+	// 担当者が空のときに使う共通エラー。
+	ErrInvalidAssignee = errors.New("invalid assignee")
+
+	// 担当者がユーザーとして存在しない、または Todo の所有者・コラボレーターでないときに使う共通エラー。
+	ErrAssigneeNotFound = errors.New("assignee not found")
+)
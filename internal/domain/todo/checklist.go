@@ -0,0 +1,173 @@
+package todo
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ChecklistItem は Todo の下にぶら下がるチェックリストの 1 項目（サブタスク）。
+// Todo 集約の一部なので、追加・並び替え・完了・削除はすべて Todo のメソッド経由で行う。
+type ChecklistItem struct {
+	ID        int64 // 未保存なら 0
+	Title     string
+	Done      bool
+	Position  int // 表示順（0 始まり、Todo の中で連番）
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+const (
+	// MaxChecklistItems は 1 つの Todo に付けられる項目の上限。
+	MaxChecklistItems = 100
+
+	// MaxChecklistItemTitleLength は項目のタイトルの最大文字数（rune 単位）。
+	MaxChecklistItemTitleLength = 255
+)
+
+var (
+	// 項目のタイトルが空のときに使う共通エラー。
+	ErrEmptyItemTitle = errors.New("checklist item title must not be empty")
+
+	// 項目のタイトルが MaxChecklistItemTitleLength を超えるときに使う共通エラー。
+	ErrItemTitleTooLong = errors.New("checklist item title is too long")
+
+	// 対象の項目が Todo に無いときに使う共通エラー。
+	ErrItemNotFound = errors.New("checklist item not found")
+
+	// 項目が MaxChecklistItems を超えるときに使う共通エラー。
+	ErrTooManyItems = errors.New("too many checklist items")
+
+	// 並び替えの指定が「全項目をちょうど 1 回ずつ」になっていないときに使う共通エラー。
+	ErrInvalidItemOrder = errors.New("checklist item order must list every item exactly once")
+
+	// 未完了の項目が残っている Todo を完了にしようとしたときに使う共通エラー。
+	ErrChecklistIncomplete = errors.New("todo has unfinished checklist items")
+)
+
+// validateItemTitle は前後の空白を落としたタイトルを返す。
+func validateItemTitle(title string) (string, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return "", ErrEmptyItemTitle
+	}
+	if utf8.RuneCountInString(title) > MaxChecklistItemTitleLength {
+		return "", ErrItemTitleTooLong
+	}
+	return title, nil
+}
+
+// MarkDone は Todo の完了状態を変える。
+// 未完了の項目が残っている間は完了にできない（先に項目を片付けるか、外すこと）。
+func (t *Todo) MarkDone(done bool) error {
+	if done && !t.ChecklistDone() {
+		return ErrChecklistIncomplete
+	}
+	t.Done = done
+	return nil
+}
+
+// ChecklistDone は全項目が完了しているかどうか（項目が無ければ true）。
+func (t *Todo) ChecklistDone() bool {
+	for _, it := range t.Items {
+		if !it.Done {
+			return false
+		}
+	}
+	return true
+}
+
+// SetAutoComplete は「全項目が完了したら Todo も自動で完了にする」かどうかを設定する。
+func (t *Todo) SetAutoComplete(on bool) {
+	t.AutoComplete = on
+	t.syncDone()
+}
+
+// AddItem は末尾に未完了の項目を追加する。
+// 完了済みの Todo に追加した場合、未完了の項目ができるので Todo も未完了に戻る。
+func (t *Todo) AddItem(title string) error {
+	title, err := validateItemTitle(title)
+	if err != nil {
+		return err
+	}
+	if len(t.Items) >= MaxChecklistItems {
+		return ErrTooManyItems
+	}
+
+	t.Items = append(t.Items, ChecklistItem{Title: title, Position: len(t.Items)})
+	t.syncDone()
+	return nil
+}
+
+// ToggleItem は項目の完了状態を done にする（同じ値なら何もしない）。
+func (t *Todo) ToggleItem(itemID int64, done bool) error {
+	i, err := t.itemIndex(itemID)
+	if err != nil {
+		return err
+	}
+	t.Items[i].Done = done
+	t.syncDone()
+	return nil
+}
+
+// RemoveItem は項目を外し、残りの表示順を詰め直す。
+func (t *Todo) RemoveItem(itemID int64) error {
+	i, err := t.itemIndex(itemID)
+	if err != nil {
+		return err
+	}
+	t.Items = slices.Delete(t.Items, i, i+1)
+	t.renumberItems()
+	t.syncDone()
+	return nil
+}
+
+// ReorderItems は itemIDs の順に並べ替える。itemIDs は全項目をちょうど 1 回ずつ含むこと。
+func (t *Todo) ReorderItems(itemIDs []int64) error {
+	if len(itemIDs) != len(t.Items) {
+		return ErrInvalidItemOrder
+	}
+
+	reordered := make([]ChecklistItem, 0, len(t.Items))
+	seen := make(map[int64]bool, len(itemIDs))
+	for _, id := range itemIDs {
+		i, err := t.itemIndex(id)
+		if err != nil || seen[id] {
+			return ErrInvalidItemOrder
+		}
+		seen[id] = true
+		reordered = append(reordered, t.Items[i])
+	}
+
+	t.Items = reordered
+	t.renumberItems()
+	return nil
+}
+
+func (t *Todo) itemIndex(itemID int64) (int, error) {
+	i := slices.IndexFunc(t.Items, func(it ChecklistItem) bool { return it.ID == itemID })
+	if itemID <= 0 || i < 0 {
+		return 0, ErrItemNotFound
+	}
+	return i, nil
+}
+
+func (t *Todo) renumberItems() {
+	for i := range t.Items {
+		t.Items[i].Position = i
+	}
+}
+
+// syncDone は項目の状態に Todo の完了状態を合わせる。
+//   - 未完了の項目があれば Todo も未完了
+//   - AutoComplete が有効で、項目が 1 つ以上あり全て完了していれば Todo も完了
+func (t *Todo) syncDone() {
+	switch {
+	case !t.ChecklistDone():
+		t.Done = false
+	case t.AutoComplete && len(t.Items) > 0:
+		t.Done = true
+	}
+}
@@ -0,0 +1,83 @@
+package todo
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Comment は Todo に付くコメント（スレッド 1 本、古い順）。Todo 集約の外のサブリソースで、書いても Todo の version は進まない。
+// 本文を変えられるのは書いた本人だけ（RoleEditor 以上のまま）。削除は本人（RoleViewer に下げられていても）のほか、
+// Todo の所有者（RoleOwner で共有された人を含む）もできる。
+// Todo をゴミ箱に入れてもコメントは残り（復元すれば戻る）、物理削除するとまとめて消える。
+type Comment struct {
+	ID        int64
+	TodoID    int64
+	AuthorID  string // 書いた人（JWT の sub）
+	Body      string
+	CreatedAt time.Time
+	UpdatedAt time.Time // 本文を変えた時刻（変えていなければ CreatedAt と同じ）
+	Edited    bool      // 作成後に本文を変えたか（時刻は秒精度で、作成と同じ秒の編集もあるので比べずに持つ）
+}
+
+// MaxCommentLength はコメント本文の最大文字数（rune 単位）。
+const MaxCommentLength = 5000
+
+var (
+	// コメント本文が空（空白だけを含む）のときに使う共通エラー。
+	ErrEmptyComment = errors.New("comment body must not be empty")
+
+	// コメント本文が MaxCommentLength を超えるときに使う共通エラー。
+	ErrCommentTooLong = errors.New("comment body is too long")
+
+	// 対象のコメントが存在しない（または別の Todo のコメント）ときに使う共通エラー。
+	ErrCommentNotFound = errors.New("comment not found")
+)
+
+// NewComment は「新規作成用」のコンストラクタ。
+func NewComment(todoID int64, authorID, body string) (*Comment, error) {
+	if err := ValidateID(todoID); err != nil {
+		return nil, err
+	}
+	if err := ValidateOwnerID(authorID); err != nil {
+		return nil, err
+	}
+	if err := validateCommentBody(body); err != nil {
+		return nil, err
+	}
+	return &Comment{
+		TodoID:   todoID,
+		AuthorID: authorID,
+		Body:     body,
+	}, nil
+}
+
+// Edit は本文を変える。書いた本人（userID）でなければ ErrPermissionDenied。
+func (c *Comment) Edit(userID, body string) error {
+	if userID != c.AuthorID {
+		return ErrPermissionDenied
+	}
+	if err := validateCommentBody(body); err != nil {
+		return err
+	}
+	c.Body = body
+	c.Edited = true
+	return nil
+}
+
+func validateCommentBody(body string) error {
+	if strings.TrimSpace(body) == "" {
+		return ErrEmptyComment
+	}
+	if utf8.RuneCountInString(body) > MaxCommentLength {
+		return ErrCommentTooLong
+	}
+	return nil
+}
+
+// CommentQuery はコメント一覧の条件。古い順（ID の昇順）に返す。
+type CommentQuery struct {
+	Limit   int
+	AfterID int64 // 0 以外なら、この ID より新しいものだけ（ページング用）
+}
@@ -3,15 +3,64 @@ package todo
 import (
 	"errors"
 	"time"
+	"unicode/utf8"
 )
 
 // Todo は Todo 集約のルートエンティティ。
 type Todo struct {
 	ID        int64
+	OwnerID   string // 所有者（JWT の sub）。他人の Todo は見えない・触れない（共有されたものを除く。share.go）
+	ListID    int64  // 属するリスト（0 ならどのリストにも属さない）
 	Title     string
 	Done      bool
+	DueAt     time.Time // 期限（ゼロ値なら期限なし）
+	Priority  Priority
+	Notes     string
+	Labels    []Label // 付いているラベル（名前順）
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	Version   int64     // 楽観ロック用。保存のたびに 1 増える
+	DeletedAt time.Time // ゴミ箱に入れた時刻（ゼロ値なら削除されていない）
+
+	// チェックリスト（サブタスク）。Position 順。
+	// 未完了の項目が残っている間は Done にできない（checklist.go）。
+	Items        []ChecklistItem
+	AutoComplete bool // 全項目が完了したら Todo も自動で完了にする
+
+	// 手動の並び順（昇順）。意味を持つのは他の Todo との大小だけ（position.go）。
+	Position int64
+
+	// 繰り返しルール（ゼロ値なら繰り返さない）。完了にすると次の回が作られる（recurrence.go）。
+	Recurrence Recurrence
+
+	// 担当者（JWT の sub。空なら未割り当て）。作成した人（OwnerID）とは別に 1 人だけ割り当てられる（assignee.go）。
+	AssigneeID string
+}
+
+// Priority は Todo の優先度。ゼロ値は「未設定」。
+type Priority int32
+
+const (
+	PriorityUnspecified Priority = iota
+	PriorityLow
+	PriorityMedium
+	PriorityHigh
+)
+
+// Validate は定義済みの値かどうかをチェックする。
+func (p Priority) Validate() error {
+	if p < PriorityUnspecified || p > PriorityHigh {
+		return ErrInvalidPriority
+	}
+	return nil
+}
+
+// MaxNotesLength はメモの最大文字数（rune 単位）。
+const MaxNotesLength = 10000
+
+// IsDeleted はゴミ箱に入っている（論理削除済み）かどうか。
+func (t *Todo) IsDeleted() bool {
+	return !t.DeletedAt.IsZero()
 }
 
 // ---- ドメインエラー（sentinel error） ----
@@ -22,20 +71,43 @@ var (
 
 	// ID が 0 以下など不正なときに使う共通エラー。
 	ErrInvalidID = errors.New("todo id must be positive")
+
+	// 所有者が特定できない（未認証など）ときに使う共通エラー。
+	ErrEmptyOwner = errors.New("todo owner must not be empty")
+
+	// 対象の Todo が存在しない（または他人の Todo で見えない）ときに使う共通エラー。
+	// 存在自体を漏らさないため、両者は区別しない。
+	ErrNotFound = errors.New("todo not found")
+
+	// 楽観ロックで、期待した version と保存済みの version が一致しないときに使う共通エラー。
+	ErrVersionMismatch = errors.New("todo version mismatch")
+
+	// 優先度が定義済みの値でないときに使う共通エラー。
+	ErrInvalidPriority = errors.New("todo priority is out of range")
+
+	// 期限が作成日時より前のときに使う共通エラー。
+	ErrDueBeforeCreation = errors.New("todo due date must not be before creation")
+
+	// メモが MaxNotesLength を超えるときに使う共通エラー。
+	ErrNotesTooLong = errors.New("todo notes are too long")
 )
 
 // ---- ファクトリ / バリデーション ----
 
 // NewTodo は「新規作成用」のコンストラクタ。
-// 不変条件（タイトルが空でないこと）をここでチェックする。
-func NewTodo(title string) (*Todo, error) {
+// 不変条件（所有者・タイトルが空でないこと）をここでチェックする。
+func NewTodo(ownerID, title string) (*Todo, error) {
+	if err := ValidateOwnerID(ownerID); err != nil {
+		return nil, err
+	}
 	if title == "" {
 		return nil, ErrEmptyTitle
 	}
 
 	return &Todo{
-		Title: title,
-		Done:  false,
+		OwnerID: ownerID,
+		Title:   title,
+		Done:    false,
 	}, nil
 }
 
@@ -49,6 +121,52 @@ func (t *Todo) ChangeTitle(title string) error {
 	return nil
 }
 
+// ChangeDueAt は期限を設定する。ゼロ値は「期限なし」。
+// 期限は作成日時（未保存なら現在時刻）より前にはできない。
+// DB の created_at は秒精度なので、比較も秒単位で行う。
+func (t *Todo) ChangeDueAt(due time.Time) error {
+	if !due.IsZero() {
+		created := t.CreatedAt
+		if created.IsZero() {
+			created = time.Now()
+		}
+		if due.Before(created.Truncate(time.Second)) {
+			return ErrDueBeforeCreation
+		}
+	}
+	t.DueAt = due
+	return nil
+}
+
+// RestoreDueAt は取り込んだ（インポートした）Todo の期限を設定する。
+// 元のデータでは作成より前のこともある（期限切れ・完了済み）ので、ChangeDueAt の作成日時との比較はしない。
+func (t *Todo) RestoreDueAt(due time.Time) {
+	t.DueAt = due
+}
+
+// ChangePriority は優先度を変更する。
+func (t *Todo) ChangePriority(p Priority) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	t.Priority = p
+	return nil
+}
+
+// ChangeNotes はメモを変更する。空文字は「メモなし」。
+func (t *Todo) ChangeNotes(notes string) error {
+	if utf8.RuneCountInString(notes) > MaxNotesLength {
+		return ErrNotesTooLong
+	}
+	t.Notes = notes
+	return nil
+}
+
+// IsOverdue は now の時点で期限を過ぎた未完了の Todo かどうか。
+func (t *Todo) IsOverdue(now time.Time) bool {
+	return !t.Done && !t.DueAt.IsZero() && t.DueAt.Before(now)
+}
+
 // ValidateID は ID まわりの共通バリデーション。
 func ValidateID(id int64) error {
 	if id <= 0 {
@@ -56,3 +174,20 @@ func ValidateID(id int64) error {
 	}
 	return nil
 }
+
+// CheckVersion は expected が 0 以外のとき、保存済みの version と一致するかを確認する。
+// 0 は「チェックしない」の意味。
+func (t *Todo) CheckVersion(expected int64) error {
+	if expected != 0 && expected != t.Version {
+		return ErrVersionMismatch
+	}
+	return nil
+}
+
+// ValidateOwnerID は所有者まわりの共通バリデーション。
+func ValidateOwnerID(ownerID string) error {
+	if ownerID == "" {
+		return ErrEmptyOwner
+	}
+	return nil
+}
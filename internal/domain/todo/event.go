@@ -0,0 +1,53 @@
+package todo
+
+import "errors"
+
+// EventType は Todo の変更の種類（WatchTodos で配信する）。
+type EventType int32
+
+const (
+	EventTypeUnspecified EventType = iota
+	EventTypeCreated               // 作成（ゴミ箱から戻したものを含む）
+	EventTypeUpdated
+	EventTypeDeleted // ゴミ箱へ移動
+)
+
+// Event はコミットされた Todo の変更 1 件。
+type Event struct {
+	Seq     uint64 // ブローカーが振る通し番号（Publish で埋まる）
+	Type    EventType
+	OwnerID string
+	Todo    *Todo // 変更後の Todo（削除では削除前の Todo）。購読者間で共有するので書き換えないこと
+}
+
+// EventCursor はイベントの位置（Seq まで受け取った）。Epoch はブローカーを作り直す（プロセスを再起動する）たびに変わる。
+type EventCursor struct {
+	Epoch string
+	Seq   uint64
+}
+
+var (
+	ErrEventsExpired  = errors.New("events after the cursor are no longer available")
+	ErrSlowSubscriber = errors.New("subscriber fell behind and was dropped")
+)
+
+// EventBroker は Todo の変更をプロセス内で購読者に配る（別のプロセスで起きた変更は届かない）。
+type EventBroker interface {
+	// Publish はイベントに Seq を振って、同じ所有者の購読者に配る。
+	// 購読者を待たない（バッファが溢れた購読者は ErrSlowSubscriber で切る）。
+	Publish(events ...Event)
+	// Subscribe は ownerID のイベントの購読を始める。after が nil でなければその続きから流す
+	// （続きを保持していない・別の Epoch なら ErrEventsExpired）。
+	Subscribe(ownerID string, after *EventCursor) (EventSubscription, error)
+}
+
+// EventSubscription は 1 購読者分の購読。使い終わったら Close すること。
+type EventSubscription interface {
+	// Events は購読したイベント。切られた・Close した後は閉じる。
+	Events() <-chan Event
+	// Err は Events が閉じた理由（遅れて切られたなら ErrSlowSubscriber、Close なら nil）。
+	Err() error
+	// Start は購読を始めた位置（これより後のイベントが Events に来る）。
+	Start() EventCursor
+	Close()
+}
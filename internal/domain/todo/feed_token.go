@@ -0,0 +1,41 @@
+package todo
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+)
+
+// フィードトークン。Authorization ヘッダを送れないカレンダーアプリが、URL に入れて Todo のフィードを読むためのもの。
+// 所有者ごとに 1 つで、発行し直すと前のトークンは使えなくなる。
+// 保存するのは SHA-256 のハッシュだけで、トークンそのものは発行したときにしか分からない。
+
+// feedTokenBytes はトークンの乱数のバイト数（URL では base64url の 43 文字）
+const feedTokenBytes = 32
+
+var (
+	ErrFeedTokenNotFound = errors.New("feed token not found")
+	ErrInvalidFeedToken  = errors.New("invalid feed token")
+)
+
+// NewFeedToken はトークンを作り、保存用のハッシュと一緒に返す。
+func NewFeedToken() (token string, hash []byte, err error) {
+	b := make([]byte, feedTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, err
+	}
+	token = base64.RawURLEncoding.EncodeToString(b)
+	sum := sha256.Sum256([]byte(token))
+	return token, sum[:], nil
+}
+
+// HashFeedToken はトークンの形を確かめて、保存用のハッシュを返す。形が違えば ErrInvalidFeedToken。
+func HashFeedToken(token string) ([]byte, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(b) != feedTokenBytes {
+		return nil, ErrInvalidFeedToken
+	}
+	sum := sha256.Sum256([]byte(token))
+	return sum[:], nil
+}
@@ -0,0 +1,54 @@
+package todo
+
+import (
+	"slices"
+	"time"
+)
+
+// HistoryEntry は Todo の変更履歴 1 件。追記のみで、書き換え・削除はしない
+// （Todo を物理削除しても履歴は残る）。
+type HistoryEntry struct {
+	ID        int64
+	OwnerID   string // 対象の Todo の所有者（履歴を見られるのはこの人だけ）
+	TodoID    int64
+	Action    HistoryAction
+	Actor     string // 変更した人（JWT の sub。操作者のいない変更では SystemActor）
+	RequestID string // x-request-id（無ければ空）
+	Before    *Todo  // 変更前のスナップショット（作成・復元・物理削除では nil）
+	After     *Todo  // 変更後のスナップショット（削除・物理削除では nil）
+	CreatedAt time.Time
+}
+
+// SystemActor は操作者のいない変更（期限切れのゴミ箱の自動削除）の Actor。
+const SystemActor = "system"
+
+// HistoryAction は履歴の種類。
+type HistoryAction int32
+
+const (
+	HistoryActionUnspecified HistoryAction = iota
+	HistoryActionCreate
+	HistoryActionUpdate
+	HistoryActionDelete  // ゴミ箱へ移動
+	HistoryActionRestore // ゴミ箱から戻す
+	HistoryActionPurge   // 物理削除
+)
+
+// HistoryQuery は履歴一覧の条件。新しい順（ID の降順）に返す。
+type HistoryQuery struct {
+	Limit    int
+	BeforeID int64 // 0 以外なら、この ID より古いものだけ（ページング用）
+}
+
+// Snapshot は履歴に残すための複製を返す。
+// スライスも複製するので、この後 t を変更してもスナップショットは変わらない。
+func (t *Todo) Snapshot() *Todo {
+	if t == nil {
+		return nil
+	}
+	s := *t
+	s.Labels = slices.Clone(t.Labels)
+	s.Items = slices.Clone(t.Items)
+	s.Recurrence.ByWeekday = slices.Clone(t.Recurrence.ByWeekday)
+	return &s
+}
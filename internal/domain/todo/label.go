@@ -0,0 +1,121 @@
+package todo
+
+import (
+	"errors"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Label は Todo の分類用タグ。所有者ごとに名前が一意。
+// Todo とは多対多で、付け外しは Todo 側の操作として扱う（Todo の version が進む）。
+type Label struct {
+	ID        int64
+	OwnerID   string
+	Name      string
+	Color     string // "#rrggbb"。空なら未指定
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+const (
+	// MaxLabelNameLength はラベル名の最大文字数（rune 単位）。
+	MaxLabelNameLength = 64
+
+	// MaxLabelsPerTodo は 1 つの Todo に付けられるラベルの上限。
+	// 一覧のラベル絞り込みで指定できる数の上限も兼ねる。
+	MaxLabelsPerTodo = 20
+)
+
+var (
+	// ラベル名が空のときに使う共通エラー。
+	ErrEmptyLabelName = errors.New("label name must not be empty")
+
+	// ラベル名が MaxLabelNameLength を超えるときに使う共通エラー。
+	ErrLabelNameTooLong = errors.New("label name is too long")
+
+	// 色が "#rrggbb" 形式でないときに使う共通エラー。
+	ErrInvalidLabelColor = errors.New("label color must be #rrggbb")
+
+	// 対象のラベルが存在しない（または他人のラベルで見えない）ときに使う共通エラー。
+	ErrLabelNotFound = errors.New("label not found")
+
+	// 同じ所有者に同名のラベルが既にあるときに使う共通エラー。
+	ErrLabelAlreadyExists = errors.New("label already exists")
+
+	// Todo に付けるラベルが MaxLabelsPerTodo を超えるときに使う共通エラー。
+	ErrTooManyLabels = errors.New("too many labels")
+)
+
+var labelColorPattern = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
+// NewLabel は「新規作成用」のコンストラクタ。
+// 名前の前後の空白は落とし、色は小文字に揃える。
+func NewLabel(ownerID, name, color string) (*Label, error) {
+	if err := ValidateOwnerID(ownerID); err != nil {
+		return nil, err
+	}
+
+	l := &Label{OwnerID: ownerID}
+	if err := l.Rename(name); err != nil {
+		return nil, err
+	}
+	if err := l.ChangeColor(color); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Rename はラベル名を変更する。
+func (l *Label) Rename(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrEmptyLabelName
+	}
+	if utf8.RuneCountInString(name) > MaxLabelNameLength {
+		return ErrLabelNameTooLong
+	}
+	l.Name = name
+	return nil
+}
+
+// ChangeColor は色を変更する。空文字は「未指定」。
+func (l *Label) ChangeColor(color string) error {
+	color = strings.ToLower(color)
+	if color != "" && !labelColorPattern.MatchString(color) {
+		return ErrInvalidLabelColor
+	}
+	l.Color = color
+	return nil
+}
+
+// LabelIDs は t に付いているラベルの ID（昇順）を返す。
+func (t *Todo) LabelIDs() []int64 {
+	ids := make([]int64, 0, len(t.Labels))
+	for _, l := range t.Labels {
+		ids = append(ids, l.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// NormalizeLabelIDs は重複を除いて昇順に並べ、ID と件数の上限をチェックする。
+// 戻り値は nil にならない（空のスライスは「ラベルなし」の意味）。
+func NormalizeLabelIDs(ids []int64) ([]int64, error) {
+	out := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if err := ValidateID(id); err != nil {
+			return nil, err
+		}
+		out = append(out, id)
+	}
+	slices.Sort(out)
+	out = slices.Compact(out)
+
+	if len(out) > MaxLabelsPerTodo {
+		return nil, ErrTooManyLabels
+	}
+	return out, nil
+}
@@ -0,0 +1,34 @@
+package todo
+
+import "errors"
+
+// 手動の並び順は「隙間あり」の整数で持つ（gap-based）。
+// 新しい Todo は末尾に PositionGap 空けて置き、間に移動するときは前後の中間の値を使う。
+// 隙間が無くなったら（前後が隣り合う整数になったら）、所有者の Todo 全体を PositionGap 間隔に振り直す。
+// 振り直しは並び順だけの変更なので、各 Todo の version は進めない。
+
+// PositionGap は振り直し・末尾追加のときの間隔。
+const PositionGap int64 = 1 << 16
+
+// ErrInvalidMoveAnchor は並び替えの基準（before / after）の指定が不正なときに使う共通エラー。
+// 両方指定した・自分自身を指定した、など。
+var ErrInvalidMoveAnchor = errors.New("invalid move anchor")
+
+// PositionAfter は last の後ろ（末尾）に置くときの位置。
+func PositionAfter(last int64) int64 {
+	return last + PositionGap
+}
+
+// PositionBefore は first の前（先頭）に置くときの位置。
+func PositionBefore(first int64) int64 {
+	return first - PositionGap
+}
+
+// PositionBetween は lo と hi の間に置くときの位置を返す。
+// 間に整数が無い（振り直しが必要な）ときは ok=false。
+func PositionBetween(lo, hi int64) (pos int64, ok bool) {
+	if hi-lo < 2 {
+		return 0, false
+	}
+	return lo + (hi-lo)/2, true
+}
@@ -0,0 +1,181 @@
+package todo
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// ListQuery は一覧取得の条件。
+// ページングは keyset 方式（OFFSET を使わない）で、After の位置より後ろを OrderBy の順で返す。
+type ListQuery struct {
+	Filter  ListFilter
+	OrderBy OrderBy
+	After   *ListCursor // nil なら先頭から
+	Limit   int         // 最大件数（0 以下なら上限なし）
+
+	// Now は期限まわりの絞り込み（Overdue / DueWithinDays）の基準時刻。
+	// ページを跨いで条件が変わらないよう Filter には含めず、取得のたびに渡す。
+	Now time.Time
+}
+
+// ListFilter は一覧の絞り込み条件。ゼロ値の項目は「条件なし」。
+type ListFilter struct {
+	Deleted       bool   // false: 通常の一覧 / true: ゴミ箱（論理削除済み）の一覧
+	Done          *bool  // nil: すべて / true: 完了のみ / false: 未完了のみ
+	ListID        *int64 // nil: すべて / 0: どのリストにも属さないもの / それ以外: そのリストのもの
+	TitleContains string // タイトルの部分一致
+
+	// 時刻範囲はいずれも [After, Before) の半開区間
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	UpdatedAfter  time.Time
+	UpdatedBefore time.Time
+
+	// 期限まわり（基準時刻は ListQuery.Now）
+	Overdue       bool // 期限切れ（期限 < 現在）かつ未完了のみ
+	DueWithinDays int  // 0 以外なら、期限が [現在, 現在 + N 日) のものだけ
+
+	// ラベル（空なら条件なし）。LabelMatch で「いずれか」か「すべて」かを選ぶ
+	LabelIDs   []int64
+	LabelMatch LabelMatch
+
+	// 担当者。true なら所有者を問わず、一覧を見る人が担当の Todo（自分のものと共有されたもの）だけ
+	AssignedToMe bool
+}
+
+// LabelMatch はラベル絞り込みで、複数のラベルをどう組み合わせるか。
+type LabelMatch int
+
+const (
+	LabelMatchAny LabelMatch = iota // いずれかのラベルが付いている
+	LabelMatchAll                   // すべてのラベルが付いている
+)
+
+// DueWindow は DueWithinDays を now 基準の [from, to) に変換する。
+func (f ListFilter) DueWindow(now time.Time) (from, to time.Time) {
+	return now, now.AddDate(0, 0, f.DueWithinDays)
+}
+
+// Validate は範囲指定の逆転や、両立しない条件の組み合わせがないかをチェックする。
+func (f ListFilter) Validate() error {
+	if !f.CreatedAfter.IsZero() && !f.CreatedBefore.IsZero() && !f.CreatedAfter.Before(f.CreatedBefore) {
+		return ErrInvalidFilter
+	}
+	if !f.UpdatedAfter.IsZero() && !f.UpdatedBefore.IsZero() && !f.UpdatedAfter.Before(f.UpdatedBefore) {
+		return ErrInvalidFilter
+	}
+	if f.DueWithinDays < 0 {
+		return ErrInvalidFilter
+	}
+	if f.ListID != nil && *f.ListID < 0 {
+		return ErrInvalidFilter
+	}
+	if _, err := NormalizeLabelIDs(f.LabelIDs); err != nil {
+		return ErrInvalidFilter
+	}
+	if f.LabelMatch != LabelMatchAny && f.LabelMatch != LabelMatchAll {
+		return ErrInvalidFilter
+	}
+	// ゴミ箱は自分の Todo だけなので、担当の絞り込みとは組み合わせない
+	if f.AssignedToMe && f.Deleted {
+		return ErrInvalidFilter
+	}
+	// 期限切れと「これから期限」は重ならない。期限切れは未完了だけが対象
+	if f.Overdue && (f.DueWithinDays > 0 || (f.Done != nil && *f.Done)) {
+		return ErrInvalidFilter
+	}
+	return nil
+}
+
+// OrderField は並び替えに使えるフィールド（ホワイトリスト）。
+type OrderField string
+
+const (
+	OrderByID        OrderField = "id"
+	OrderByCreatedAt OrderField = "created_at"
+	OrderByUpdatedAt OrderField = "updated_at"
+	OrderByTitle     OrderField = "title"
+	OrderByPosition  OrderField = "position"
+)
+
+// OrderBy は並び順。同じ値の行は ID で同じ向きにタイブレークする。
+type OrderBy struct {
+	Field OrderField
+	Desc  bool
+}
+
+// DefaultOrderBy は order_by 未指定時の並び順（ユーザーが並べた順 = position 昇順）。
+var DefaultOrderBy = OrderBy{Field: OrderByPosition}
+
+var (
+	// order_by がホワイトリスト外・書式不正のときに使う共通エラー。
+	ErrInvalidOrderBy = errors.New("invalid order_by")
+
+	// 絞り込み条件が矛盾しているときに使う共通エラー。
+	ErrInvalidFilter = errors.New("invalid filter")
+)
+
+// ParseOrderBy は "created_at desc" のような文字列を OrderBy に変換する。
+// 空文字は DefaultOrderBy。フィールドはホワイトリストにあるものだけ受け付ける。
+func ParseOrderBy(s string) (OrderBy, error) {
+	parts := strings.Fields(strings.ToLower(s))
+	if len(parts) == 0 {
+		return DefaultOrderBy, nil
+	}
+	if len(parts) > 2 {
+		return OrderBy{}, ErrInvalidOrderBy
+	}
+
+	var o OrderBy
+	switch f := OrderField(parts[0]); f {
+	case OrderByID, OrderByCreatedAt, OrderByUpdatedAt, OrderByTitle, OrderByPosition:
+		o.Field = f
+	default:
+		return OrderBy{}, ErrInvalidOrderBy
+	}
+
+	if len(parts) == 2 {
+		switch parts[1] {
+		case "asc":
+		case "desc":
+			o.Desc = true
+		default:
+			return OrderBy{}, ErrInvalidOrderBy
+		}
+	}
+	return o, nil
+}
+
+// String は ParseOrderBy で読み戻せる形式を返す。
+func (o OrderBy) String() string {
+	if o.Desc {
+		return string(o.Field) + " desc"
+	}
+	return string(o.Field) + " asc"
+}
+
+// ListCursor は keyset ページングの位置（直前ページ最後の行の並び替えキー）。
+// 使うのは OrderBy.Field に対応する値と ID だけ。
+type ListCursor struct {
+	ID       int64
+	Time     time.Time // created_at / updated_at 順のとき
+	Title    string    // title 順のとき
+	Position int64     // position 順のとき
+}
+
+// CursorOf は t を「直前ページの最後の行」としたときのカーソルを返す。
+func (o OrderBy) CursorOf(t *Todo) ListCursor {
+	c := ListCursor{ID: t.ID}
+	switch o.Field {
+	case OrderByCreatedAt:
+		c.Time = t.CreatedAt
+	case OrderByUpdatedAt:
+		c.Time = t.UpdatedAt
+	case OrderByTitle:
+		c.Title = t.Title
+	case OrderByPosition:
+		c.Position = t.Position
+	}
+	return c
+}
@@ -0,0 +1,420 @@
+package todo
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Recurrence は Todo の繰り返しルール。ゼロ値は「繰り返さない」。
+// 表現できるのは RFC 5545 の RRULE のうち、次の部分集合だけ:
+//
+//	FREQ=DAILY|WEEKLY|MONTHLY（必須）
+//	INTERVAL=n（省略時 1）
+//	BYDAY=MO,WE,...（WEEKLY のみ。数字付きの "1MO" などは不可）
+//	BYMONTHDAY=n（MONTHLY のみ。1〜31 か、月末を表す -1 の 1 つだけ）
+//	UNTIL=YYYYMMDD または YYYYMMDDTHHMMSSZ
+//
+// 日時の計算は起点（期限）のタイムゾーンの壁時計で行うので、夏時間の切り替えを跨いでも時刻はずれない。
+// BYMONTHDAY がその月に無い日（2 月の 31 日など）の場合は、その月の末日にする（RFC と違い、月を飛ばさない）。
+type Recurrence struct {
+	Freq       Frequency
+	Interval   int            // 1 以上（ゼロ値は 1 として扱う）
+	ByWeekday  []time.Weekday // WEEKLY のみ。空なら起点の曜日
+	ByMonthDay int            // MONTHLY のみ。0 なら起点の日、-1 なら月末
+	Until      time.Time      // ゼロ値なら無期限
+}
+
+// Frequency は繰り返しの単位。
+type Frequency int32
+
+const (
+	FrequencyNone Frequency = iota
+	FrequencyDaily
+	FrequencyWeekly
+	FrequencyMonthly
+)
+
+// MaxRecurrenceInterval は INTERVAL の上限。
+const MaxRecurrenceInterval = 999
+
+// maxRecurrenceSteps は Next が諦めるまでに調べる周期の数（壊れたルールで無限ループしないため）。
+const maxRecurrenceSteps = 10000
+
+// 繰り返しルールが不正・未対応のときに使う共通エラー。
+var ErrInvalidRecurrence = errors.New("invalid recurrence rule")
+
+var frequencyNames = map[Frequency]string{
+	FrequencyDaily:   "DAILY",
+	FrequencyWeekly:  "WEEKLY",
+	FrequencyMonthly: "MONTHLY",
+}
+
+var weekdayNames = map[time.Weekday]string{
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+	time.Sunday:    "SU",
+}
+
+// IsZero は繰り返さない（ルールが無い）かどうか。
+func (r Recurrence) IsZero() bool {
+	return r.Freq == FrequencyNone
+}
+
+func (r Recurrence) interval() int {
+	if r.Interval <= 0 {
+		return 1
+	}
+	return r.Interval
+}
+
+// Validate は表現できる部分集合に収まっているかをチェックする。ゼロ値は正しい。
+func (r Recurrence) Validate() error {
+	if r.IsZero() {
+		if r.Interval != 0 || len(r.ByWeekday) > 0 || r.ByMonthDay != 0 || !r.Until.IsZero() {
+			return ErrInvalidRecurrence
+		}
+		return nil
+	}
+	if _, ok := frequencyNames[r.Freq]; !ok {
+		return ErrInvalidRecurrence
+	}
+	if r.Interval < 0 || r.Interval > MaxRecurrenceInterval {
+		return ErrInvalidRecurrence
+	}
+	if len(r.ByWeekday) > 0 && r.Freq != FrequencyWeekly {
+		return ErrInvalidRecurrence
+	}
+	for _, wd := range r.ByWeekday {
+		if wd < time.Sunday || wd > time.Saturday {
+			return ErrInvalidRecurrence
+		}
+	}
+	if r.ByMonthDay != 0 && (r.Freq != FrequencyMonthly || r.ByMonthDay < -1 || r.ByMonthDay > 31) {
+		return ErrInvalidRecurrence
+	}
+	return nil
+}
+
+// ParseRecurrence は RRULE 文字列（先頭の "RRULE:" は有っても無くてもよい）を読む。空文字はゼロ値。
+func ParseRecurrence(s string) (Recurrence, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	if s == "" {
+		return Recurrence{}, nil
+	}
+
+	var (
+		r    Recurrence
+		seen = map[string]bool{}
+	)
+	for _, part := range strings.Split(strings.ToUpper(s), ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" || seen[key] {
+			return Recurrence{}, ErrInvalidRecurrence
+		}
+		seen[key] = true
+
+		switch key {
+		case "FREQ":
+			r.Freq = FrequencyNone
+			for f, name := range frequencyNames {
+				if name == value {
+					r.Freq = f
+				}
+			}
+			if r.Freq == FrequencyNone {
+				return Recurrence{}, ErrInvalidRecurrence
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return Recurrence{}, ErrInvalidRecurrence
+			}
+			r.Interval = n
+		case "BYDAY":
+			for _, name := range strings.Split(value, ",") {
+				wd, ok := parseWeekday(name)
+				if !ok {
+					return Recurrence{}, ErrInvalidRecurrence
+				}
+				r.ByWeekday = append(r.ByWeekday, wd)
+			}
+		case "BYMONTHDAY":
+			n, err := strconv.Atoi(value)
+			if err != nil || n == 0 {
+				return Recurrence{}, ErrInvalidRecurrence
+			}
+			r.ByMonthDay = n
+		case "UNTIL":
+			until, err := parseRRuleTime(value)
+			if err != nil {
+				return Recurrence{}, ErrInvalidRecurrence
+			}
+			r.Until = until
+		default:
+			// COUNT / BYSETPOS などは未対応
+			return Recurrence{}, ErrInvalidRecurrence
+		}
+	}
+	if r.IsZero() {
+		return Recurrence{}, ErrInvalidRecurrence
+	}
+	if err := r.Validate(); err != nil {
+		return Recurrence{}, err
+	}
+	r.ByWeekday = normalizeWeekdays(r.ByWeekday)
+	return r, nil
+}
+
+// String は ParseRecurrence で読み戻せる RRULE 文字列（"RRULE:" は付けない）を返す。ゼロ値は空文字。
+func (r Recurrence) String() string {
+	if r.IsZero() {
+		return ""
+	}
+
+	parts := []string{"FREQ=" + frequencyNames[r.Freq]}
+	if r.interval() != 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.interval()))
+	}
+	if len(r.ByWeekday) > 0 {
+		names := make([]string, 0, len(r.ByWeekday))
+		for _, wd := range normalizeWeekdays(r.ByWeekday) {
+			names = append(names, weekdayNames[wd])
+		}
+		parts = append(parts, "BYDAY="+strings.Join(names, ","))
+	}
+	if r.ByMonthDay != 0 {
+		parts = append(parts, "BYMONTHDAY="+strconv.Itoa(r.ByMonthDay))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Next は anchor を 1 回目とする繰り返しのうち、after より後の最初の日時を返す。
+// UNTIL を過ぎる・ルールが無い場合は ok=false。
+func (r Recurrence) Next(anchor, after time.Time) (next time.Time, ok bool) {
+	if r.IsZero() || r.Validate() != nil {
+		return time.Time{}, false
+	}
+	after = after.In(anchor.Location())
+
+	// 周期ごとに候補を出し、after より後のものを探す。after が遠い場合は手前まで飛ばす
+	n := r.interval()
+	step := 0
+	if skip := r.periodsBetween(anchor, after) / n; skip > 1 {
+		step = (skip - 1) * n
+	}
+	for i := 0; i < maxRecurrenceSteps; i, step = i+1, step+n {
+		for _, c := range r.candidates(anchor, step) {
+			if c.Before(anchor) || !c.After(after) {
+				continue
+			}
+			if !r.Until.IsZero() && c.After(r.Until) {
+				return time.Time{}, false
+			}
+			return c, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// Pin は起点から決まる既定値（曜日・日）をルールに書き込んだコピーを返す。
+// 次の回の起点は前の回の日時になるので、月末に丸めた日（2/28 など）を起点にして日がずれていかないようにする。
+func (r Recurrence) Pin(anchor time.Time) Recurrence {
+	switch r.Freq {
+	case FrequencyWeekly:
+		if len(r.ByWeekday) == 0 {
+			r.ByWeekday = []time.Weekday{anchor.Weekday()}
+		}
+	case FrequencyMonthly:
+		if r.ByMonthDay == 0 {
+			r.ByMonthDay = anchor.Day()
+		}
+	}
+	return r
+}
+
+// ChangeRecurrence は繰り返しルールを変更する。ゼロ値は「繰り返さない」。
+func (t *Todo) ChangeRecurrence(r Recurrence) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	r.ByWeekday = normalizeWeekdays(r.ByWeekday)
+	t.Recurrence = r
+	return nil
+}
+
+// IsRecurring は繰り返しルールが付いているかどうか。
+func (t *Todo) IsRecurring() bool {
+	return !t.Recurrence.IsZero()
+}
+
+// NextOccurrence は、完了にした繰り返しの Todo から次の回を作る（未保存。ID・Version は 0）。
+// 期限は「今の期限と now の遅いほう」より後の最初の回にする（溜まった回はまとめて飛ばす）。
+// 期限が無い場合は now を起点にする。
+// ルールは次の回に引き継ぐので、呼び出し側は完了にした Todo のルールを外す（StopRecurring）。
+// UNTIL を過ぎて次の回が無い場合は ok=false。
+func (t *Todo) NextOccurrence(now time.Time) (next *Todo, ok bool) {
+	if !t.IsRecurring() {
+		return nil, false
+	}
+
+	anchor := t.DueAt
+	if anchor.IsZero() {
+		anchor = now
+	}
+	after := anchor
+	if now.After(after) {
+		after = now
+	}
+
+	rule := t.Recurrence.Pin(anchor)
+	due, ok := rule.Next(anchor, after)
+	if !ok {
+		return nil, false
+	}
+
+	next = &Todo{
+		OwnerID:      t.OwnerID,
+		AssigneeID:   t.AssigneeID,
+		ListID:       t.ListID,
+		Title:        t.Title,
+		DueAt:        due,
+		Priority:     t.Priority,
+		Notes:        t.Notes,
+		Labels:       t.Labels,
+		AutoComplete: t.AutoComplete,
+		Recurrence:   rule,
+	}
+	// チェックリストは未完了に戻して引き継ぐ（ID は振り直し）
+	for _, it := range t.Items {
+		next.Items = append(next.Items, ChecklistItem{Title: it.Title, Position: it.Position})
+	}
+	return next, true
+}
+
+// StopRecurring は繰り返しルールを外す。次の回を作った後の Todo に使い、
+// 完了を戻してまた完了にしたときに次の回が二重にできないようにする。
+func (t *Todo) StopRecurring() {
+	t.Recurrence = Recurrence{}
+}
+
+// candidates は anchor から step 周期目の候補の日時を昇順で返す。
+func (r Recurrence) candidates(anchor time.Time, step int) []time.Time {
+	y, m, d := anchor.Date()
+	at := func(y int, m time.Month, d int) time.Time {
+		return wallClock(y, m, d, anchor)
+	}
+
+	switch r.Freq {
+	case FrequencyDaily:
+		return []time.Time{at(y, m, d+step)}
+
+	case FrequencyWeekly:
+		days := r.ByWeekday
+		if len(days) == 0 {
+			days = []time.Weekday{anchor.Weekday()}
+		}
+		// 週は月曜始まり
+		monday := d - (int(anchor.Weekday())+6)%7 + 7*step
+		out := make([]time.Time, 0, len(days))
+		for _, wd := range normalizeWeekdays(days) {
+			out = append(out, at(y, m, monday+(int(wd)+6)%7))
+		}
+		return out
+
+	case FrequencyMonthly:
+		first := time.Date(y, m+time.Month(step), 1, 0, 0, 0, 0, time.UTC)
+		last := daysIn(first.Year(), first.Month())
+		day := r.ByMonthDay
+		switch {
+		case day == 0:
+			day = min(d, last)
+		case day < 0 || day > last:
+			day = last
+		}
+		return []time.Time{at(first.Year(), first.Month(), day)}
+	}
+	return nil
+}
+
+// wallClock は y-m-d の clock と同じ時刻（clock のタイムゾーン）を返す。
+// 夏時間の開始でその時刻が存在しない場合は、切り替え前のオフセットで読む（RFC 5545 と同じく 02:30 は 03:30 になる）。
+// 切り替えで同じ時刻が 2 回ある場合は time.Date に任せる（先の方になる）。
+func wallClock(y int, m time.Month, d int, clock time.Time) time.Time {
+	loc := clock.Location()
+	t := time.Date(y, m, d, clock.Hour(), clock.Minute(), clock.Second(), clock.Nanosecond(), loc)
+	if t.Hour() == clock.Hour() && t.Minute() == clock.Minute() && t.Second() == clock.Second() {
+		return t
+	}
+
+	_, before := t.Add(-24 * time.Hour).Zone()
+	wall := time.Date(y, m, d, clock.Hour(), clock.Minute(), clock.Second(), clock.Nanosecond(), time.UTC)
+	return wall.Add(-time.Duration(before) * time.Second).In(loc)
+}
+
+// periodsBetween は anchor から after までのおおよその周期数（日・週・月の単位で、切り捨て）。
+func (r Recurrence) periodsBetween(anchor, after time.Time) int {
+	if !after.After(anchor) {
+		return 0
+	}
+	switch r.Freq {
+	case FrequencyDaily:
+		return civilDays(anchor, after)
+	case FrequencyWeekly:
+		return civilDays(anchor, after) / 7
+	case FrequencyMonthly:
+		return (after.Year()-anchor.Year())*12 + int(after.Month()-anchor.Month())
+	}
+	return 0
+}
+
+// civilDays は暦の上での日数差（夏時間で 1 日が 23/25 時間になっても 1 日と数える）。
+func civilDays(from, to time.Time) int {
+	a := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	b := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a).Hours() / 24)
+}
+
+func daysIn(y int, m time.Month) int {
+	return time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func parseWeekday(s string) (time.Weekday, bool) {
+	for wd, name := range weekdayNames {
+		if name == s {
+			return wd, true
+		}
+	}
+	return 0, false
+}
+
+// normalizeWeekdays は月曜始まりの順に並べ、重複を除いたコピーを返す。
+func normalizeWeekdays(days []time.Weekday) []time.Weekday {
+	out := slices.Clone(days)
+	slices.SortFunc(out, func(a, b time.Weekday) int {
+		return (int(a)+6)%7 - (int(b)+6)%7
+	})
+	return slices.Compact(out)
+}
+
+// parseRRuleTime は UNTIL の値を読む。日付だけの場合はその日の終わり（UTC）まで含める。
+func parseRRuleTime(s string) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("20060102", s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse until %q: %w", s, err)
+	}
+	return t.Add(24*time.Hour - time.Second), nil
+}
@@ -0,0 +1,365 @@
+package todo
+
+import (
+	"slices"
+	"testing"
+	"time"
+	_ "time/tzdata" // America/New_York を環境に依存せず読むため
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("load location %q: %v", name, err)
+	}
+	return loc
+}
+
+func TestParseRecurrence(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		in      string
+		want    Recurrence
+		wantStr string
+		wantErr bool
+	}{
+		{name: "empty", in: "", want: Recurrence{}, wantStr: ""},
+		{name: "daily", in: "FREQ=DAILY", want: Recurrence{Freq: FrequencyDaily}, wantStr: "FREQ=DAILY"},
+		{name: "prefix and lower case", in: "RRULE:freq=daily;interval=3", want: Recurrence{Freq: FrequencyDaily, Interval: 3}, wantStr: "FREQ=DAILY;INTERVAL=3"},
+		{name: "interval 1 is omitted", in: "FREQ=WEEKLY;INTERVAL=1", want: Recurrence{Freq: FrequencyWeekly, Interval: 1}, wantStr: "FREQ=WEEKLY"},
+		{
+			name:    "weekdays are sorted from monday",
+			in:      "FREQ=WEEKLY;BYDAY=SU,TH,MO,TH",
+			want:    Recurrence{Freq: FrequencyWeekly, ByWeekday: []time.Weekday{time.Monday, time.Thursday, time.Sunday}},
+			wantStr: "FREQ=WEEKLY;BYDAY=MO,TH,SU",
+		},
+		{name: "last day of month", in: "FREQ=MONTHLY;BYMONTHDAY=-1", want: Recurrence{Freq: FrequencyMonthly, ByMonthDay: -1}, wantStr: "FREQ=MONTHLY;BYMONTHDAY=-1"},
+		{
+			name:    "until date",
+			in:      "FREQ=DAILY;UNTIL=20261231",
+			want:    Recurrence{Freq: FrequencyDaily, Until: time.Date(2026, 12, 31, 23, 59, 59, 0, time.UTC)},
+			wantStr: "FREQ=DAILY;UNTIL=20261231T235959Z",
+		},
+		{
+			name:    "until date time",
+			in:      "FREQ=MONTHLY;INTERVAL=2;BYMONTHDAY=15;UNTIL=20270101T090000Z",
+			want:    Recurrence{Freq: FrequencyMonthly, Interval: 2, ByMonthDay: 15, Until: time.Date(2027, 1, 1, 9, 0, 0, 0, time.UTC)},
+			wantStr: "FREQ=MONTHLY;INTERVAL=2;BYMONTHDAY=15;UNTIL=20270101T090000Z",
+		},
+
+		{name: "no freq", in: "INTERVAL=2", wantErr: true},
+		{name: "unsupported freq", in: "FREQ=YEARLY", wantErr: true},
+		{name: "unsupported key", in: "FREQ=DAILY;COUNT=3", wantErr: true},
+		{name: "duplicate key", in: "FREQ=DAILY;FREQ=WEEKLY", wantErr: true},
+		{name: "missing value", in: "FREQ=DAILY;INTERVAL=", wantErr: true},
+		{name: "zero interval", in: "FREQ=DAILY;INTERVAL=0", wantErr: true},
+		{name: "interval too large", in: "FREQ=DAILY;INTERVAL=1000", wantErr: true},
+		{name: "byday on daily", in: "FREQ=DAILY;BYDAY=MO", wantErr: true},
+		{name: "byday with ordinal", in: "FREQ=WEEKLY;BYDAY=1MO", wantErr: true},
+		{name: "bymonthday on weekly", in: "FREQ=WEEKLY;BYMONTHDAY=1", wantErr: true},
+		{name: "bymonthday zero", in: "FREQ=MONTHLY;BYMONTHDAY=0", wantErr: true},
+		{name: "bymonthday out of range", in: "FREQ=MONTHLY;BYMONTHDAY=32", wantErr: true},
+		{name: "bymonthday list", in: "FREQ=MONTHLY;BYMONTHDAY=1,15", wantErr: true},
+		{name: "bad until", in: "FREQ=DAILY;UNTIL=2026-12-31", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseRecurrence(tt.in)
+			if tt.wantErr {
+				if err != ErrInvalidRecurrence {
+					t.Fatalf("expected ErrInvalidRecurrence, got %v (%+v)", err, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRecurrence returned error: %v", err)
+			}
+			if got.Freq != tt.want.Freq || got.Interval != tt.want.Interval || got.ByMonthDay != tt.want.ByMonthDay ||
+				!got.Until.Equal(tt.want.Until) || !slices.Equal(got.ByWeekday, tt.want.ByWeekday) {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+			if s := got.String(); s != tt.wantStr {
+				t.Errorf("expected String()=%q, got %q", tt.wantStr, s)
+			}
+			// 正規化した文字列は読み戻しても同じになる
+			again, err := ParseRecurrence(got.String())
+			if err != nil || again.String() != got.String() {
+				t.Errorf("round trip of %q failed: %q, %v", got.String(), again.String(), err)
+			}
+		})
+	}
+}
+
+func TestRecurrence_Next(t *testing.T) {
+	t.Parallel()
+
+	tokyo := mustLoadLocation(t, "Asia/Tokyo")
+	ny := mustLoadLocation(t, "America/New_York")
+	at := func(loc *time.Location, y int, m time.Month, d, h, min int) time.Time {
+		return time.Date(y, m, d, h, min, 0, 0, loc)
+	}
+
+	tests := []struct {
+		name   string
+		rule   string
+		anchor time.Time
+		after  time.Time // ゼロ値なら anchor
+		want   time.Time // ゼロ値なら次の回なし
+	}{
+		// ---- DAILY ----
+		{name: "daily", rule: "FREQ=DAILY", anchor: at(tokyo, 2026, 3, 2, 9, 0), want: at(tokyo, 2026, 3, 3, 9, 0)},
+		{name: "daily interval", rule: "FREQ=DAILY;INTERVAL=3", anchor: at(tokyo, 2026, 3, 30, 9, 0), want: at(tokyo, 2026, 4, 2, 9, 0)},
+		{name: "daily before anchor returns anchor", rule: "FREQ=DAILY", anchor: at(tokyo, 2026, 3, 2, 9, 0), after: at(tokyo, 2026, 3, 1, 0, 0), want: at(tokyo, 2026, 3, 2, 9, 0)},
+		{name: "daily skips missed occurrences", rule: "FREQ=DAILY;INTERVAL=2", anchor: at(tokyo, 2026, 1, 1, 9, 0), after: at(tokyo, 2026, 6, 15, 12, 0), want: at(tokyo, 2026, 6, 16, 9, 0)},
+		{name: "daily same clock later that day", rule: "FREQ=DAILY", anchor: at(tokyo, 2026, 3, 2, 9, 0), after: at(tokyo, 2026, 3, 5, 8, 59), want: at(tokyo, 2026, 3, 5, 9, 0)},
+		{name: "daily across year end", rule: "FREQ=DAILY", anchor: at(tokyo, 2026, 12, 31, 23, 30), want: at(tokyo, 2027, 1, 1, 23, 30)},
+
+		// ---- 夏時間（America/New_York: 2026-03-08 開始、2026-11-01 終了）----
+		{name: "dst start keeps wall clock", rule: "FREQ=DAILY", anchor: at(ny, 2026, 3, 7, 9, 0), want: at(ny, 2026, 3, 8, 9, 0)},
+		{name: "dst end keeps wall clock", rule: "FREQ=DAILY", anchor: at(ny, 2026, 10, 31, 9, 0), want: at(ny, 2026, 11, 1, 9, 0)},
+		{name: "dst weekly", rule: "FREQ=WEEKLY", anchor: at(ny, 2026, 3, 2, 18, 0), want: at(ny, 2026, 3, 9, 18, 0)},
+		{name: "dst monthly", rule: "FREQ=MONTHLY", anchor: at(ny, 2026, 2, 15, 9, 0), want: at(ny, 2026, 3, 15, 9, 0)},
+		{
+			name:   "dst skipped hour moves forward",
+			rule:   "FREQ=DAILY",
+			anchor: at(ny, 2026, 3, 7, 2, 30),
+			// 03-08 の 02:30 は存在しないので 03:30 EDT になる
+			want: time.Date(2026, 3, 8, 7, 30, 0, 0, time.UTC),
+		},
+		{name: "dst skipped hour only that day", rule: "FREQ=DAILY", anchor: at(ny, 2026, 3, 7, 2, 30), after: at(ny, 2026, 3, 8, 4, 0), want: at(ny, 2026, 3, 9, 2, 30)},
+		{
+			name:   "dst repeated hour uses first",
+			rule:   "FREQ=DAILY",
+			anchor: at(ny, 2026, 10, 31, 1, 30),
+			want:   time.Date(2026, 11, 1, 5, 30, 0, 0, time.UTC), // 01:30 EDT
+		},
+
+		// ---- WEEKLY ----
+		{name: "weekly same weekday", rule: "FREQ=WEEKLY", anchor: at(tokyo, 2026, 3, 4, 9, 0), want: at(tokyo, 2026, 3, 11, 9, 0)},
+		{name: "weekly byday later this week", rule: "FREQ=WEEKLY;BYDAY=MO,TH", anchor: at(tokyo, 2026, 3, 4, 9, 0), want: at(tokyo, 2026, 3, 5, 9, 0)},
+		{name: "weekly byday next week", rule: "FREQ=WEEKLY;BYDAY=MO,TH", anchor: at(tokyo, 2026, 3, 4, 9, 0), after: at(tokyo, 2026, 3, 5, 9, 0), want: at(tokyo, 2026, 3, 9, 9, 0)},
+		{name: "weekly byday before anchor is not an occurrence", rule: "FREQ=WEEKLY;BYDAY=MO", anchor: at(tokyo, 2026, 3, 4, 9, 0), after: at(tokyo, 2026, 3, 1, 0, 0), want: at(tokyo, 2026, 3, 9, 9, 0)},
+		{name: "weekly sunday ends the week", rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=SU", anchor: at(tokyo, 2026, 3, 2, 9, 0), want: at(tokyo, 2026, 3, 8, 9, 0)},
+		{name: "weekly interval skips weeks", rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR", anchor: at(tokyo, 2026, 3, 2, 9, 0), after: at(tokyo, 2026, 3, 6, 9, 0), want: at(tokyo, 2026, 3, 16, 9, 0)},
+		{name: "weekly interval fast forward", rule: "FREQ=WEEKLY;INTERVAL=2", anchor: at(tokyo, 2026, 1, 5, 9, 0), after: at(tokyo, 2026, 3, 4, 0, 0), want: at(tokyo, 2026, 3, 16, 9, 0)},
+		{name: "weekly across month end", rule: "FREQ=WEEKLY;BYDAY=TU", anchor: at(tokyo, 2026, 3, 31, 9, 0), want: at(tokyo, 2026, 4, 7, 9, 0)},
+
+		// ---- MONTHLY ----
+		{name: "monthly", rule: "FREQ=MONTHLY", anchor: at(tokyo, 2026, 3, 10, 9, 0), want: at(tokyo, 2026, 4, 10, 9, 0)},
+		{name: "monthly 31st clamps to february", rule: "FREQ=MONTHLY", anchor: at(tokyo, 2026, 1, 31, 9, 0), want: at(tokyo, 2026, 2, 28, 9, 0)},
+		{name: "monthly 31st comes back after clamp", rule: "FREQ=MONTHLY", anchor: at(tokyo, 2026, 1, 31, 9, 0), after: at(tokyo, 2026, 2, 28, 9, 0), want: at(tokyo, 2026, 3, 31, 9, 0)},
+		{name: "monthly 31st clamps to 30th", rule: "FREQ=MONTHLY;BYMONTHDAY=31", anchor: at(tokyo, 2026, 3, 31, 9, 0), want: at(tokyo, 2026, 4, 30, 9, 0)},
+		{name: "monthly leap year", rule: "FREQ=MONTHLY;BYMONTHDAY=30", anchor: at(tokyo, 2028, 1, 30, 9, 0), want: at(tokyo, 2028, 2, 29, 9, 0)},
+		{name: "monthly last day", rule: "FREQ=MONTHLY;BYMONTHDAY=-1", anchor: at(tokyo, 2026, 4, 30, 9, 0), want: at(tokyo, 2026, 5, 31, 9, 0)},
+		{name: "monthly last day of february", rule: "FREQ=MONTHLY;BYMONTHDAY=-1", anchor: at(tokyo, 2026, 1, 31, 9, 0), want: at(tokyo, 2026, 2, 28, 9, 0)},
+		{name: "monthly bymonthday later this month", rule: "FREQ=MONTHLY;BYMONTHDAY=20", anchor: at(tokyo, 2026, 3, 10, 9, 0), want: at(tokyo, 2026, 3, 20, 9, 0)},
+		{name: "monthly bymonthday before anchor", rule: "FREQ=MONTHLY;BYMONTHDAY=5", anchor: at(tokyo, 2026, 3, 10, 9, 0), want: at(tokyo, 2026, 4, 5, 9, 0)},
+		{name: "monthly interval across year", rule: "FREQ=MONTHLY;INTERVAL=3", anchor: at(tokyo, 2026, 11, 15, 9, 0), want: at(tokyo, 2027, 2, 15, 9, 0)},
+		{name: "monthly fast forward", rule: "FREQ=MONTHLY;INTERVAL=2", anchor: at(tokyo, 2026, 1, 31, 9, 0), after: at(tokyo, 2027, 7, 1, 0, 0), want: at(tokyo, 2027, 7, 31, 9, 0)},
+
+		// ---- UNTIL ----
+		{name: "until includes last occurrence", rule: "FREQ=DAILY;UNTIL=20260303", anchor: at(time.UTC, 2026, 3, 2, 9, 0), want: at(time.UTC, 2026, 3, 3, 9, 0)},
+		{name: "until reached", rule: "FREQ=DAILY;UNTIL=20260303", anchor: at(time.UTC, 2026, 3, 2, 9, 0), after: at(time.UTC, 2026, 3, 3, 9, 0)},
+		{name: "until before anchor", rule: "FREQ=WEEKLY;UNTIL=20260101T000000Z", anchor: at(tokyo, 2026, 3, 2, 9, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := ParseRecurrence(tt.rule)
+			if err != nil {
+				t.Fatalf("ParseRecurrence(%q) returned error: %v", tt.rule, err)
+			}
+			after := tt.after
+			if after.IsZero() {
+				after = tt.anchor
+			}
+
+			got, ok := r.Next(tt.anchor, after)
+			if tt.want.IsZero() {
+				if ok {
+					t.Fatalf("expected no next occurrence, got %v", got)
+				}
+				return
+			}
+			if !ok {
+				t.Fatalf("expected %v, got no next occurrence", tt.want)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+			if got.Location() != tt.anchor.Location() {
+				t.Errorf("expected location %v, got %v", tt.anchor.Location(), got.Location())
+			}
+		})
+	}
+}
+
+func TestRecurrence_Next_Zero(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	if got, ok := (Recurrence{}).Next(now, now); ok {
+		t.Errorf("expected no next occurrence, got %v", got)
+	}
+}
+
+func TestTodo_ChangeRecurrence(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		r       Recurrence
+		wantErr error
+	}{
+		{name: "none", r: Recurrence{}},
+		{name: "daily", r: Recurrence{Freq: FrequencyDaily, Interval: 2}},
+		{name: "unknown freq", r: Recurrence{Freq: 9}, wantErr: ErrInvalidRecurrence},
+		{name: "negative interval", r: Recurrence{Freq: FrequencyDaily, Interval: -1}, wantErr: ErrInvalidRecurrence},
+		{name: "interval too large", r: Recurrence{Freq: FrequencyDaily, Interval: MaxRecurrenceInterval + 1}, wantErr: ErrInvalidRecurrence},
+		{name: "interval without freq", r: Recurrence{Interval: 2}, wantErr: ErrInvalidRecurrence},
+		{name: "weekday on monthly", r: Recurrence{Freq: FrequencyMonthly, ByWeekday: []time.Weekday{time.Monday}}, wantErr: ErrInvalidRecurrence},
+		{name: "invalid weekday", r: Recurrence{Freq: FrequencyWeekly, ByWeekday: []time.Weekday{7}}, wantErr: ErrInvalidRecurrence},
+		{name: "month day too small", r: Recurrence{Freq: FrequencyMonthly, ByMonthDay: -2}, wantErr: ErrInvalidRecurrence},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			td := &Todo{}
+			if err := td.ChangeRecurrence(tt.r); err != tt.wantErr {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && td.Recurrence.String() != tt.r.String() {
+				t.Errorf("expected %q, got %q", tt.r.String(), td.Recurrence.String())
+			}
+		})
+	}
+}
+
+func TestTodo_NextOccurrence(t *testing.T) {
+	t.Parallel()
+
+	tokyo := mustLoadLocation(t, "Asia/Tokyo")
+	due := time.Date(2026, 3, 2, 9, 0, 0, 0, tokyo) // 月曜
+
+	src := &Todo{
+		ID:           10,
+		OwnerID:      "user-1",
+		ListID:       3,
+		Title:        "ゴミ出し",
+		Done:         true,
+		DueAt:        due,
+		Priority:     PriorityHigh,
+		Notes:        "燃えるゴミ",
+		Labels:       []Label{{ID: 1, Name: "家事"}},
+		AutoComplete: true,
+		Position:     5 * PositionGap,
+		Version:      4,
+		Items: []ChecklistItem{
+			{ID: 7, Title: "袋をまとめる", Done: true, Position: 0},
+			{ID: 8, Title: "出す", Done: true, Position: 1},
+		},
+		Recurrence: Recurrence{Freq: FrequencyWeekly},
+	}
+
+	t.Run("copies the todo with the next due date", func(t *testing.T) {
+		t.Parallel()
+
+		next, ok := src.NextOccurrence(due.Add(-time.Hour))
+		if !ok {
+			t.Fatal("expected next occurrence")
+		}
+		if want := due.AddDate(0, 0, 7); !next.DueAt.Equal(want) {
+			t.Errorf("expected due_at=%v, got %v", want, next.DueAt)
+		}
+		if next.ID != 0 || next.Version != 0 || next.Done || next.Position != 0 {
+			t.Errorf("expected a new undone todo, got %+v", next)
+		}
+		if next.OwnerID != src.OwnerID || next.ListID != src.ListID || next.Title != src.Title ||
+			next.Priority != src.Priority || next.Notes != src.Notes || !next.AutoComplete || len(next.Labels) != 1 {
+			t.Errorf("expected fields to be copied, got %+v", next)
+		}
+		if len(next.Items) != 2 || next.Items[0].ID != 0 || next.Items[0].Done || next.Items[1].Title != "出す" {
+			t.Errorf("expected checklist to be reset, got %+v", next.Items)
+		}
+		// 曜日を固定したルールを引き継ぐ
+		if got := next.Recurrence.String(); got != "FREQ=WEEKLY;BYDAY=MO" {
+			t.Errorf("expected pinned rule, got %q", got)
+		}
+		// 元の Todo は変わらない
+		if !src.Items[0].Done || src.Recurrence.String() != "FREQ=WEEKLY" {
+			t.Errorf("expected source todo to be untouched, got %+v", src)
+		}
+	})
+
+	t.Run("late completion skips missed occurrences", func(t *testing.T) {
+		t.Parallel()
+
+		next, ok := src.NextOccurrence(time.Date(2026, 3, 20, 12, 0, 0, 0, tokyo))
+		if !ok {
+			t.Fatal("expected next occurrence")
+		}
+		if want := time.Date(2026, 3, 23, 9, 0, 0, 0, tokyo); !next.DueAt.Equal(want) {
+			t.Errorf("expected due_at=%v, got %v", want, next.DueAt)
+		}
+	})
+
+	t.Run("without due date starts from now", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Date(2026, 3, 2, 15, 4, 5, 0, tokyo)
+		td := &Todo{OwnerID: "user-1", Title: "水やり", Done: true, Recurrence: Recurrence{Freq: FrequencyDaily, Interval: 2}}
+		next, ok := td.NextOccurrence(now)
+		if !ok {
+			t.Fatal("expected next occurrence")
+		}
+		if want := now.AddDate(0, 0, 2); !next.DueAt.Equal(want) {
+			t.Errorf("expected due_at=%v, got %v", want, next.DueAt)
+		}
+	})
+
+	t.Run("month end does not drift", func(t *testing.T) {
+		t.Parallel()
+
+		td := &Todo{OwnerID: "user-1", Title: "家賃", DueAt: time.Date(2026, 1, 31, 9, 0, 0, 0, tokyo), Recurrence: Recurrence{Freq: FrequencyMonthly}}
+		var got []string
+		for range 4 {
+			next, ok := td.NextOccurrence(td.DueAt)
+			if !ok {
+				t.Fatal("expected next occurrence")
+			}
+			got = append(got, next.DueAt.Format("2006-01-02"))
+			td = next
+		}
+		if want := []string{"2026-02-28", "2026-03-31", "2026-04-30", "2026-05-31"}; !slices.Equal(got, want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("until reached", func(t *testing.T) {
+		t.Parallel()
+
+		td := &Todo{OwnerID: "user-1", Title: "t", DueAt: due, Recurrence: Recurrence{Freq: FrequencyDaily, Until: due}}
+		if next, ok := td.NextOccurrence(due); ok {
+			t.Errorf("expected no next occurrence, got %+v", next)
+		}
+	})
+
+	t.Run("not recurring", func(t *testing.T) {
+		t.Parallel()
+
+		td := &Todo{OwnerID: "user-1", Title: "t", DueAt: due}
+		if next, ok := td.NextOccurrence(due); ok {
+			t.Errorf("expected no next occurrence, got %+v", next)
+		}
+	})
+}
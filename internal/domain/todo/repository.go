@@ -1,23 +1,157 @@
 package todo
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // 読み取り専用のリポジトリインターフェース。
 // 「一覧表示」「詳細取得」など、状態を変更しない操作だけをまとめる。
+// すべて ownerID でスコープされ、他人の Todo は返さない（ListFilter.AssignedToMe の一覧だけは、ownerID が担当で見られるもの）。
+// ゴミ箱に入っている Todo は、ListQuery.Filter.Deleted を指定したときだけ返す。
 type ReadRepository interface {
-	List(ctx context.Context) ([]*Todo, error)
-	// Get(ctx context.Context, id int64) (*Todo, error)
+	List(ctx context.Context, ownerID string, q ListQuery) ([]*Todo, error)
+	// Get は 1 件取得。存在しない（他人の Todo を含む）場合は ErrNotFound を返す。
+	Get(ctx context.Context, ownerID string, id int64) (*Todo, error)
 }
 
 // 書き込み専用のリポジトリインターフェース。
 // 「作成」「更新」「削除」など、DB の状態を変える操作をまとめる。
+// Update は t.OwnerID、Delete は ownerID でスコープされる。
+// 対象が存在しない（他人の Todo・ゴミ箱の中を含む）場合、Update は ErrNotFound、Delete は false を返す。
+// Delete は論理削除（ゴミ箱へ移動）で、Restore で戻せる。Purge はゴミ箱の中の Todo を物理削除する。
+// Update は t.Version が保存済みの値と一致するときだけ成功し（不一致は ErrVersionMismatch）、
+// 成功すると version を 1 増やす。
 type WriteRepository interface {
+	// GetForUpdate は Get と同じだが、Tx 内で行ロックを取る（read-modify-write 用）。
+	GetForUpdate(ctx context.Context, ownerID string, id int64) (*Todo, error)
 	Create(ctx context.Context, t *Todo) (*Todo, error)
+	// CreateMany は同じ所有者の todos をまとめて作成し、入力と同じ順に ID などを埋めて返す。
+	// 途中で失敗したときに一部だけ作成されないよう、Tx の中で呼ぶこと。
+	CreateMany(ctx context.Context, todos []*Todo) ([]*Todo, error)
 	Update(ctx context.Context, t *Todo) (*Todo, error)
-	Delete(ctx context.Context, id int64) (bool, error)
+	Delete(ctx context.Context, ownerID string, id int64) (bool, error)
+	Restore(ctx context.Context, ownerID string, id int64) (bool, error)
+	Purge(ctx context.Context, ownerID string, id int64) (bool, error)
+
+	// SaveItems は t のチェックリストを t.Items の内容で置き換える（ID が 0 の項目は新規追加）。
+	// t.Items に無い項目は削除する。Todo 自体の存在・所有者の確認は呼び出し側（GetForUpdate）で済ませておくこと。
+	SaveItems(ctx context.Context, t *Todo) error
+
+	// ---- 手動の並び順（position.go） ----
+	// LastPosition は ownerID の Todo（ゴミ箱の中を含む）の最大の position を返す（無ければ 0）。
+	LastPosition(ctx context.Context, ownerID string) (int64, error)
+	// NeighborPosition は anchor の直前（before=true）または直後の Todo の position を、行ロックを取って返す。
+	// excludeID の Todo（動かしている本人）とゴミ箱の中は数えない。隣が無ければ found=false。
+	NeighborPosition(ctx context.Context, anchor *Todo, excludeID int64, before bool) (pos int64, found bool, err error)
+	// RebalancePositions は ownerID の Todo 全体を、今の順番のまま PositionGap 間隔に振り直す。
+	// version・updated_at は変えない。
+	RebalancePositions(ctx context.Context, ownerID string) error
+
+	// PurgeDeletedBefore は owner を跨いで、before より前にゴミ箱へ入った Todo を最大 limit 件物理削除する。
+	// バックグラウンドの purger 用。戻り値は削除した Todo（共有の後始末・履歴に使う ID と OwnerID だけを埋める）。
+	// WithinTx の中で使うこと。
+	PurgeDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*Todo, error)
+}
+
+// ラベルのリポジトリインターフェース。ownerID でスコープされ、他人のラベルは返さない。
+// 存在しない（他人のラベルを含む）場合、GetLabel / UpdateLabel は ErrLabelNotFound、DeleteLabel は false を返す。
+// 同じ所有者に同名のラベルがある場合、CreateLabel / UpdateLabel は ErrLabelAlreadyExists を返す。
+// ラベルを削除すると、Todo への付与もまとめて外れる。
+type LabelRepository interface {
+	ListLabels(ctx context.Context, ownerID string) ([]*Label, error)
+	GetLabel(ctx context.Context, ownerID string, id int64) (*Label, error)
+	CreateLabel(ctx context.Context, l *Label) (*Label, error)
+	UpdateLabel(ctx context.Context, l *Label) (*Label, error)
+	DeleteLabel(ctx context.Context, ownerID string, id int64) (bool, error)
+
+	// SetTodoLabels は todoID に付いているラベルを labelIDs で置き換える（空なら全て外す）。
+	// ownerID のラベルでないものが含まれていれば ErrLabelNotFound を返し、何も変えない。
+	// Todo 自体の存在・所有者の確認は呼び出し側（GetForUpdate）で済ませておくこと。
+	SetTodoLabels(ctx context.Context, ownerID string, todoID int64, labelIDs []int64) error
+}
+
+// リスト（TodoList 集約）のリポジトリインターフェース。ownerID でスコープされ、他人のリストは返さない。
+// 存在しない（他人のリストを含む）場合、Get 系・UpdateTodoList は ErrListNotFound、DeleteTodoList は false を返す。
+// リストを削除すると、ゴミ箱に残っている Todo はどのリストにも属さない状態に戻る。
+type TodoListRepository interface {
+	// ListTodoLists は名前順で返す。includeArchived が false ならアーカイブ済みは含めない。
+	ListTodoLists(ctx context.Context, ownerID string, includeArchived bool) ([]*TodoList, error)
+	GetTodoList(ctx context.Context, ownerID string, id int64) (*TodoList, error)
+	// GetTodoListForUpdate は Tx 内で行ロックを取る。
+	// Todo の追加・移動とリストの削除・アーカイブを直列化するのに使う。
+	GetTodoListForUpdate(ctx context.Context, ownerID string, id int64) (*TodoList, error)
+	CreateTodoList(ctx context.Context, l *TodoList) (*TodoList, error)
+	UpdateTodoList(ctx context.Context, l *TodoList) (*TodoList, error)
+	DeleteTodoList(ctx context.Context, ownerID string, id int64) (bool, error)
+
+	// CountTodos はリストに属する Todo（ゴミ箱の中は除く）の件数を返す。
+	CountTodos(ctx context.Context, ownerID string, listID int64) (int64, error)
+}
+
+// 変更履歴のリポジトリインターフェース。追記と一覧だけで、更新・削除は無い。
+type HistoryRepository interface {
+	// AppendHistory は e を追記し、e.ID / e.CreatedAt を埋める。Todo の変更と同じ Tx で呼ぶこと。
+	AppendHistory(ctx context.Context, e *HistoryEntry) error
+	// ListHistory は ownerID の Todo todoID の履歴を新しい順に返す（他人の Todo の履歴は返さない）。
+	ListHistory(ctx context.Context, ownerID string, todoID int64, q HistoryQuery) ([]*HistoryEntry, error)
+}
+
+// フィードトークン（feed_token.go）のリポジトリインターフェース。所有者ごとに 1 つで、ハッシュだけを保存する。
+type FeedTokenRepository interface {
+	// SaveFeedToken は ownerID のトークンを hash のものに置き換える。
+	SaveFeedToken(ctx context.Context, ownerID string, hash []byte) error
+	// DeleteFeedToken は ownerID のトークンを削除する。無ければ false。
+	DeleteFeedToken(ctx context.Context, ownerID string) (bool, error)
+	// FeedTokenOwner は hash のトークンの所有者を返す。無ければ ErrFeedTokenNotFound。
+	FeedTokenOwner(ctx context.Context, hash []byte) (string, error)
+}
+
+// 共有（share.go）のリポジトリインターフェース。対象の所有者で絞らずに引けるのは、権限の確認に使う *Access だけ。
+type GrantRepository interface {
+	// TodoAccess は Todo todoID の所有者と、granteeID がその Todo と入っているリストへの共有で持つ最も強い役割を返す。
+	// Todo が無ければ（ゴミ箱の中は含めて探す）ErrNotFound。共有されていなければ RoleNone。
+	TodoAccess(ctx context.Context, granteeID string, todoID int64) (ownerID string, role Role, err error)
+	// ListAccess はリスト listID の所有者と、granteeID がそのリストへの共有で持つ役割を返す。
+	// リストが無ければ ErrListNotFound。共有されていなければ RoleNone。
+	ListAccess(ctx context.Context, granteeID string, listID int64) (ownerID string, role Role, err error)
+
+	// CreateGrant は g を追加し、g.CreatedAt / g.UpdatedAt を埋める。同じ相手に共有済みなら ErrGrantAlreadyExists。
+	CreateGrant(ctx context.Context, g *Grant) error
+	// UpdateGrantRole は r を granteeID に共有したときの役割を変える。共有していなければ ErrGrantNotFound。
+	UpdateGrantRole(ctx context.Context, r Resource, granteeID string, role Role) (*Grant, error)
+	// DeleteGrant は r の granteeID への共有をやめる。共有していなければ false。
+	DeleteGrant(ctx context.Context, r Resource, granteeID string) (bool, error)
+	// ListGrants は r の共有を作成順で返す。
+	ListGrants(ctx context.Context, r Resource) ([]*Grant, error)
+	// ListGrantsForGrantee は granteeID に共有されているもの（対象が削除済みのものは除く）を新しい順で返す。
+	ListGrantsForGrantee(ctx context.Context, granteeID string) ([]*Grant, error)
+	// DeleteGrantsFor は rs への共有をすべて消す。Todo・リストを物理削除するときに同じ Tx で呼ぶ。
+	DeleteGrantsFor(ctx context.Context, rs ...Resource) error
+}
+
+// コメント（comment.go）のリポジトリインターフェース。Todo todoID で絞るだけなので、
+// Todo が見えるかどうか（所有者・共有）の確認は呼び出し側で済ませておくこと。
+// 存在しない（別の Todo のものを含む）場合、GetComment / UpdateComment は ErrCommentNotFound、DeleteComment は false を返す。
+type CommentRepository interface {
+	// CreateComment は c を追加し、c.ID / c.CreatedAt / c.UpdatedAt を埋める。Todo が無ければ ErrNotFound。
+	CreateComment(ctx context.Context, c *Comment) error
+	GetComment(ctx context.Context, todoID, id int64) (*Comment, error)
+	// UpdateComment は c.Body を保存し、c.UpdatedAt を埋める。
+	UpdateComment(ctx context.Context, c *Comment) error
+	DeleteComment(ctx context.Context, todoID, id int64) (bool, error)
+	ListComments(ctx context.Context, todoID int64, q CommentQuery) ([]*Comment, error)
+}
+
+// ユーザーのディレクトリ（担当者の存在確認に使う）。
+type UserDirectory interface {
+	// UserExists は userID のユーザーがいるかどうかを返す。
+	UserExists(ctx context.Context, userID string) (bool, error)
 }
 
 type Repository interface {
 	ReadRepository
 	WriteRepository
+	LabelRepository
+	HistoryRepository
 }
@@ -0,0 +1,150 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// 全文検索（タイトルとメモ）。
+// クエリは空白で区切った語の並びで、すべての語を含む Todo だけを返す（大文字小文字は区別しない）。
+
+const (
+	MaxSearchQueryLength = 200 // クエリの最大文字数
+	MaxSearchTerms       = 8   // クエリに含められる語の最大数
+	SnippetLength        = 80  // Highlight の抜粋の最大文字数（前後の "…" を除く）
+	snippetLeadContext   = 20  // 抜粋で、最初に一致した位置より前に残す文字数
+)
+
+var ErrInvalidSearchQuery = errors.New("invalid search query")
+
+// SearchQuery は SearchIndex.Search の条件。
+type SearchQuery struct {
+	Terms  []string // ParseSearchTerms で正規化した語（すべてを含むものを返す）
+	Offset int      // 関連度順に並べたときの読み飛ばす件数
+	Limit  int      // 最大件数
+}
+
+// SearchHit は検索結果の 1 件。
+type SearchHit struct {
+	Todo  *Todo
+	Score float64 // 関連度（大きいほど上位）。実装ごとに尺度が違うので、同じ検索結果の中での比較にだけ使う
+}
+
+// SearchIndex は Todo の全文検索の索引。
+// 索引はコミットされた変更（Event）で更新する。作成・更新で追加し直し、削除（ゴミ箱へ移動）で外す。
+type SearchIndex interface {
+	// Apply はコミットされた変更を索引に反映する（todo_usecase がコミットの後に呼ぶ）。
+	Apply(ctx context.Context, events ...Event) error
+	// Search は ownerID の Todo（ゴミ箱の中は除く）から q.Terms をすべて含むものを、関連度の高い順に返す
+	// （同じ関連度なら新しい ID が先）。
+	Search(ctx context.Context, ownerID string, q SearchQuery) ([]SearchHit, error)
+}
+
+// FoldSearchText は検索用に文字を揃える（小文字にする）。1 文字ずつ変換するので、文字数は変わらない。
+func FoldSearchText(s string) string {
+	return strings.Map(unicode.ToLower, s)
+}
+
+// ParseSearchTerms はクエリを語に分けて正規化する（重複は除く）。
+// 空・長すぎる・語が多すぎるクエリは ErrInvalidSearchQuery。
+// 語の中の '"' は、索引の検索構文と混ざらないよう取り除く。
+func ParseSearchTerms(q string) ([]string, error) {
+	if len([]rune(q)) > MaxSearchQueryLength {
+		return nil, ErrInvalidSearchQuery
+	}
+	var terms []string
+	for _, f := range strings.FieldsFunc(FoldSearchText(q), unicode.IsSpace) {
+		f = strings.ReplaceAll(f, `"`, "")
+		if f == "" || slices.Contains(terms, f) {
+			continue
+		}
+		terms = append(terms, f)
+	}
+	if len(terms) == 0 || len(terms) > MaxSearchTerms {
+		return nil, ErrInvalidSearchQuery
+	}
+	return terms, nil
+}
+
+// TextRange は Highlight.Snippet の中の一致した範囲 [Start, End)（文字単位）。
+type TextRange struct {
+	Start int
+	End   int
+}
+
+// Highlight は 1 フィールド分の一致箇所。
+type Highlight struct {
+	Field   string // "title" / "notes"
+	Snippet string // 一致箇所の周りの抜粋（途中で切ったら前後に "…" を付ける）
+	Ranges  []TextRange
+}
+
+// Highlights は t のタイトルとメモから terms に一致した箇所を抜き出す（一致の無いフィールドは含めない）。
+func Highlights(t *Todo, terms []string) []Highlight {
+	var hs []Highlight
+	for _, f := range []struct{ name, text string }{{"title", t.Title}, {"notes", t.Notes}} {
+		if h, ok := highlight(f.name, f.text, terms); ok {
+			hs = append(hs, h)
+		}
+	}
+	return hs
+}
+
+func highlight(field, text string, terms []string) (Highlight, bool) {
+	runes := []rune(text)
+	folded := []rune(FoldSearchText(text))
+
+	// 一致した範囲を全部集めて、重なり・隣接をまとめる
+	var ranges []TextRange
+	for _, term := range terms {
+		tr := []rune(term)
+		for i := 0; i+len(tr) <= len(folded); i++ {
+			if slices.Equal(folded[i:i+len(tr)], tr) {
+				ranges = append(ranges, TextRange{Start: i, End: i + len(tr)})
+			}
+		}
+	}
+	if len(ranges) == 0 {
+		return Highlight{}, false
+	}
+	slices.SortFunc(ranges, func(a, b TextRange) int { return a.Start - b.Start })
+	merged := ranges[:1]
+	for _, r := range ranges[1:] {
+		last := &merged[len(merged)-1]
+		if r.Start <= last.End {
+			last.End = max(last.End, r.End)
+			continue
+		}
+		merged = append(merged, r)
+	}
+
+	// 最初の一致の少し前から SnippetLength 文字を切り出す
+	from := 0
+	if len(runes) > SnippetLength {
+		from = min(max(merged[0].Start-snippetLeadContext, 0), len(runes)-SnippetLength)
+	}
+	to := min(from+SnippetLength, len(runes))
+
+	var b strings.Builder
+	shift := -from
+	if from > 0 {
+		b.WriteString("…")
+		shift++
+	}
+	b.WriteString(string(runes[from:to]))
+	if to < len(runes) {
+		b.WriteString("…")
+	}
+
+	h := Highlight{Field: field, Snippet: b.String()}
+	for _, r := range merged {
+		if r.Start >= to {
+			break
+		}
+		h.Ranges = append(h.Ranges, TextRange{Start: r.Start + shift, End: min(r.End, to) + shift})
+	}
+	return h, true
+}
@@ -0,0 +1,77 @@
+package todo
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseSearchTerms(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		in      string
+		want    []string
+		wantErr bool
+	}{
+		{name: "single", in: "牛乳", want: []string{"牛乳"}},
+		{name: "lower case and dedupe", in: "  Milk 牛乳　milk ", want: []string{"milk", "牛乳"}},
+		{name: "quotes are removed", in: `"buy" " milk`, want: []string{"buy", "milk"}},
+		{name: "empty", in: " 　", wantErr: true},
+		{name: "too many terms", in: "a b c d e f g h i", wantErr: true},
+		{name: "too long", in: strings.Repeat("あ", MaxSearchQueryLength+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseSearchTerms(tt.in)
+			if tt.wantErr {
+				if err != ErrInvalidSearchQuery {
+					t.Errorf("expected ErrInvalidSearchQuery, got %v (%q)", err, got)
+				}
+				return
+			}
+			if err != nil || !slices.Equal(got, tt.want) {
+				t.Errorf("expected %q, got %q err=%v", tt.want, got, err)
+			}
+		})
+	}
+}
+
+func TestHighlights(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ranges are merged", func(t *testing.T) {
+		t.Parallel()
+
+		hs := Highlights(&Todo{Title: "Buy MILK and milkshake", Notes: "なし"}, []string{"milk", "lks"})
+		if len(hs) != 1 || hs[0].Field != "title" || hs[0].Snippet != "Buy MILK and milkshake" {
+			t.Fatalf("unexpected highlights: %#v", hs)
+		}
+		want := []TextRange{{4, 8}, {13, 18}}
+		if !slices.Equal(hs[0].Ranges, want) {
+			t.Errorf("expected %v, got %v", want, hs[0].Ranges)
+		}
+	})
+
+	t.Run("long notes are cut around the first match", func(t *testing.T) {
+		t.Parallel()
+
+		notes := strings.Repeat("あ", 100) + "牛乳" + strings.Repeat("い", 100)
+		hs := Highlights(&Todo{Title: "買い物", Notes: notes}, []string{"牛乳"})
+		if len(hs) != 1 || hs[0].Field != "notes" {
+			t.Fatalf("unexpected highlights: %#v", hs)
+		}
+		snippet := []rune(hs[0].Snippet)
+		if len(snippet) != SnippetLength+2 || snippet[0] != '…' || snippet[len(snippet)-1] != '…' {
+			t.Errorf("unexpected snippet: %q", hs[0].Snippet)
+		}
+		r := hs[0].Ranges[0]
+		if got := string(snippet[r.Start:r.End]); got != "牛乳" {
+			t.Errorf("expected range to cover 牛乳, got %q", got)
+		}
+	})
+}
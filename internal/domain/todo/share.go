@@ -0,0 +1,107 @@
+package todo
+
+import (
+	"errors"
+	"time"
+)
+
+// 共有（コラボレーター）。所有者は Todo 1 件、またはリスト（中の Todo 全部）を他のユーザーに役割付きで共有できる。
+// 共有された Todo の所有者（OwnerID）は変わらない。共有された人は役割の範囲で所有者の Todo を読み書きする。
+// Todo の役割は、その Todo への共有と、Todo が入っているリストへの共有のうち強い方になる。
+
+// Role は共有された人の役割。大きいほど強く、強い役割は弱い役割のできることを全部できる。
+type Role int32
+
+const (
+	RoleNone   Role = iota // 共有されていない（見えない）
+	RoleViewer             // 読むだけ
+	RoleEditor             // Todo の中身（タイトル・完了・ラベル・チェックリストなど）を変えられる。共有されたリストに Todo を作れる。コメントを書ける
+	RoleOwner              // 所有者と同じ。削除・移動・リストの変更と、共有の管理もできる
+)
+
+// Validate は共有に付けられる役割（RoleNone 以外の定義済みの値）かどうかをチェックする。
+func (r Role) Validate() error {
+	if r < RoleViewer || r > RoleOwner {
+		return ErrInvalidRole
+	}
+	return nil
+}
+
+// Allows は r の役割で need の役割が要る操作をできるかどうか。
+func (r Role) Allows(need Role) bool {
+	return r >= need
+}
+
+// ResourceType は共有する対象の種類。
+type ResourceType int32
+
+const (
+	ResourceTodo ResourceType = iota + 1
+	ResourceList
+)
+
+// Resource は共有する対象（Todo 1 件かリスト 1 つ）。
+type Resource struct {
+	Type ResourceType
+	ID   int64
+}
+
+// Validate は対象の種類と ID をチェックする。
+func (r Resource) Validate() error {
+	if r.Type != ResourceTodo && r.Type != ResourceList {
+		return ErrInvalidResource
+	}
+	return ValidateID(r.ID)
+}
+
+// Grant は 1 人への共有。対象と共有された人の組で 1 つ。
+type Grant struct {
+	Resource  Resource
+	OwnerID   string // 対象の所有者
+	GranteeID string // 共有された人（JWT の sub）
+	Role      Role
+	GrantedBy string // 共有した人（所有者、または RoleOwner で共有された人）
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewGrant は「新規作成用」のコンストラクタ。所有者自身には共有できない。
+func NewGrant(r Resource, ownerID, granteeID string, role Role, grantedBy string) (*Grant, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	if err := ValidateOwnerID(granteeID); err != nil || granteeID == ownerID {
+		return nil, ErrInvalidGrantee
+	}
+	if err := role.Validate(); err != nil {
+		return nil, err
+	}
+	return &Grant{
+		Resource:  r,
+		OwnerID:   ownerID,
+		GranteeID: granteeID,
+		Role:      role,
+		GrantedBy: grantedBy,
+	}, nil
+}
+
+var (
+	// 役割が未指定・未定義のときに使う共通エラー。
+	ErrInvalidRole = errors.New("invalid collaborator role")
+
+	// 共有する対象の種類が未指定・未定義のときに使う共通エラー。
+	ErrInvalidResource = errors.New("invalid share target")
+
+	// 共有する相手が空・所有者自身・共有する本人のときに使う共通エラー。
+	ErrInvalidGrantee = errors.New("invalid collaborator")
+
+	// 同じ相手に既に共有しているときに使う共通エラー（役割の変更は ChangeRole で）。
+	ErrGrantAlreadyExists = errors.New("already shared with the collaborator")
+
+	// 共有していない相手の役割を変える・共有をやめようとしたときに使う共通エラー。
+	ErrGrantNotFound = errors.New("collaborator not found")
+
+	// 見えている（共有されている）が、役割が足りない操作をしようとしたときに使う共通エラー。
+	// 見えないものは ErrNotFound / ErrListNotFound のままにして、存在を漏らさない。
+	ErrPermissionDenied = errors.New("permission denied")
+)
@@ -0,0 +1,109 @@
+package todo
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// TodoList は Todo をまとめるリスト（プロジェクト）集約のルートエンティティ。
+// Todo は最大 1 つのリストに属する（Todo.ListID が 0 ならどのリストにも属さない「インボックス」）。
+// アーカイブしたリストには Todo を追加・移動できない。中身のあるリストは削除できない。
+type TodoList struct {
+	ID         int64
+	OwnerID    string
+	Name       string
+	ArchivedAt time.Time // アーカイブした時刻（ゼロ値ならアーカイブされていない）
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// MaxTodoListNameLength はリスト名の最大文字数（rune 単位）。
+const MaxTodoListNameLength = 100
+
+var (
+	// リスト名が空のときに使う共通エラー。
+	ErrEmptyListName = errors.New("todo list name must not be empty")
+
+	// リスト名が MaxTodoListNameLength を超えるときに使う共通エラー。
+	ErrListNameTooLong = errors.New("todo list name is too long")
+
+	// 対象のリストが存在しない（または他人のリストで見えない）ときに使う共通エラー。
+	ErrListNotFound = errors.New("todo list not found")
+
+	// アーカイブ済みのリストに Todo を追加・移動しようとしたときに使う共通エラー。
+	ErrListArchived = errors.New("todo list is archived")
+
+	// Todo が残っているリストを削除しようとしたときに使う共通エラー。
+	ErrListNotEmpty = errors.New("todo list is not empty")
+)
+
+// NewTodoList は「新規作成用」のコンストラクタ。
+func NewTodoList(ownerID, name string) (*TodoList, error) {
+	if err := ValidateOwnerID(ownerID); err != nil {
+		return nil, err
+	}
+
+	l := &TodoList{OwnerID: ownerID}
+	if err := l.Rename(name); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Rename はリスト名を変更する。前後の空白は落とす。
+func (l *TodoList) Rename(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrEmptyListName
+	}
+	if utf8.RuneCountInString(name) > MaxTodoListNameLength {
+		return ErrListNameTooLong
+	}
+	l.Name = name
+	return nil
+}
+
+// IsArchived はアーカイブ済みかどうか。
+func (l *TodoList) IsArchived() bool {
+	return !l.ArchivedAt.IsZero()
+}
+
+// Archive はリストをアーカイブする（中の Todo はそのまま残る）。既にアーカイブ済みなら何もしない。
+func (l *TodoList) Archive(now time.Time) {
+	if !l.IsArchived() {
+		l.ArchivedAt = now
+	}
+}
+
+// Unarchive はアーカイブを解除する。
+func (l *TodoList) Unarchive() {
+	l.ArchivedAt = time.Time{}
+}
+
+// CheckDeletable は残っている Todo の件数から、削除してよいかを判定する。
+// 中身があるときは、アーカイブするか Todo を移動してから削除してもらう。
+func (l *TodoList) CheckDeletable(todoCount int64) error {
+	if todoCount > 0 {
+		return ErrListNotEmpty
+	}
+	return nil
+}
+
+// MoveTo は Todo を l に移動する。l が nil ならインボックス（どのリストにも属さない）に戻す。
+// 他人のリストには移動できず（存在しないものとして扱う）、アーカイブ済みのリストにも移動できない。
+func (t *Todo) MoveTo(l *TodoList) error {
+	if l == nil {
+		t.ListID = 0
+		return nil
+	}
+	if l.OwnerID != t.OwnerID {
+		return ErrListNotFound
+	}
+	if l.IsArchived() {
+		return ErrListArchived
+	}
+	t.ListID = l.ID
+	return nil
+}
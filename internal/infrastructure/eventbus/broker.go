@@ -0,0 +1,187 @@
+package eventbus
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"go.uber.org/zap"
+)
+
+// 既定のバッファ長
+const (
+	DefaultSubscriberBuffer = 256  // 購読者ごとに溜められるイベント数（溢れたら切る）
+	DefaultHistorySize      = 1024 // 再開（Subscribe の after）のために覚えておく直近のイベント数（全所有者で共有）
+)
+
+// Broker は domain_todo.EventBroker のプロセス内実装。
+// Publish は購読者を待たないので、読むのが遅い購読者は溢れた時点で切る（ErrSlowSubscriber）。
+type Broker struct {
+	mu          sync.Mutex
+	epoch       string
+	seq         uint64
+	history     []domain_todo.Event // 直近 historySize 件（Seq の昇順）
+	historySize int
+	bufferSize  int
+	subs        map[string]map[*subscription]struct{} // ownerID -> 購読者
+	logger      *zap.Logger
+}
+
+// Option は NewBroker の任意設定。
+type Option func(*Broker)
+
+// WithSubscriberBuffer は購読者ごとのバッファ長を設定する（0 以下なら既定値）。
+func WithSubscriberBuffer(n int) Option {
+	return func(b *Broker) {
+		if n > 0 {
+			b.bufferSize = n
+		}
+	}
+}
+
+// WithHistorySize は再開用に覚えておくイベント数を設定する（0 以下なら既定値）。
+func WithHistorySize(n int) Option {
+	return func(b *Broker) {
+		if n > 0 {
+			b.historySize = n
+		}
+	}
+}
+
+// NewBroker は Broker を構築する。Epoch はランダムに決めるので、再起動前の再開トークンは使えない。
+func NewBroker(logger *zap.Logger, opts ...Option) *Broker {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	epoch := make([]byte, 8)
+	_, _ = rand.Read(epoch)
+
+	b := &Broker{
+		epoch:       hex.EncodeToString(epoch),
+		historySize: DefaultHistorySize,
+		bufferSize:  DefaultSubscriberBuffer,
+		subs:        map[string]map[*subscription]struct{}{},
+		logger:      logger,
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+func (b *Broker) Publish(events ...domain_todo.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, ev := range events {
+		b.seq++
+		ev.Seq = b.seq
+
+		b.history = append(b.history, ev)
+		if len(b.history) > b.historySize {
+			// 先頭を詰めて容量を使い回す
+			n := copy(b.history, b.history[len(b.history)-b.historySize:])
+			b.history = b.history[:n]
+		}
+
+		for s := range b.subs[ev.OwnerID] {
+			select {
+			case s.ch <- ev:
+			default:
+				b.logger.Warn("dropping slow todo event subscriber",
+					zap.String("owner_id", ev.OwnerID),
+					zap.Uint64("seq", ev.Seq),
+				)
+				b.removeLocked(s, domain_todo.ErrSlowSubscriber)
+			}
+		}
+	}
+}
+
+func (b *Broker) Subscribe(ownerID string, after *domain_todo.EventCursor) (domain_todo.EventSubscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	start := domain_todo.EventCursor{Epoch: b.epoch, Seq: b.seq}
+	var backlog []domain_todo.Event
+	if after != nil {
+		if after.Epoch != b.epoch || after.Seq > b.seq || after.Seq < b.oldestRetainedLocked() {
+			return nil, domain_todo.ErrEventsExpired
+		}
+		start.Seq = after.Seq
+		for _, ev := range b.history {
+			if ev.Seq > after.Seq && ev.OwnerID == ownerID {
+				backlog = append(backlog, ev)
+			}
+		}
+	}
+
+	// 取りこぼした分は溢れないよう、バッファに上乗せして先に積んでおく
+	s := &subscription{
+		broker:  b,
+		ownerID: ownerID,
+		start:   start,
+		ch:      make(chan domain_todo.Event, b.bufferSize+len(backlog)),
+	}
+	for _, ev := range backlog {
+		s.ch <- ev
+	}
+
+	if b.subs[ownerID] == nil {
+		b.subs[ownerID] = map[*subscription]struct{}{}
+	}
+	b.subs[ownerID][s] = struct{}{}
+	return s, nil
+}
+
+// oldestRetainedLocked は再開できる最も古い位置（これより前の位置からは取りこぼしがある）。
+func (b *Broker) oldestRetainedLocked() uint64 {
+	if len(b.history) == 0 {
+		return b.seq
+	}
+	return b.history[0].Seq - 1
+}
+
+// removeLocked は購読者を外して Events を閉じる。b.mu を持って呼ぶ。
+func (b *Broker) removeLocked(s *subscription, err error) {
+	owned := b.subs[s.ownerID]
+	if _, ok := owned[s]; !ok {
+		return
+	}
+	delete(owned, s)
+	if len(owned) == 0 {
+		delete(b.subs, s.ownerID)
+	}
+	s.err = err
+	close(s.ch)
+}
+
+// subscription は domain_todo.EventSubscription の実装。
+type subscription struct {
+	broker  *Broker
+	ownerID string
+	start   domain_todo.EventCursor
+	ch      chan domain_todo.Event
+	err     error // b.mu で守る
+}
+
+func (s *subscription) Events() <-chan domain_todo.Event {
+	return s.ch
+}
+
+func (s *subscription) Err() error {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	return s.err
+}
+
+func (s *subscription) Start() domain_todo.EventCursor {
+	return s.start
+}
+
+func (s *subscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	s.broker.removeLocked(s, nil)
+}
@@ -0,0 +1,97 @@
+package eventbus
+
+import (
+	"errors"
+	"testing"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"go.uber.org/zap"
+)
+
+func event(ownerID string, id int64) domain_todo.Event {
+	return domain_todo.Event{Type: domain_todo.EventTypeUpdated, OwnerID: ownerID, Todo: &domain_todo.Todo{ID: id, OwnerID: ownerID}}
+}
+
+func TestBroker_DeliversToOwner(t *testing.T) {
+	t.Parallel()
+
+	b := NewBroker(zap.NewNop())
+	sub, err := b.Subscribe("user-1", nil)
+	if err != nil {
+		t.Fatalf("Subscribe returned error: %v", err)
+	}
+	defer sub.Close()
+
+	b.Publish(event("user-2", 1), event("user-1", 2))
+
+	ev := <-sub.Events()
+	if ev.Todo.ID != 2 || ev.Seq != 2 {
+		t.Errorf("expected todo 2 at seq 2, got todo %d at seq %d", ev.Todo.ID, ev.Seq)
+	}
+	select {
+	case ev := <-sub.Events():
+		t.Errorf("unexpected event: %#v", ev)
+	default:
+	}
+}
+
+func TestBroker_DropsSlowSubscriber(t *testing.T) {
+	t.Parallel()
+
+	b := NewBroker(zap.NewNop(), WithSubscriberBuffer(2))
+	slow, _ := b.Subscribe("user-1", nil)
+
+	b.Publish(event("user-1", 1), event("user-1", 2), event("user-1", 3))
+
+	// 溜まっていた分を読み切ったら閉じている
+	n := 0
+	for range slow.Events() {
+		n++
+	}
+	if n != 2 || !errors.Is(slow.Err(), domain_todo.ErrSlowSubscriber) {
+		t.Errorf("expected 2 events and ErrSlowSubscriber, got %d events, err=%v", n, slow.Err())
+	}
+	slow.Close() // 切られた後に Close しても問題ない
+
+	// 切られた購読者の分は配らない（他の購読者は影響を受けない）
+	sub, _ := b.Subscribe("user-1", nil)
+	defer sub.Close()
+	b.Publish(event("user-1", 4))
+	if ev := <-sub.Events(); ev.Todo.ID != 4 {
+		t.Errorf("expected todo 4, got %d", ev.Todo.ID)
+	}
+}
+
+func TestBroker_Resume(t *testing.T) {
+	t.Parallel()
+
+	b := NewBroker(zap.NewNop(), WithHistorySize(3))
+	first, _ := b.Subscribe("user-1", nil)
+	start := first.Start()
+	first.Close()
+
+	b.Publish(event("user-1", 1), event("user-2", 2), event("user-1", 3))
+
+	// 切断している間の自分の分だけが先に届く
+	sub, err := b.Subscribe("user-1", &start)
+	if err != nil {
+		t.Fatalf("Subscribe returned error: %v", err)
+	}
+	defer sub.Close()
+	for _, want := range []int64{1, 3} {
+		if ev := <-sub.Events(); ev.Todo.ID != want {
+			t.Errorf("expected todo %d, got %d", want, ev.Todo.ID)
+		}
+	}
+
+	// 覚えている分より前・別の Epoch からは再開できない
+	b.Publish(event("user-1", 4))
+	for _, after := range []domain_todo.EventCursor{start, {Epoch: "other", Seq: 4}} {
+		if _, err := b.Subscribe("user-1", &after); !errors.Is(err, domain_todo.ErrEventsExpired) {
+			t.Errorf("after %v: expected ErrEventsExpired, got %v", after, err)
+		}
+	}
+	if _, err := b.Subscribe("user-1", &domain_todo.EventCursor{Epoch: start.Epoch, Seq: 1}); err != nil {
+		t.Errorf("expected resume from seq 1, got %v", err)
+	}
+}
@@ -0,0 +1,193 @@
+package memsearch
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+)
+
+// Index は domain_todo.SearchIndex のメモリ上の実装（テスト・ローカル実行用）。
+// 2 文字ずつの n-gram の転置索引で候補を絞り、部分一致で確かめる。
+// 索引はプロセス内にしか無く、起動してから Apply された変更だけが載る（起動前からある Todo は検索できない）。
+// 返す Todo は変更が Apply された時点のもの（ラベル名の変更など、Todo の version を進めない変更は反映されない）。
+type Index struct {
+	mu       sync.RWMutex
+	docs     map[int64]*document           // todo ID -> 文書
+	owners   map[string]map[int64]struct{} // ownerID -> todo ID
+	postings map[string]map[int64]struct{} // n-gram -> todo ID（所有者を跨いで共有）
+}
+
+type document struct {
+	todo  *domain_todo.Todo
+	title string // FoldSearchText 済み
+	notes string
+	grams []string
+}
+
+func New() *Index {
+	return &Index{
+		docs:     map[int64]*document{},
+		owners:   map[string]map[int64]struct{}{},
+		postings: map[string]map[int64]struct{}{},
+	}
+}
+
+func (x *Index) Apply(ctx context.Context, events ...domain_todo.Event) error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	for _, ev := range events {
+		if ev.Todo == nil {
+			continue
+		}
+		x.removeLocked(ev.Todo.ID)
+		switch ev.Type {
+		case domain_todo.EventTypeCreated, domain_todo.EventTypeUpdated:
+			x.addLocked(ev.Todo)
+		}
+	}
+	return nil
+}
+
+func (x *Index) addLocked(t *domain_todo.Todo) {
+	d := &document{
+		todo:  t,
+		title: domain_todo.FoldSearchText(t.Title),
+		notes: domain_todo.FoldSearchText(t.Notes),
+	}
+	seen := map[string]bool{}
+	for _, g := range append(bigrams(d.title), bigrams(d.notes)...) {
+		if seen[g] {
+			continue
+		}
+		seen[g] = true
+		d.grams = append(d.grams, g)
+		if x.postings[g] == nil {
+			x.postings[g] = map[int64]struct{}{}
+		}
+		x.postings[g][t.ID] = struct{}{}
+	}
+
+	x.docs[t.ID] = d
+	if x.owners[t.OwnerID] == nil {
+		x.owners[t.OwnerID] = map[int64]struct{}{}
+	}
+	x.owners[t.OwnerID][t.ID] = struct{}{}
+}
+
+func (x *Index) removeLocked(id int64) {
+	d, ok := x.docs[id]
+	if !ok {
+		return
+	}
+	for _, g := range d.grams {
+		delete(x.postings[g], id)
+		if len(x.postings[g]) == 0 {
+			delete(x.postings, g)
+		}
+	}
+	delete(x.owners[d.todo.OwnerID], id)
+	if len(x.owners[d.todo.OwnerID]) == 0 {
+		delete(x.owners, d.todo.OwnerID)
+	}
+	delete(x.docs, id)
+}
+
+func (x *Index) Search(ctx context.Context, ownerID string, q domain_todo.SearchQuery) ([]domain_todo.SearchHit, error) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	owned := x.owners[ownerID]
+	scores := map[int64]float64{}
+	for i, term := range q.Terms {
+		// 語を含む文書ごとの出現回数（タイトルの一致は重く数える）
+		counts := map[int64]int{}
+		for id := range x.candidatesLocked(owned, term) {
+			d := x.docs[id]
+			if n := 2*strings.Count(d.title, term) + strings.Count(d.notes, term); n > 0 {
+				counts[id] = n
+			}
+		}
+
+		// すべての語を含むものだけ残す
+		for id := range scores {
+			if _, ok := counts[id]; !ok {
+				delete(scores, id)
+			}
+		}
+		// 多くの文書に出てくる語ほど軽くする（tf-idf）
+		idf := 1 + math.Log(float64(len(owned))/float64(len(counts)+1)+1)
+		for id, n := range counts {
+			if _, ok := scores[id]; ok || i == 0 {
+				scores[id] += float64(n) * idf
+			}
+		}
+	}
+
+	hits := make([]domain_todo.SearchHit, 0, len(scores))
+	for id, score := range scores {
+		hits = append(hits, domain_todo.SearchHit{Todo: x.docs[id].todo, Score: score})
+	}
+	slices.SortFunc(hits, func(a, b domain_todo.SearchHit) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.Todo.ID, a.Todo.ID)
+	})
+
+	if q.Offset >= len(hits) {
+		return nil, nil
+	}
+	hits = hits[q.Offset:]
+	if q.Limit > 0 && len(hits) > q.Limit {
+		hits = hits[:q.Limit]
+	}
+	return hits, nil
+}
+
+// candidatesLocked は term を含みうる ownerID の文書（n-gram がすべて載っているもの）。
+// 1 文字の語は n-gram で絞れないので、所有者の文書すべてを候補にする。
+func (x *Index) candidatesLocked(owned map[int64]struct{}, term string) map[int64]struct{} {
+	grams := bigrams(term)
+	if len(grams) == 0 {
+		return owned
+	}
+	// 一番短い posting から始めて絞る
+	slices.SortFunc(grams, func(a, b string) int { return cmp.Compare(len(x.postings[a]), len(x.postings[b])) })
+	out := map[int64]struct{}{}
+	for id := range x.postings[grams[0]] {
+		if _, ok := owned[id]; !ok {
+			continue
+		}
+		all := true
+		for _, g := range grams[1:] {
+			if _, ok := x.postings[g][id]; !ok {
+				all = false
+				break
+			}
+		}
+		if all {
+			out[id] = struct{}{}
+		}
+	}
+	return out
+}
+
+// bigrams は s の連続する 2 文字をすべて返す（空白を含むものは除く）。
+func bigrams(s string) []string {
+	runes := []rune(s)
+	var out []string
+	for i := 0; i+1 < len(runes); i++ {
+		if unicode.IsSpace(runes[i]) || unicode.IsSpace(runes[i+1]) {
+			continue
+		}
+		out = append(out, string(runes[i:i+2]))
+	}
+	return out
+}
@@ -0,0 +1,76 @@
+package memsearch
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+)
+
+func created(id int64, ownerID, title, notes string) domain_todo.Event {
+	return domain_todo.Event{
+		Type:    domain_todo.EventTypeCreated,
+		OwnerID: ownerID,
+		Todo:    &domain_todo.Todo{ID: id, OwnerID: ownerID, Title: title, Notes: notes},
+	}
+}
+
+func searchIDs(t *testing.T, x *Index, ownerID string, terms ...string) []int64 {
+	t.Helper()
+	hits, err := x.Search(context.Background(), ownerID, domain_todo.SearchQuery{Terms: terms, Limit: 10})
+	if err != nil {
+		t.Fatalf("Search returned error: %v", err)
+	}
+	var ids []int64
+	for _, h := range hits {
+		ids = append(ids, h.Todo.ID)
+	}
+	return ids
+}
+
+func TestIndex_Search(t *testing.T) {
+	t.Parallel()
+
+	x := New()
+	_ = x.Apply(context.Background(),
+		created(1, "user-1", "買い物", "牛乳とパン"),
+		created(2, "user-1", "牛乳を買う", ""),
+		created(3, "user-1", "掃除", "風呂"),
+		created(4, "user-2", "牛乳", ""),
+	)
+
+	// タイトルの一致が上位。他人の Todo は出てこない
+	if got := searchIDs(t, x, "user-1", "牛乳"); !slices.Equal(got, []int64{2, 1}) {
+		t.Errorf("expected [2 1], got %v", got)
+	}
+	// すべての語を含むものだけ
+	if got := searchIDs(t, x, "user-1", "牛乳", "パン"); !slices.Equal(got, []int64{1}) {
+		t.Errorf("expected [1], got %v", got)
+	}
+	// 1 文字の語
+	if got := searchIDs(t, x, "user-1", "呂"); !slices.Equal(got, []int64{3}) {
+		t.Errorf("expected [3], got %v", got)
+	}
+}
+
+func TestIndex_ApplyUpdatesAndDeletes(t *testing.T) {
+	t.Parallel()
+
+	x := New()
+	ctx := context.Background()
+	_ = x.Apply(ctx, created(1, "user-1", "牛乳", ""), created(2, "user-1", "牛乳", ""))
+
+	updated := created(1, "user-1", "パン", "")
+	updated.Type = domain_todo.EventTypeUpdated
+	deleted := created(2, "user-1", "牛乳", "")
+	deleted.Type = domain_todo.EventTypeDeleted
+	_ = x.Apply(ctx, updated, deleted)
+
+	if got := searchIDs(t, x, "user-1", "牛乳"); len(got) != 0 {
+		t.Errorf("expected no hits, got %v", got)
+	}
+	if got := searchIDs(t, x, "user-1", "パン"); !slices.Equal(got, []int64{1}) {
+		t.Errorf("expected [1], got %v", got)
+	}
+}
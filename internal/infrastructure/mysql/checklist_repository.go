@@ -0,0 +1,114 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"go.uber.org/zap"
+)
+
+// チェックリストは Todo 集約の一部なので、TodoRepository に実装する。
+
+// SaveItems は todo_checklist_items を t.Items に合わせる。
+//   - t.Items に無い項目は DELETE
+//   - ID のある項目は UPDATE（タイトル・完了・表示順）
+//   - ID が 0 の項目は INSERT
+func (r *TodoRepository) SaveItems(ctx context.Context, t *domain_todo.Todo) error {
+	exec := r.getExecutor(ctx)
+
+	var keep []any
+	for _, it := range t.Items {
+		if it.ID != 0 {
+			keep = append(keep, it.ID)
+		}
+	}
+
+	query := `DELETE FROM todo_checklist_items WHERE todo_id = ?`
+	if len(keep) > 0 {
+		query += ` AND id NOT IN (` + placeholders(len(keep)) + `)`
+	}
+	if _, err := exec.ExecContext(ctx, query, append([]any{t.ID}, keep...)...); err != nil {
+		r.logger.Error("failed to delete checklist items",
+			zap.Int64("todo_id", t.ID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("delete checklist items: %w", err)
+	}
+
+	for _, it := range t.Items {
+		var err error
+		if it.ID == 0 {
+			_, err = exec.ExecContext(ctx,
+				`INSERT INTO todo_checklist_items (todo_id, title, done, position) VALUES (?, ?, ?, ?)`,
+				t.ID,
+				it.Title,
+				it.Done,
+				it.Position,
+			)
+		} else {
+			_, err = exec.ExecContext(ctx,
+				`UPDATE todo_checklist_items SET title = ?, done = ?, position = ? WHERE id = ? AND todo_id = ?`,
+				it.Title,
+				it.Done,
+				it.Position,
+				it.ID,
+				t.ID,
+			)
+		}
+		if err != nil {
+			r.logger.Error("failed to save checklist item",
+				zap.Int64("todo_id", t.ID),
+				zap.Int64("item_id", it.ID),
+				zap.Error(err),
+			)
+			return fmt.Errorf("save checklist item: %w", err)
+		}
+	}
+
+	r.logger.Info("checklist items saved",
+		zap.Int64("todo_id", t.ID),
+		zap.Int("count", len(t.Items)),
+	)
+	return nil
+}
+
+// loadItems は todos それぞれのチェックリストを 1 回の SELECT でまとめて詰める（loadLabels と同じく N+1 を避ける）
+func (r *TodoRepository) loadItems(ctx context.Context, exec executor, todos ...*domain_todo.Todo) error {
+	if len(todos) == 0 {
+		return nil
+	}
+
+	byID := make(map[int64]*domain_todo.Todo, len(todos))
+	args := make([]any, 0, len(todos))
+	for _, t := range todos {
+		byID[t.ID] = t
+		args = append(args, t.ID)
+	}
+
+	rows, err := exec.QueryContext(ctx,
+		`SELECT todo_id, id, title, done, position, created_at, updated_at
+		   FROM todo_checklist_items
+		  WHERE todo_id IN (`+placeholders(len(todos))+`)
+		  ORDER BY todo_id, position, id`,
+		args...,
+	)
+	if err != nil {
+		return fmt.Errorf("query checklist items: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			todoID int64
+			it     domain_todo.ChecklistItem
+		)
+		if err := rows.Scan(&todoID, &it.ID, &it.Title, &it.Done, &it.Position, &it.CreatedAt, &it.UpdatedAt); err != nil {
+			return err
+		}
+		if t, ok := byID[todoID]; ok {
+			t.Items = append(t.Items, it)
+		}
+	}
+	return rows.Err()
+}
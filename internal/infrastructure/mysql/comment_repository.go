@@ -0,0 +1,238 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	mysqldriver "github.com/go-sql-driver/mysql"
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"go.uber.org/zap"
+)
+
+// CommentRepository はコメントの MySQL 実装。
+// todo_comments.todo_id は todos への外部キー（ON DELETE CASCADE）なので、Todo を物理削除するとコメントも消える
+// （ゴミ箱へ入れる論理削除では残る）。
+type CommentRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewCommentRepository(db *sql.DB, logger *zap.Logger) *CommentRepository {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &CommentRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *CommentRepository) getExecutor(ctx context.Context) executor {
+	return getExecutor(ctx, r.db)
+}
+
+// commentColumns は todo_comments の SELECT で使う列。scanComment と順番を揃えること。
+const commentColumns = `id, todo_id, author_id, body, created_at, updated_at, edited`
+
+// mysqlErrNoReferencedRow は外部キーの参照先が無い（ER_NO_REFERENCED_ROW_2）
+const mysqlErrNoReferencedRow = 1452
+
+func scanComment(s rowScanner) (*domain_todo.Comment, error) {
+	var c domain_todo.Comment
+	if err := s.Scan(&c.ID, &c.TodoID, &c.AuthorID, &c.Body, &c.CreatedAt, &c.UpdatedAt, &c.Edited); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// isNoReferencedRow は外部キー制約違反（参照先が無い）かどうか
+func isNoReferencedRow(err error) bool {
+	var me *mysqldriver.MySQLError
+	return errors.As(err, &me) && me.Number == mysqlErrNoReferencedRow
+}
+
+func (r *CommentRepository) CreateComment(ctx context.Context, c *domain_todo.Comment) error {
+	exec := r.getExecutor(ctx)
+
+	// DB の DATETIME は秒精度なので、読み戻さずに済むよう揃えておく
+	now := time.Now().Truncate(time.Second)
+	res, err := exec.ExecContext(ctx,
+		`INSERT INTO todo_comments (todo_id, author_id, body, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`,
+		c.TodoID,
+		c.AuthorID,
+		c.Body,
+		now,
+		now,
+	)
+	if isNoReferencedRow(err) {
+		// 確認した後に Todo が物理削除された
+		return domain_todo.ErrNotFound
+	}
+	if err != nil {
+		r.logger.Error("failed to insert comment",
+			zap.Int64("todo_id", c.TodoID),
+			zap.String("author_id", c.AuthorID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("insert comment: %w", err)
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("get last insert id: %w", err)
+	}
+	c.ID = id
+	c.CreatedAt, c.UpdatedAt = now, now
+	return nil
+}
+
+func (r *CommentRepository) GetComment(ctx context.Context, todoID, id int64) (*domain_todo.Comment, error) {
+	exec := r.getExecutor(ctx)
+
+	var c *domain_todo.Comment
+	err := doWithRetry(ctx, DefaultReadRetry, r.logger, func() error {
+		var err error
+		c, err = scanComment(exec.QueryRowContext(ctx,
+			`SELECT `+commentColumns+` FROM todo_comments WHERE id = ? AND todo_id = ?`,
+			id,
+			todoID,
+		))
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain_todo.ErrCommentNotFound
+		}
+		return err
+	})
+	if errors.Is(err, domain_todo.ErrCommentNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query comment: %w", err)
+	}
+	return c, nil
+}
+
+func (r *CommentRepository) UpdateComment(ctx context.Context, c *domain_todo.Comment) error {
+	exec := r.getExecutor(ctx)
+
+	now := time.Now().Truncate(time.Second)
+	res, err := exec.ExecContext(ctx,
+		`UPDATE todo_comments SET body = ?, updated_at = ?, edited = ? WHERE id = ? AND todo_id = ?`,
+		c.Body,
+		now,
+		c.Edited,
+		c.ID,
+		c.TodoID,
+	)
+	if err != nil {
+		r.logger.Error("failed to update comment",
+			zap.Int64("todo_id", c.TodoID),
+			zap.Int64("id", c.ID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("update comment: %w", err)
+	}
+
+	// 同じ秒に同じ本文で変えると変更行数は 0 になるので、そのときだけ存在を確かめる
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected (update comment): %w", err)
+	}
+	if n == 0 {
+		var exists bool
+		if err := exec.QueryRowContext(ctx,
+			`SELECT EXISTS (SELECT 1 FROM todo_comments WHERE id = ? AND todo_id = ?)`,
+			c.ID,
+			c.TodoID,
+		).Scan(&exists); err != nil {
+			return fmt.Errorf("query comment: %w", err)
+		}
+		if !exists {
+			return domain_todo.ErrCommentNotFound
+		}
+	}
+	c.UpdatedAt = now
+	return nil
+}
+
+func (r *CommentRepository) DeleteComment(ctx context.Context, todoID, id int64) (bool, error) {
+	exec := r.getExecutor(ctx)
+
+	res, err := exec.ExecContext(ctx,
+		`DELETE FROM todo_comments WHERE id = ? AND todo_id = ?`,
+		id,
+		todoID,
+	)
+	if err != nil {
+		r.logger.Error("failed to delete comment",
+			zap.Int64("todo_id", todoID),
+			zap.Int64("id", id),
+			zap.Error(err),
+		)
+		return false, fmt.Errorf("delete comment: %w", err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("rows affected (delete comment): %w", err)
+	}
+	return n > 0, nil
+}
+
+func (r *CommentRepository) ListComments(ctx context.Context, todoID int64, q domain_todo.CommentQuery) ([]*domain_todo.Comment, error) {
+	exec := r.getExecutor(ctx)
+
+	query := `SELECT ` + commentColumns + ` FROM todo_comments WHERE todo_id = ?`
+	args := []any{todoID}
+	if q.AfterID != 0 {
+		query += ` AND id > ?`
+		args = append(args, q.AfterID)
+	}
+	query += ` ORDER BY id`
+	if q.Limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, q.Limit)
+	}
+
+	listOnce := func() ([]*domain_todo.Comment, error) {
+		rows, err := exec.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		var comments []*domain_todo.Comment
+		for rows.Next() {
+			c, err := scanComment(rows)
+			if err != nil {
+				return nil, err
+			}
+			comments = append(comments, c)
+		}
+		return comments, rows.Err()
+	}
+
+	if _, inTx := TxFromContext(ctx); inTx {
+		return listOnce()
+	}
+
+	var comments []*domain_todo.Comment
+	err := doWithRetry(ctx, DefaultReadRetry, r.logger, func() error {
+		list, err := listOnce()
+		if err != nil {
+			return err
+		}
+		comments = list
+		return nil
+	})
+	if err != nil {
+		r.logger.Error("failed to list comments",
+			zap.Int64("todo_id", todoID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("query comments: %w", err)
+	}
+	return comments, nil
+}
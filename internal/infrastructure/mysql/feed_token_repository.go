@@ -0,0 +1,97 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"go.uber.org/zap"
+)
+
+// FeedTokenRepository はフィードトークンの MySQL 実装（所有者ごとに 1 行、トークンのハッシュだけを持つ）。
+type FeedTokenRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewFeedTokenRepository(db *sql.DB, logger *zap.Logger) *FeedTokenRepository {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &FeedTokenRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *FeedTokenRepository) getExecutor(ctx context.Context) executor {
+	return getExecutor(ctx, r.db)
+}
+
+func (r *FeedTokenRepository) SaveFeedToken(ctx context.Context, ownerID string, hash []byte) error {
+	exec := r.getExecutor(ctx)
+
+	_, err := exec.ExecContext(ctx,
+		`INSERT INTO calendar_feed_tokens (owner_id, token_hash, created_at) VALUES (?, ?, ?)
+		 ON DUPLICATE KEY UPDATE token_hash = VALUES(token_hash), created_at = VALUES(created_at)`,
+		ownerID,
+		hash,
+		time.Now(),
+	)
+	if err != nil {
+		r.logger.Error("failed to save feed token",
+			zap.String("owner_id", ownerID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("save feed token: %w", err)
+	}
+	return nil
+}
+
+func (r *FeedTokenRepository) DeleteFeedToken(ctx context.Context, ownerID string) (bool, error) {
+	exec := r.getExecutor(ctx)
+
+	res, err := exec.ExecContext(ctx,
+		`DELETE FROM calendar_feed_tokens WHERE owner_id = ?`,
+		ownerID,
+	)
+	if err != nil {
+		r.logger.Error("failed to delete feed token",
+			zap.String("owner_id", ownerID),
+			zap.Error(err),
+		)
+		return false, fmt.Errorf("delete feed token: %w", err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("rows affected (delete feed token): %w", err)
+	}
+	return n > 0, nil
+}
+
+func (r *FeedTokenRepository) FeedTokenOwner(ctx context.Context, hash []byte) (string, error) {
+	exec := r.getExecutor(ctx)
+
+	var ownerID string
+	err := doWithRetry(ctx, DefaultReadRetry, r.logger, func() error {
+		err := exec.QueryRowContext(ctx,
+			`SELECT owner_id FROM calendar_feed_tokens WHERE token_hash = ?`,
+			hash,
+		).Scan(&ownerID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain_todo.ErrFeedTokenNotFound
+		}
+		return err
+	})
+	if errors.Is(err, domain_todo.ErrFeedTokenNotFound) {
+		return "", err
+	}
+	if err != nil {
+		return "", fmt.Errorf("query feed token: %w", err)
+	}
+	return ownerID, nil
+}
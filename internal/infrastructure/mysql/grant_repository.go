@@ -0,0 +1,318 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"go.uber.org/zap"
+)
+
+// GrantRepository は共有（コラボレーター）の MySQL 実装。
+// todo_grants は対象（resource_type, resource_id）と共有された人の組で 1 行。
+// 対象は Todo とリストのどちらも指すので外部キーは無く、対象を物理削除するときに usecase が DeleteGrantsFor で消す。
+type GrantRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewGrantRepository(db *sql.DB, logger *zap.Logger) *GrantRepository {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &GrantRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *GrantRepository) getExecutor(ctx context.Context) executor {
+	return getExecutor(ctx, r.db)
+}
+
+const grantColumns = `resource_type, resource_id, owner_id, grantee_id, role, granted_by, created_at, updated_at`
+
+func scanGrant(s interface{ Scan(dest ...any) error }) (*domain_todo.Grant, error) {
+	var g domain_todo.Grant
+	if err := s.Scan(
+		&g.Resource.Type,
+		&g.Resource.ID,
+		&g.OwnerID,
+		&g.GranteeID,
+		&g.Role,
+		&g.GrantedBy,
+		&g.CreatedAt,
+		&g.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &g, nil
+}
+
+// TodoAccess は Todo への共有と、Todo が入っているリストへの共有のうち強い方の役割を返す。
+// 共有は Todo の所有者が作ったものだけを数える（別の所有者のリストへの共有が、そのリストを指す Todo に効かないように）。
+func (r *GrantRepository) TodoAccess(ctx context.Context, granteeID string, todoID int64) (string, domain_todo.Role, error) {
+	exec := r.getExecutor(ctx)
+
+	var (
+		ownerID string
+		role    domain_todo.Role
+	)
+	err := r.readRow(ctx, func() error {
+		err := exec.QueryRowContext(ctx,
+			`SELECT t.owner_id, COALESCE((
+			   SELECT MAX(g.role) FROM todo_grants g
+			   WHERE g.grantee_id = ? AND g.owner_id = t.owner_id
+			     AND ((g.resource_type = ? AND g.resource_id = t.id) OR (g.resource_type = ? AND g.resource_id = t.list_id))
+			 ), 0)
+			 FROM todos t WHERE t.id = ?`,
+			granteeID,
+			domain_todo.ResourceTodo,
+			domain_todo.ResourceList,
+			todoID,
+		).Scan(&ownerID, &role)
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain_todo.ErrNotFound
+		}
+		return err
+	})
+	if errors.Is(err, domain_todo.ErrNotFound) {
+		return "", domain_todo.RoleNone, err
+	}
+	if err != nil {
+		return "", domain_todo.RoleNone, fmt.Errorf("query todo access: %w", err)
+	}
+	return ownerID, role, nil
+}
+
+func (r *GrantRepository) ListAccess(ctx context.Context, granteeID string, listID int64) (string, domain_todo.Role, error) {
+	exec := r.getExecutor(ctx)
+
+	var (
+		ownerID string
+		role    domain_todo.Role
+	)
+	err := r.readRow(ctx, func() error {
+		err := exec.QueryRowContext(ctx,
+			`SELECT l.owner_id, COALESCE((
+			   SELECT g.role FROM todo_grants g
+			   WHERE g.resource_type = ? AND g.resource_id = l.id AND g.grantee_id = ? AND g.owner_id = l.owner_id
+			 ), 0)
+			 FROM todo_lists l WHERE l.id = ?`,
+			domain_todo.ResourceList,
+			granteeID,
+			listID,
+		).Scan(&ownerID, &role)
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain_todo.ErrListNotFound
+		}
+		return err
+	})
+	if errors.Is(err, domain_todo.ErrListNotFound) {
+		return "", domain_todo.RoleNone, err
+	}
+	if err != nil {
+		return "", domain_todo.RoleNone, fmt.Errorf("query list access: %w", err)
+	}
+	return ownerID, role, nil
+}
+
+// readRow は 1 行の読み出しの共通部分。Tx の中では Tx を貼り直せないので read-retry しない（queryGrants と同じ）。
+func (r *GrantRepository) readRow(ctx context.Context, fn func() error) error {
+	if _, inTx := TxFromContext(ctx); inTx {
+		return fn()
+	}
+	return doWithRetry(ctx, DefaultReadRetry, r.logger, fn)
+}
+
+func (r *GrantRepository) CreateGrant(ctx context.Context, g *domain_todo.Grant) error {
+	exec := r.getExecutor(ctx)
+
+	now := time.Now()
+	_, err := exec.ExecContext(ctx,
+		`INSERT INTO todo_grants (`+grantColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		g.Resource.Type,
+		g.Resource.ID,
+		g.OwnerID,
+		g.GranteeID,
+		g.Role,
+		g.GrantedBy,
+		now,
+		now,
+	)
+	if isDuplicateEntry(err) {
+		return domain_todo.ErrGrantAlreadyExists
+	}
+	if err != nil {
+		r.logger.Error("failed to insert grant",
+			zap.Int32("resource_type", int32(g.Resource.Type)),
+			zap.Int64("resource_id", g.Resource.ID),
+			zap.String("grantee_id", g.GranteeID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("insert grant: %w", err)
+	}
+	g.CreatedAt, g.UpdatedAt = now, now
+	return nil
+}
+
+func (r *GrantRepository) UpdateGrantRole(ctx context.Context, res domain_todo.Resource, granteeID string, role domain_todo.Role) (*domain_todo.Grant, error) {
+	exec := r.getExecutor(ctx)
+
+	_, err := exec.ExecContext(ctx,
+		`UPDATE todo_grants SET role = ?, updated_at = ? WHERE resource_type = ? AND resource_id = ? AND grantee_id = ?`,
+		role,
+		time.Now(),
+		res.Type,
+		res.ID,
+		granteeID,
+	)
+	if err != nil {
+		r.logger.Error("failed to update grant",
+			zap.Int32("resource_type", int32(res.Type)),
+			zap.Int64("resource_id", res.ID),
+			zap.String("grantee_id", granteeID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("update grant: %w", err)
+	}
+
+	// 役割が変わらなくても updated_at は変わるが、存在確認は読み戻しでまとめて行う
+	g, err := scanGrant(exec.QueryRowContext(ctx,
+		`SELECT `+grantColumns+` FROM todo_grants WHERE resource_type = ? AND resource_id = ? AND grantee_id = ?`,
+		res.Type,
+		res.ID,
+		granteeID,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain_todo.ErrGrantNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load grant: %w", err)
+	}
+	return g, nil
+}
+
+func (r *GrantRepository) DeleteGrant(ctx context.Context, res domain_todo.Resource, granteeID string) (bool, error) {
+	exec := r.getExecutor(ctx)
+
+	result, err := exec.ExecContext(ctx,
+		`DELETE FROM todo_grants WHERE resource_type = ? AND resource_id = ? AND grantee_id = ?`,
+		res.Type,
+		res.ID,
+		granteeID,
+	)
+	if err != nil {
+		r.logger.Error("failed to delete grant",
+			zap.Int32("resource_type", int32(res.Type)),
+			zap.Int64("resource_id", res.ID),
+			zap.String("grantee_id", granteeID),
+			zap.Error(err),
+		)
+		return false, fmt.Errorf("delete grant: %w", err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("rows affected (delete grant): %w", err)
+	}
+	return n > 0, nil
+}
+
+func (r *GrantRepository) ListGrants(ctx context.Context, res domain_todo.Resource) ([]*domain_todo.Grant, error) {
+	grants, err := r.queryGrants(ctx,
+		`SELECT `+grantColumns+` FROM todo_grants
+		 WHERE resource_type = ? AND resource_id = ?
+		 ORDER BY created_at, grantee_id`,
+		res.Type,
+		res.ID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list grants: %w", err)
+	}
+	return grants, nil
+}
+
+func (r *GrantRepository) ListGrantsForGrantee(ctx context.Context, granteeID string) ([]*domain_todo.Grant, error) {
+	grants, err := r.queryGrants(ctx,
+		`SELECT `+grantColumns+` FROM todo_grants g
+		 WHERE g.grantee_id = ?
+		   AND ((g.resource_type = ? AND EXISTS (SELECT 1 FROM todos t WHERE t.id = g.resource_id AND t.deleted_at IS NULL))
+		     OR (g.resource_type = ? AND EXISTS (SELECT 1 FROM todo_lists l WHERE l.id = g.resource_id)))
+		 ORDER BY g.created_at DESC, g.resource_type, g.resource_id`,
+		granteeID,
+		domain_todo.ResourceTodo,
+		domain_todo.ResourceList,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list grants for grantee: %w", err)
+	}
+	return grants, nil
+}
+
+func (r *GrantRepository) DeleteGrantsFor(ctx context.Context, rs ...domain_todo.Resource) error {
+	if len(rs) == 0 {
+		return nil
+	}
+	exec := r.getExecutor(ctx)
+
+	args := make([]any, 0, len(rs)*2)
+	for _, res := range rs {
+		args = append(args, res.Type, res.ID)
+	}
+	_, err := exec.ExecContext(ctx,
+		`DELETE FROM todo_grants WHERE (resource_type, resource_id) IN (`+
+			strings.TrimSuffix(strings.Repeat("(?, ?), ", len(rs)), ", ")+`)`,
+		args...,
+	)
+	if err != nil {
+		r.logger.Error("failed to delete grants",
+			zap.Int("resources", len(rs)),
+			zap.Error(err),
+		)
+		return fmt.Errorf("delete grants: %w", err)
+	}
+	return nil
+}
+
+// queryGrants は一覧の読み出しの共通部分（Tx の外では read-retry する）。
+func (r *GrantRepository) queryGrants(ctx context.Context, query string, args ...any) ([]*domain_todo.Grant, error) {
+	exec := r.getExecutor(ctx)
+
+	listOnce := func() ([]*domain_todo.Grant, error) {
+		rows, err := exec.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		var grants []*domain_todo.Grant
+		for rows.Next() {
+			g, err := scanGrant(rows)
+			if err != nil {
+				return nil, err
+			}
+			grants = append(grants, g)
+		}
+		return grants, rows.Err()
+	}
+
+	if _, inTx := TxFromContext(ctx); inTx {
+		return listOnce()
+	}
+
+	var grants []*domain_todo.Grant
+	err := doWithRetry(ctx, DefaultReadRetry, r.logger, func() error {
+		list, err := listOnce()
+		if err != nil {
+			return err
+		}
+		grants = list
+		return nil
+	})
+	return grants, err
+}
@@ -0,0 +1,268 @@
+package mysql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"go.uber.org/zap"
+)
+
+// 変更履歴は Todo の変更と同じ Tx で書くので、TodoRepository に実装する。
+// todos への外部キーは張らない（Todo を物理削除しても履歴は残す）。
+
+// historyColumns は todo_history の SELECT で使う列。scanHistory と順番を揃えること。
+const historyColumns = `id, owner_id, todo_id, action, actor, request_id, before_snapshot, after_snapshot, created_at`
+
+// todoSnapshot は履歴に JSON で保存する Todo の形。
+// ドメインのエンティティに json タグを付けないよう、ここで詰め替える。
+// 列を足すときは、古い履歴が読めなくならないようフィールドの追加だけにすること。
+type todoSnapshot struct {
+	ID           int64               `json:"id"`
+	OwnerID      string              `json:"owner_id"`
+	AssigneeID   string              `json:"assignee_id,omitempty"`
+	ListID       int64               `json:"list_id,omitempty"`
+	Title        string              `json:"title"`
+	Done         bool                `json:"done"`
+	DueAt        *time.Time          `json:"due_at,omitempty"`
+	Priority     int32               `json:"priority,omitempty"`
+	Notes        string              `json:"notes,omitempty"`
+	Labels       []labelSnapshot     `json:"labels,omitempty"`
+	Items        []checklistSnapshot `json:"items,omitempty"`
+	AutoComplete bool                `json:"auto_complete,omitempty"`
+	Position     int64               `json:"position"`
+	Recurrence   string              `json:"recurrence,omitempty"`
+	CreatedAt    time.Time           `json:"created_at"`
+	UpdatedAt    time.Time           `json:"updated_at"`
+	Version      int64               `json:"version"`
+	DeletedAt    *time.Time          `json:"deleted_at,omitempty"`
+}
+
+type labelSnapshot struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color,omitempty"`
+}
+
+type checklistSnapshot struct {
+	ID       int64  `json:"id"`
+	Title    string `json:"title"`
+	Done     bool   `json:"done"`
+	Position int    `json:"position"`
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// marshalSnapshot は nil を SQL の NULL にする。
+// []byte のまま渡すと binary 文字列扱いで JSON 列に入らないので、文字列にして返す。
+func marshalSnapshot(t *domain_todo.Todo) (any, error) {
+	if t == nil {
+		return nil, nil
+	}
+
+	s := todoSnapshot{
+		ID:           t.ID,
+		OwnerID:      t.OwnerID,
+		AssigneeID:   t.AssigneeID,
+		ListID:       t.ListID,
+		Title:        t.Title,
+		Done:         t.Done,
+		DueAt:        optionalTime(t.DueAt),
+		Priority:     int32(t.Priority),
+		Notes:        t.Notes,
+		AutoComplete: t.AutoComplete,
+		Position:     t.Position,
+		Recurrence:   t.Recurrence.String(),
+		CreatedAt:    t.CreatedAt,
+		UpdatedAt:    t.UpdatedAt,
+		Version:      t.Version,
+		DeletedAt:    optionalTime(t.DeletedAt),
+	}
+	for _, l := range t.Labels {
+		s.Labels = append(s.Labels, labelSnapshot{ID: l.ID, Name: l.Name, Color: l.Color})
+	}
+	for _, it := range t.Items {
+		s.Items = append(s.Items, checklistSnapshot{ID: it.ID, Title: it.Title, Done: it.Done, Position: it.Position})
+	}
+
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// unmarshalSnapshot は NULL（空）を nil にする
+func unmarshalSnapshot(b []byte) (*domain_todo.Todo, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+
+	var s todoSnapshot
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, err
+	}
+	recurrence, err := domain_todo.ParseRecurrence(s.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &domain_todo.Todo{
+		ID:           s.ID,
+		OwnerID:      s.OwnerID,
+		AssigneeID:   s.AssigneeID,
+		ListID:       s.ListID,
+		Title:        s.Title,
+		Done:         s.Done,
+		Priority:     domain_todo.Priority(s.Priority),
+		Notes:        s.Notes,
+		AutoComplete: s.AutoComplete,
+		Position:     s.Position,
+		Recurrence:   recurrence,
+		CreatedAt:    s.CreatedAt,
+		UpdatedAt:    s.UpdatedAt,
+		Version:      s.Version,
+	}
+	if s.DueAt != nil {
+		t.DueAt = *s.DueAt
+	}
+	if s.DeletedAt != nil {
+		t.DeletedAt = *s.DeletedAt
+	}
+	for _, l := range s.Labels {
+		t.Labels = append(t.Labels, domain_todo.Label{ID: l.ID, OwnerID: s.OwnerID, Name: l.Name, Color: l.Color})
+	}
+	for _, it := range s.Items {
+		t.Items = append(t.Items, domain_todo.ChecklistItem{ID: it.ID, Title: it.Title, Done: it.Done, Position: it.Position})
+	}
+	return t, nil
+}
+
+func scanHistory(s rowScanner) (*domain_todo.HistoryEntry, error) {
+	var (
+		e             domain_todo.HistoryEntry
+		before, after []byte
+	)
+	if err := s.Scan(&e.ID, &e.OwnerID, &e.TodoID, &e.Action, &e.Actor, &e.RequestID, &before, &after, &e.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if e.Before, err = unmarshalSnapshot(before); err != nil {
+		return nil, fmt.Errorf("decode before snapshot of history %d: %w", e.ID, err)
+	}
+	if e.After, err = unmarshalSnapshot(after); err != nil {
+		return nil, fmt.Errorf("decode after snapshot of history %d: %w", e.ID, err)
+	}
+	return &e, nil
+}
+
+func (r *TodoRepository) AppendHistory(ctx context.Context, e *domain_todo.HistoryEntry) error {
+	exec := r.getExecutor(ctx)
+
+	before, err := marshalSnapshot(e.Before)
+	if err != nil {
+		return fmt.Errorf("encode before snapshot: %w", err)
+	}
+	after, err := marshalSnapshot(e.After)
+	if err != nil {
+		return fmt.Errorf("encode after snapshot: %w", err)
+	}
+
+	res, err := exec.ExecContext(ctx,
+		`INSERT INTO todo_history (owner_id, todo_id, action, actor, request_id, before_snapshot, after_snapshot) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		e.OwnerID,
+		e.TodoID,
+		e.Action,
+		e.Actor,
+		e.RequestID,
+		before,
+		after,
+	)
+	if err != nil {
+		r.logger.Error("failed to insert todo history",
+			zap.String("owner_id", e.OwnerID),
+			zap.Int64("todo_id", e.TodoID),
+			zap.Int32("action", int32(e.Action)),
+			zap.Error(err),
+		)
+		return fmt.Errorf("insert todo history: %w", err)
+	}
+
+	if e.ID, err = res.LastInsertId(); err != nil {
+		return fmt.Errorf("get last insert id: %w", err)
+	}
+	// created_at は DB 側のデフォルトで埋まるので読み戻す
+	if err := exec.QueryRowContext(ctx,
+		`SELECT created_at FROM todo_history WHERE id = ?`,
+		e.ID,
+	).Scan(&e.CreatedAt); err != nil {
+		return fmt.Errorf("load todo history created_at: %w", err)
+	}
+	return nil
+}
+
+func (r *TodoRepository) ListHistory(ctx context.Context, ownerID string, todoID int64, q domain_todo.HistoryQuery) ([]*domain_todo.HistoryEntry, error) {
+	exec := r.getExecutor(ctx)
+
+	query := `SELECT ` + historyColumns + ` FROM todo_history WHERE owner_id = ? AND todo_id = ?`
+	args := []any{ownerID, todoID}
+	if q.BeforeID != 0 {
+		query += ` AND id < ?`
+		args = append(args, q.BeforeID)
+	}
+	query += ` ORDER BY id DESC`
+	if q.Limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, q.Limit)
+	}
+
+	listOnce := func() ([]*domain_todo.HistoryEntry, error) {
+		rows, err := exec.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		var entries []*domain_todo.HistoryEntry
+		for rows.Next() {
+			e, err := scanHistory(rows)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, e)
+		}
+		return entries, rows.Err()
+	}
+
+	// List と同じく、Tx の中では read-retry は使わない（安全側）
+	if _, inTx := TxFromContext(ctx); inTx {
+		return listOnce()
+	}
+
+	var entries []*domain_todo.HistoryEntry
+	err := doWithRetry(ctx, DefaultReadRetry, r.logger, func() error {
+		list, err := listOnce()
+		if err != nil {
+			return err
+		}
+		entries = list
+		return nil
+	})
+	if err != nil {
+		r.logger.Error("failed to list todo history",
+			zap.String("owner_id", ownerID),
+			zap.Int64("todo_id", todoID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("query todo history: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,166 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	domain_idempotency "github.com/hijjiri/grpc-echo/internal/domain/idempotency"
+	"go.uber.org/zap"
+)
+
+// IdempotencyRepository は冪等キーの MySQL 実装。
+// キーの行は Todo の変更と同じ Tx で追加するので、TodoRepository と同じ TxManager の Tx に乗る。
+type IdempotencyRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewIdempotencyRepository(db *sql.DB, logger *zap.Logger) *IdempotencyRepository {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &IdempotencyRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *IdempotencyRepository) getExecutor(ctx context.Context) executor {
+	return getExecutor(ctx, r.db)
+}
+
+func (r *IdempotencyRepository) Get(ctx context.Context, ownerID, key string, now time.Time) (*domain_idempotency.Record, error) {
+	exec := r.getExecutor(ctx)
+
+	getOnce := func() (*domain_idempotency.Record, error) {
+		rec := domain_idempotency.Record{OwnerID: ownerID, Key: key}
+		err := exec.QueryRowContext(ctx,
+			`SELECT method, request_hash, response, created_at, expires_at FROM idempotency_keys WHERE owner_id = ? AND idempotency_key = ? AND expires_at > ?`,
+			ownerID,
+			key,
+			now,
+		).Scan(&rec.Method, &rec.RequestHash, &rec.Response, &rec.CreatedAt, &rec.ExpiresAt)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain_idempotency.ErrNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &rec, nil
+	}
+
+	// TodoRepository と同じく、Tx の中では read-retry は使わない（安全側）
+	if _, inTx := TxFromContext(ctx); inTx {
+		return getOnce()
+	}
+
+	var rec *domain_idempotency.Record
+	err := doWithRetry(ctx, DefaultReadRetry, r.logger, func() error {
+		got, err := getOnce()
+		if err != nil {
+			return err
+		}
+		rec = got
+		return nil
+	})
+	if errors.Is(err, domain_idempotency.ErrNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query idempotency key: %w", err)
+	}
+	return rec, nil
+}
+
+func (r *IdempotencyRepository) Insert(ctx context.Context, rec *domain_idempotency.Record) error {
+	exec := r.getExecutor(ctx)
+	now := time.Now()
+
+	// 期限切れの行は、期限切れの掃除を待たずに置き換える
+	if _, err := exec.ExecContext(ctx,
+		`DELETE FROM idempotency_keys WHERE owner_id = ? AND idempotency_key = ? AND expires_at <= ?`,
+		rec.OwnerID,
+		rec.Key,
+		now,
+	); err != nil {
+		return fmt.Errorf("delete expired idempotency key: %w", err)
+	}
+
+	_, err := exec.ExecContext(ctx,
+		`INSERT INTO idempotency_keys (owner_id, idempotency_key, method, request_hash, response, created_at, expires_at) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		rec.OwnerID,
+		rec.Key,
+		rec.Method,
+		rec.RequestHash,
+		rec.Response,
+		now,
+		rec.ExpiresAt,
+	)
+	if isDuplicateEntry(err) {
+		return domain_idempotency.ErrKeyInUse
+	}
+	if err != nil {
+		r.logger.Error("failed to insert idempotency key",
+			zap.String("owner_id", rec.OwnerID),
+			zap.String("method", rec.Method),
+			zap.Error(err),
+		)
+		return fmt.Errorf("insert idempotency key: %w", err)
+	}
+
+	rec.CreatedAt = now
+	return nil
+}
+
+func (r *IdempotencyRepository) SaveResponse(ctx context.Context, ownerID, key string, response []byte) error {
+	exec := r.getExecutor(ctx)
+
+	res, err := exec.ExecContext(ctx,
+		`UPDATE idempotency_keys SET response = ? WHERE owner_id = ? AND idempotency_key = ?`,
+		response,
+		ownerID,
+		key,
+	)
+	if err != nil {
+		r.logger.Error("failed to save idempotent response",
+			zap.String("owner_id", ownerID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("update idempotency key: %w", err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected (save idempotent response): %w", err)
+	}
+	if n == 0 {
+		return domain_idempotency.ErrNotFound
+	}
+	return nil
+}
+
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context, before time.Time, limit int) (int64, error) {
+	exec := r.getExecutor(ctx)
+
+	res, err := exec.ExecContext(ctx,
+		`DELETE FROM idempotency_keys WHERE expires_at <= ? ORDER BY expires_at LIMIT ?`,
+		before,
+		limit,
+	)
+	if err != nil {
+		r.logger.Error("failed to delete expired idempotency keys",
+			zap.Time("before", before),
+			zap.Error(err),
+		)
+		return 0, fmt.Errorf("delete expired idempotency keys: %w", err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("rows affected (delete expired idempotency keys): %w", err)
+	}
+	return n, nil
+}
@@ -0,0 +1,351 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	mysqldriver "github.com/go-sql-driver/mysql"
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"go.uber.org/zap"
+)
+
+// ラベルは Todo と同じ DB・同じ Tx で扱うので、TodoRepository にまとめて実装する。
+
+// labelColumns は labels の SELECT で使う列。scanLabel と順番を揃えること。
+const labelColumns = `id, owner_id, name, color, created_at, updated_at`
+
+// mysqlErrDuplicateEntry は UNIQUE 制約違反（ER_DUP_ENTRY）
+const mysqlErrDuplicateEntry = 1062
+
+func scanLabel(s rowScanner) (*domain_todo.Label, error) {
+	var l domain_todo.Label
+	if err := s.Scan(&l.ID, &l.OwnerID, &l.Name, &l.Color, &l.CreatedAt, &l.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+// isDuplicateEntry は UNIQUE 制約違反かどうか
+func isDuplicateEntry(err error) bool {
+	var me *mysqldriver.MySQLError
+	return errors.As(err, &me) && me.Number == mysqlErrDuplicateEntry
+}
+
+func (r *TodoRepository) ListLabels(ctx context.Context, ownerID string) ([]*domain_todo.Label, error) {
+	exec := r.getExecutor(ctx)
+
+	listOnce := func() ([]*domain_todo.Label, error) {
+		rows, err := exec.QueryContext(ctx,
+			`SELECT `+labelColumns+` FROM labels WHERE owner_id = ? ORDER BY name, id`,
+			ownerID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		var labels []*domain_todo.Label
+		for rows.Next() {
+			l, err := scanLabel(rows)
+			if err != nil {
+				return nil, err
+			}
+			labels = append(labels, l)
+		}
+		return labels, rows.Err()
+	}
+
+	// List と同じく、Tx の中では read-retry は使わない（安全側）
+	if _, inTx := TxFromContext(ctx); inTx {
+		return listOnce()
+	}
+
+	var labels []*domain_todo.Label
+	err := doWithRetry(ctx, DefaultReadRetry, r.logger, func() error {
+		list, err := listOnce()
+		if err != nil {
+			return err
+		}
+		labels = list
+		return nil
+	})
+	if err != nil {
+		r.logger.Error("failed to list labels",
+			zap.String("owner_id", ownerID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("query labels: %w", err)
+	}
+
+	return labels, nil
+}
+
+func (r *TodoRepository) GetLabel(ctx context.Context, ownerID string, id int64) (*domain_todo.Label, error) {
+	exec := r.getExecutor(ctx)
+
+	getOnce := func() (*domain_todo.Label, error) {
+		l, err := scanLabel(exec.QueryRowContext(ctx,
+			`SELECT `+labelColumns+` FROM labels WHERE id = ? AND owner_id = ?`,
+			id,
+			ownerID,
+		))
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain_todo.ErrLabelNotFound
+		}
+		return l, err
+	}
+
+	if _, inTx := TxFromContext(ctx); inTx {
+		return getOnce()
+	}
+
+	var label *domain_todo.Label
+	err := doWithRetry(ctx, DefaultReadRetry, r.logger, func() error {
+		l, err := getOnce()
+		if err != nil {
+			return err
+		}
+		label = l
+		return nil
+	})
+	if errors.Is(err, domain_todo.ErrLabelNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		r.logger.Error("failed to get label",
+			zap.Int64("id", id),
+			zap.String("owner_id", ownerID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("query label: %w", err)
+	}
+
+	return label, nil
+}
+
+func (r *TodoRepository) CreateLabel(ctx context.Context, l *domain_todo.Label) (*domain_todo.Label, error) {
+	exec := r.getExecutor(ctx)
+
+	res, err := exec.ExecContext(ctx,
+		`INSERT INTO labels (owner_id, name, color) VALUES (?, ?, ?)`,
+		l.OwnerID,
+		l.Name,
+		l.Color,
+	)
+	if isDuplicateEntry(err) {
+		return nil, domain_todo.ErrLabelAlreadyExists
+	}
+	if err != nil {
+		r.logger.Error("failed to insert label",
+			zap.String("owner_id", l.OwnerID),
+			zap.String("name", l.Name),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("insert label: %w", err)
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("get last insert id: %w", err)
+	}
+	l.ID = id
+
+	// created_at / updated_at は DB 側のデフォルトで埋まるので読み戻す
+	if err := r.loadLabelTimes(ctx, exec, l); err != nil {
+		return nil, fmt.Errorf("load label db columns: %w", err)
+	}
+
+	r.logger.Info("label created",
+		zap.Int64("id", l.ID),
+		zap.String("owner_id", l.OwnerID),
+		zap.String("name", l.Name),
+	)
+	return l, nil
+}
+
+func (r *TodoRepository) UpdateLabel(ctx context.Context, l *domain_todo.Label) (*domain_todo.Label, error) {
+	exec := r.getExecutor(ctx)
+
+	_, err := exec.ExecContext(ctx,
+		`UPDATE labels SET name = ?, color = ? WHERE id = ? AND owner_id = ?`,
+		l.Name,
+		l.Color,
+		l.ID,
+		l.OwnerID,
+	)
+	if isDuplicateEntry(err) {
+		return nil, domain_todo.ErrLabelAlreadyExists
+	}
+	if err != nil {
+		r.logger.Error("failed to update label",
+			zap.Int64("id", l.ID),
+			zap.String("owner_id", l.OwnerID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("update label: %w", err)
+	}
+
+	// 値が変わらないと RowsAffected は 0 になるので、存在確認は読み戻しで行う
+	if err := r.loadLabelTimes(ctx, exec, l); err != nil {
+		if errors.Is(err, domain_todo.ErrLabelNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("load label db columns: %w", err)
+	}
+
+	r.logger.Info("label updated",
+		zap.Int64("id", l.ID),
+		zap.String("name", l.Name),
+	)
+	return l, nil
+}
+
+// DeleteLabel はラベルを物理削除する。todo_labels の行は FK の ON DELETE CASCADE で消える。
+func (r *TodoRepository) DeleteLabel(ctx context.Context, ownerID string, id int64) (bool, error) {
+	exec := r.getExecutor(ctx)
+
+	res, err := exec.ExecContext(ctx,
+		`DELETE FROM labels WHERE id = ? AND owner_id = ?`,
+		id,
+		ownerID,
+	)
+	if err != nil {
+		r.logger.Error("failed to delete label",
+			zap.Int64("id", id),
+			zap.String("owner_id", ownerID),
+			zap.Error(err),
+		)
+		return false, fmt.Errorf("delete label: %w", err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("rows affected (delete label): %w", err)
+	}
+
+	r.logger.Info("label deleted",
+		zap.Int64("id", id),
+		zap.String("owner_id", ownerID),
+		zap.Bool("deleted", n > 0),
+	)
+	return n > 0, nil
+}
+
+func (r *TodoRepository) SetTodoLabels(ctx context.Context, ownerID string, todoID int64, labelIDs []int64) error {
+	exec := r.getExecutor(ctx)
+
+	args := make([]any, 0, len(labelIDs)+1)
+	for _, id := range labelIDs {
+		args = append(args, id)
+	}
+
+	// 他人のラベル・存在しないラベルが混ざっていないか（labelIDs は重複除去済み）
+	if len(labelIDs) > 0 {
+		var n int
+		err := exec.QueryRowContext(ctx,
+			`SELECT COUNT(*) FROM labels WHERE owner_id = ? AND id IN (`+placeholders(len(labelIDs))+`)`,
+			append([]any{ownerID}, args...)...,
+		).Scan(&n)
+		if err != nil {
+			return fmt.Errorf("count labels: %w", err)
+		}
+		if n != len(labelIDs) {
+			return domain_todo.ErrLabelNotFound
+		}
+	}
+
+	// 外すもの: labelIDs に含まれないもの全部
+	query := `DELETE FROM todo_labels WHERE todo_id = ?`
+	if len(labelIDs) > 0 {
+		query += ` AND label_id NOT IN (` + placeholders(len(labelIDs)) + `)`
+	}
+	if _, err := exec.ExecContext(ctx, query, append([]any{todoID}, args...)...); err != nil {
+		r.logger.Error("failed to detach labels",
+			zap.Int64("todo_id", todoID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("delete todo labels: %w", err)
+	}
+
+	// 付けるもの: 既にあるものは INSERT IGNORE で飛ばす
+	if len(labelIDs) > 0 {
+		values := make([]any, 0, len(labelIDs)*2)
+		for _, id := range labelIDs {
+			values = append(values, todoID, id)
+		}
+		query := `INSERT IGNORE INTO todo_labels (todo_id, label_id) VALUES ` +
+			strings.TrimSuffix(strings.Repeat("(?, ?), ", len(labelIDs)), ", ")
+		if _, err := exec.ExecContext(ctx, query, values...); err != nil {
+			r.logger.Error("failed to attach labels",
+				zap.Int64("todo_id", todoID),
+				zap.Int64s("label_ids", labelIDs),
+				zap.Error(err),
+			)
+			return fmt.Errorf("insert todo labels: %w", err)
+		}
+	}
+
+	r.logger.Info("todo labels set",
+		zap.Int64("todo_id", todoID),
+		zap.Int64s("label_ids", labelIDs),
+	)
+	return nil
+}
+
+// loadLabels は todos それぞれに付いているラベルを 1 回の SELECT でまとめて詰める（N+1 を避ける）
+func (r *TodoRepository) loadLabels(ctx context.Context, exec executor, todos ...*domain_todo.Todo) error {
+	if len(todos) == 0 {
+		return nil
+	}
+
+	byID := make(map[int64]*domain_todo.Todo, len(todos))
+	args := make([]any, 0, len(todos))
+	for _, t := range todos {
+		byID[t.ID] = t
+		args = append(args, t.ID)
+	}
+
+	rows, err := exec.QueryContext(ctx,
+		`SELECT tl.todo_id, l.id, l.owner_id, l.name, l.color, l.created_at, l.updated_at
+		   FROM todo_labels tl
+		   JOIN labels l ON l.id = tl.label_id
+		  WHERE tl.todo_id IN (`+placeholders(len(todos))+`)
+		  ORDER BY l.name, l.id`,
+		args...,
+	)
+	if err != nil {
+		return fmt.Errorf("query todo labels: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			todoID int64
+			l      domain_todo.Label
+		)
+		if err := rows.Scan(&todoID, &l.ID, &l.OwnerID, &l.Name, &l.Color, &l.CreatedAt, &l.UpdatedAt); err != nil {
+			return err
+		}
+		if t, ok := byID[todoID]; ok {
+			t.Labels = append(t.Labels, l)
+		}
+	}
+	return rows.Err()
+}
+
+// loadLabelTimes は l.ID / l.OwnerID の行から created_at / updated_at を読み戻す。
+// 行が無ければ domain_todo.ErrLabelNotFound を返す。
+func (r *TodoRepository) loadLabelTimes(ctx context.Context, exec executor, l *domain_todo.Label) error {
+	err := exec.QueryRowContext(ctx,
+		`SELECT created_at, updated_at FROM labels WHERE id = ? AND owner_id = ?`,
+		l.ID,
+		l.OwnerID,
+	).Scan(&l.CreatedAt, &l.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain_todo.ErrLabelNotFound
+	}
+	return err
+}
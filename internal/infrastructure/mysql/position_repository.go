@@ -0,0 +1,77 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"go.uber.org/zap"
+)
+
+func (r *TodoRepository) LastPosition(ctx context.Context, ownerID string) (int64, error) {
+	var last int64
+	// FOR UPDATE で範囲をロックし、同じ所有者の同時作成が同じ位置を取らないようにする
+	err := r.getExecutor(ctx).QueryRowContext(ctx,
+		`SELECT COALESCE(MAX(position), 0) FROM todos WHERE owner_id = ? FOR UPDATE`,
+		ownerID,
+	).Scan(&last)
+	if err != nil {
+		return 0, fmt.Errorf("query last position: %w", err)
+	}
+	return last, nil
+}
+
+func (r *TodoRepository) NeighborPosition(ctx context.Context, anchor *domain_todo.Todo, excludeID int64, before bool) (int64, bool, error) {
+	// 並び順は (position, id) なので、同じ position の行は id で前後を決める
+	cond, order := `(position > ? OR (position = ? AND id > ?))`, `position ASC, id ASC`
+	if before {
+		cond, order = `(position < ? OR (position = ? AND id < ?))`, `position DESC, id DESC`
+	}
+
+	var pos int64
+	err := r.getExecutor(ctx).QueryRowContext(ctx,
+		`SELECT position FROM todos
+		  WHERE owner_id = ? AND deleted_at IS NULL AND id <> ? AND `+cond+`
+		  ORDER BY `+order+` LIMIT 1 FOR UPDATE`,
+		anchor.OwnerID,
+		excludeID,
+		anchor.Position,
+		anchor.Position,
+		anchor.ID,
+	).Scan(&pos)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("query neighbor position: %w", err)
+	}
+	return pos, true, nil
+}
+
+func (r *TodoRepository) RebalancePositions(ctx context.Context, ownerID string) error {
+	// updated_at は ON UPDATE で勝手に進むので、明示的に元の値を入れておく
+	res, err := r.getExecutor(ctx).ExecContext(ctx,
+		`UPDATE todos t
+		   JOIN (SELECT id, ROW_NUMBER() OVER (ORDER BY position, id) AS rn FROM todos WHERE owner_id = ?) r
+		     ON r.id = t.id
+		    SET t.position = r.rn * ?, t.updated_at = t.updated_at`,
+		ownerID,
+		domain_todo.PositionGap,
+	)
+	if err != nil {
+		r.logger.Error("failed to rebalance positions",
+			zap.String("owner_id", ownerID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("rebalance positions: %w", err)
+	}
+
+	n, _ := res.RowsAffected()
+	r.logger.Info("positions rebalanced",
+		zap.String("owner_id", ownerID),
+		zap.Int64("rows", n),
+	)
+	return nil
+}
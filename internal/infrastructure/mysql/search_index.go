@@ -0,0 +1,114 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"go.uber.org/zap"
+)
+
+// TodoSearchIndex は domain_todo.SearchIndex の MySQL 実装。
+// todos の FULLTEXT 索引（title, notes、ngram パーサ）をそのまま使う。
+// 索引は InnoDB が Todo の変更と同じ Tx で更新するので、Apply では何もしない。
+type TodoSearchIndex struct {
+	todos *TodoRepository
+}
+
+func NewTodoSearchIndex(todos *TodoRepository) *TodoSearchIndex {
+	return &TodoSearchIndex{todos: todos}
+}
+
+func (s *TodoSearchIndex) Apply(ctx context.Context, events ...domain_todo.Event) error {
+	return nil
+}
+
+func (s *TodoSearchIndex) Search(ctx context.Context, ownerID string, q domain_todo.SearchQuery) ([]domain_todo.SearchHit, error) {
+	r := s.todos
+	exec := r.getExecutor(ctx)
+	against := booleanQuery(q.Terms)
+
+	var hits []domain_todo.SearchHit
+	err := doWithRetry(ctx, DefaultReadRetry, r.logger, func() error {
+		rows, err := exec.QueryContext(ctx,
+			`SELECT `+todoColumns+`, MATCH (title, notes) AGAINST (? IN BOOLEAN MODE) AS score
+			   FROM todos
+			  WHERE owner_id = ? AND deleted_at IS NULL AND MATCH (title, notes) AGAINST (? IN BOOLEAN MODE)
+			  ORDER BY score DESC, id DESC
+			  LIMIT ? OFFSET ?`,
+			against,
+			ownerID,
+			against,
+			q.Limit,
+			q.Offset,
+		)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		hits = hits[:0]
+		var todos []*domain_todo.Todo
+		for rows.Next() {
+			var h domain_todo.SearchHit
+			if h.Todo, err = scanTodo(scoredRow{rows, &h.Score}); err != nil {
+				return err
+			}
+			hits = append(hits, h)
+			todos = append(todos, h.Todo)
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		if err := r.loadLabels(ctx, exec, todos...); err != nil {
+			return err
+		}
+		return r.loadItems(ctx, exec, todos...)
+	})
+	if err != nil {
+		r.logger.Error("failed to search todos",
+			zap.String("owner_id", ownerID),
+			zap.Int("terms", len(q.Terms)),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("search todos: %w", err)
+	}
+
+	r.logger.Info("todos searched",
+		zap.String("owner_id", ownerID),
+		zap.Int("terms", len(q.Terms)),
+		zap.Int("offset", q.Offset),
+		zap.Int("count", len(hits)),
+	)
+	return hits, nil
+}
+
+// scoredRow は todoColumns の後ろに続く score 列も一緒に読むための rowScanner
+type scoredRow struct {
+	rowScanner
+	score *float64
+}
+
+func (s scoredRow) Scan(dest ...any) error {
+	return s.rowScanner.Scan(append(dest, s.score)...)
+}
+
+// booleanQuery は語を BOOLEAN MODE のクエリにする（すべての語を必須にする）。
+// 語はフレーズとして囲むので、語の中の演算子は効かない（'"' は ParseSearchTerms で取り除いてある）。
+// ngram（2 文字）より短い 1 文字の語は、その文字で始まる n-gram の前方一致にする。
+func booleanQuery(terms []string) string {
+	parts := make([]string, 0, len(terms))
+	for _, t := range terms {
+		r, _ := utf8.DecodeRuneInString(t)
+		if utf8.RuneCountInString(t) == 1 && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			parts = append(parts, "+"+t+"*")
+			continue
+		}
+		parts = append(parts, `+"`+t+`"`)
+	}
+	return strings.Join(parts, " ")
+}
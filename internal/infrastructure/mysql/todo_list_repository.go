@@ -0,0 +1,278 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"go.uber.org/zap"
+)
+
+// todoListColumns は todo_lists の SELECT で使う列。scanTodoList と順番を揃えること。
+const todoListColumns = `id, owner_id, name, archived_at, created_at, updated_at`
+
+func scanTodoList(s rowScanner) (*domain_todo.TodoList, error) {
+	var (
+		l          domain_todo.TodoList
+		archivedAt sql.NullTime
+	)
+	if err := s.Scan(&l.ID, &l.OwnerID, &l.Name, &archivedAt, &l.CreatedAt, &l.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if archivedAt.Valid {
+		l.ArchivedAt = archivedAt.Time
+	}
+	return &l, nil
+}
+
+// TodoListRepository は TodoList 集約の MySQL 実装。
+// TodoRepository と同じ TxManager の Tx に乗る（ctx に Tx があればそれを使う）。
+type TodoListRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewTodoListRepository(db *sql.DB, logger *zap.Logger) *TodoListRepository {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &TodoListRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *TodoListRepository) getExecutor(ctx context.Context) executor {
+	return getExecutor(ctx, r.db)
+}
+
+func (r *TodoListRepository) ListTodoLists(ctx context.Context, ownerID string, includeArchived bool) ([]*domain_todo.TodoList, error) {
+	exec := r.getExecutor(ctx)
+
+	query := `SELECT ` + todoListColumns + ` FROM todo_lists WHERE owner_id = ?`
+	if !includeArchived {
+		query += ` AND archived_at IS NULL`
+	}
+	query += ` ORDER BY name, id`
+
+	listOnce := func() ([]*domain_todo.TodoList, error) {
+		rows, err := exec.QueryContext(ctx, query, ownerID)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		var lists []*domain_todo.TodoList
+		for rows.Next() {
+			l, err := scanTodoList(rows)
+			if err != nil {
+				return nil, err
+			}
+			lists = append(lists, l)
+		}
+		return lists, rows.Err()
+	}
+
+	// Tx の中では「Tx を貼り直してリトライ」ができないので、read-retry は使わない（安全側）
+	if _, inTx := TxFromContext(ctx); inTx {
+		return listOnce()
+	}
+
+	var lists []*domain_todo.TodoList
+	err := doWithRetry(ctx, DefaultReadRetry, r.logger, func() error {
+		l, err := listOnce()
+		if err != nil {
+			return err
+		}
+		lists = l
+		return nil
+	})
+	if err != nil {
+		r.logger.Error("failed to list todo lists",
+			zap.String("owner_id", ownerID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("query todo lists: %w", err)
+	}
+
+	return lists, nil
+}
+
+func (r *TodoListRepository) GetTodoList(ctx context.Context, ownerID string, id int64) (*domain_todo.TodoList, error) {
+	exec := r.getExecutor(ctx)
+
+	if _, inTx := TxFromContext(ctx); inTx {
+		return r.getOnce(ctx, exec, ownerID, id, false)
+	}
+
+	var list *domain_todo.TodoList
+	err := doWithRetry(ctx, DefaultReadRetry, r.logger, func() error {
+		l, err := r.getOnce(ctx, exec, ownerID, id, false)
+		if err != nil {
+			return err
+		}
+		list = l
+		return nil
+	})
+	if errors.Is(err, domain_todo.ErrListNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		r.logger.Error("failed to get todo list",
+			zap.Int64("id", id),
+			zap.String("owner_id", ownerID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("query todo list: %w", err)
+	}
+
+	return list, nil
+}
+
+// GetTodoListForUpdate は SELECT ... FOR UPDATE で 1 件読む。WithinTx の中で使うこと。
+func (r *TodoListRepository) GetTodoListForUpdate(ctx context.Context, ownerID string, id int64) (*domain_todo.TodoList, error) {
+	l, err := r.getOnce(ctx, r.getExecutor(ctx), ownerID, id, true)
+	if err != nil && !errors.Is(err, domain_todo.ErrListNotFound) {
+		r.logger.Error("failed to get todo list for update",
+			zap.Int64("id", id),
+			zap.String("owner_id", ownerID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("query todo list for update: %w", err)
+	}
+	return l, err
+}
+
+// getOnce は 1 回だけ SELECT して 1 件読む（リトライの最小単位）
+func (r *TodoListRepository) getOnce(ctx context.Context, exec executor, ownerID string, id int64, forUpdate bool) (*domain_todo.TodoList, error) {
+	query := `SELECT ` + todoListColumns + ` FROM todo_lists WHERE id = ? AND owner_id = ?`
+	if forUpdate {
+		query += ` FOR UPDATE`
+	}
+
+	l, err := scanTodoList(exec.QueryRowContext(ctx, query, id, ownerID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain_todo.ErrListNotFound
+	}
+	return l, err
+}
+
+func (r *TodoListRepository) CreateTodoList(ctx context.Context, l *domain_todo.TodoList) (*domain_todo.TodoList, error) {
+	exec := r.getExecutor(ctx)
+
+	res, err := exec.ExecContext(ctx,
+		`INSERT INTO todo_lists (owner_id, name, archived_at) VALUES (?, ?, ?)`,
+		l.OwnerID,
+		l.Name,
+		nullTime(l.ArchivedAt),
+	)
+	if err != nil {
+		r.logger.Error("failed to insert todo list",
+			zap.String("owner_id", l.OwnerID),
+			zap.String("name", l.Name),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("insert todo list: %w", err)
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("get last insert id: %w", err)
+	}
+
+	// created_at / updated_at は DB 側のデフォルトで埋まるので、行ごと読み戻す
+	created, err := r.getOnce(ctx, exec, l.OwnerID, id, false)
+	if err != nil {
+		return nil, fmt.Errorf("load todo list: %w", err)
+	}
+
+	r.logger.Info("todo list created",
+		zap.Int64("id", created.ID),
+		zap.String("owner_id", created.OwnerID),
+		zap.String("name", created.Name),
+	)
+	return created, nil
+}
+
+func (r *TodoListRepository) UpdateTodoList(ctx context.Context, l *domain_todo.TodoList) (*domain_todo.TodoList, error) {
+	exec := r.getExecutor(ctx)
+
+	_, err := exec.ExecContext(ctx,
+		`UPDATE todo_lists SET name = ?, archived_at = ? WHERE id = ? AND owner_id = ?`,
+		l.Name,
+		nullTime(l.ArchivedAt),
+		l.ID,
+		l.OwnerID,
+	)
+	if err != nil {
+		r.logger.Error("failed to update todo list",
+			zap.Int64("id", l.ID),
+			zap.String("owner_id", l.OwnerID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("update todo list: %w", err)
+	}
+
+	// 値が変わらないと RowsAffected は 0 になるので、存在確認は読み戻しで行う
+	updated, err := r.getOnce(ctx, exec, l.OwnerID, l.ID, false)
+	if errors.Is(err, domain_todo.ErrListNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load todo list: %w", err)
+	}
+
+	r.logger.Info("todo list updated",
+		zap.Int64("id", updated.ID),
+		zap.String("name", updated.Name),
+		zap.Bool("archived", updated.IsArchived()),
+	)
+	return updated, nil
+}
+
+// DeleteTodoList はリストを物理削除する。
+// ゴミ箱に残っている Todo の list_id は FK の ON DELETE SET NULL で外れる。
+func (r *TodoListRepository) DeleteTodoList(ctx context.Context, ownerID string, id int64) (bool, error) {
+	exec := r.getExecutor(ctx)
+
+	res, err := exec.ExecContext(ctx,
+		`DELETE FROM todo_lists WHERE id = ? AND owner_id = ?`,
+		id,
+		ownerID,
+	)
+	if err != nil {
+		r.logger.Error("failed to delete todo list",
+			zap.Int64("id", id),
+			zap.String("owner_id", ownerID),
+			zap.Error(err),
+		)
+		return false, fmt.Errorf("delete todo list: %w", err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("rows affected (delete todo list): %w", err)
+	}
+
+	r.logger.Info("todo list deleted",
+		zap.Int64("id", id),
+		zap.String("owner_id", ownerID),
+		zap.Bool("deleted", n > 0),
+	)
+	return n > 0, nil
+}
+
+func (r *TodoListRepository) CountTodos(ctx context.Context, ownerID string, listID int64) (int64, error) {
+	var n int64
+	err := r.getExecutor(ctx).QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM todos WHERE owner_id = ? AND list_id = ? AND deleted_at IS NULL`,
+		ownerID,
+		listID,
+	).Scan(&n)
+	if err != nil {
+		return 0, fmt.Errorf("count todos in list: %w", err)
+	}
+	return n, nil
+}
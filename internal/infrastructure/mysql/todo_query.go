@@ -0,0 +1,207 @@
+package mysql
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+)
+
+// todoColumns は todos の SELECT で使う列。scanTodo と順番を揃えること。
+const todoColumns = `id, owner_id, assignee_id, list_id, title, done, due_at, priority, notes, auto_complete, position, recurrence, created_at, updated_at, version, deleted_at`
+
+// rowScanner は *sql.Row と *sql.Rows を同じように扱うための小さなインターフェース
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanTodo は todoColumns の順で 1 行読み込む
+func scanTodo(s rowScanner) (*domain_todo.Todo, error) {
+	var (
+		t          domain_todo.Todo
+		assigneeID sql.NullString
+		listID     sql.NullInt64
+		doneInt    int
+		dueAt      sql.NullTime
+		deletedAt  sql.NullTime
+		recurrence string
+	)
+	if err := s.Scan(&t.ID, &t.OwnerID, &assigneeID, &listID, &t.Title, &doneInt, &dueAt, &t.Priority, &t.Notes, &t.AutoComplete, &t.Position, &recurrence, &t.CreatedAt, &t.UpdatedAt, &t.Version, &deletedAt); err != nil {
+		return nil, err
+	}
+	t.AssigneeID = assigneeID.String
+	t.ListID = listID.Int64
+	t.Done = doneInt == 1
+	if dueAt.Valid {
+		t.DueAt = dueAt.Time
+	}
+	if deletedAt.Valid {
+		t.DeletedAt = deletedAt.Time
+	}
+	r, err := domain_todo.ParseRecurrence(recurrence)
+	if err != nil {
+		return nil, fmt.Errorf("parse recurrence of todo %d: %w", t.ID, err)
+	}
+	t.Recurrence = r
+	return &t, nil
+}
+
+// nullID は 0 の ID（参照なし）を NULL として書き込む
+func nullID(id int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: id, Valid: id != 0}
+}
+
+// nullString は空文字（未設定）を NULL として書き込む
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// nullTime はゼロ値の time.Time を NULL として書き込む
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+// orderColumns は OrderField → 列名の対応（ホワイトリスト）。
+// ORDER BY はプレースホルダにできないので、ここに無いものは SQL に出さない。
+var orderColumns = map[domain_todo.OrderField]string{
+	domain_todo.OrderByID:        "id",
+	domain_todo.OrderByCreatedAt: "created_at",
+	domain_todo.OrderByUpdatedAt: "updated_at",
+	domain_todo.OrderByTitle:     "title",
+	domain_todo.OrderByPosition:  "position",
+}
+
+// buildListQuery は ListQuery を parameterized な SELECT に組み立てる。
+// ユーザー入力は全てプレースホルダ経由で渡し、列名・向きはホワイトリストからだけ選ぶ。
+func buildListQuery(ownerID string, q domain_todo.ListQuery) (string, []any) {
+	f := q.Filter
+
+	var (
+		where = []string{"owner_id = ?"}
+		args  = []any{ownerID}
+	)
+	if f.AssignedToMe {
+		// 所有者を問わず ownerID が担当のもの。ただし見えるもの（自分の Todo・Todo かリストが共有されているもの）に限る
+		where = []string{
+			"assignee_id = ?",
+			`(owner_id = ? OR EXISTS (
+			   SELECT 1 FROM todo_grants g
+			   WHERE g.grantee_id = ? AND g.owner_id = todos.owner_id
+			     AND ((g.resource_type = ? AND g.resource_id = todos.id) OR (g.resource_type = ? AND g.resource_id = todos.list_id))
+			 ))`,
+		}
+		args = []any{ownerID, ownerID, ownerID, domain_todo.ResourceTodo, domain_todo.ResourceList}
+	}
+
+	if f.Deleted {
+		where = append(where, "deleted_at IS NOT NULL")
+	} else {
+		where = append(where, "deleted_at IS NULL")
+	}
+	if f.Done != nil {
+		where = append(where, "done = ?")
+		args = append(args, *f.Done)
+	}
+	if f.ListID != nil {
+		if *f.ListID == 0 {
+			where = append(where, "list_id IS NULL")
+		} else {
+			where = append(where, "list_id = ?")
+			args = append(args, *f.ListID)
+		}
+	}
+	if f.TitleContains != "" {
+		where = append(where, `title LIKE ? ESCAPE '\\'`)
+		args = append(args, "%"+escapeLike(f.TitleContains)+"%")
+	}
+	if !f.CreatedAfter.IsZero() {
+		where = append(where, "created_at >= ?")
+		args = append(args, f.CreatedAfter)
+	}
+	if !f.CreatedBefore.IsZero() {
+		where = append(where, "created_at < ?")
+		args = append(args, f.CreatedBefore)
+	}
+	if !f.UpdatedAfter.IsZero() {
+		where = append(where, "updated_at >= ?")
+		args = append(args, f.UpdatedAfter)
+	}
+	if !f.UpdatedBefore.IsZero() {
+		where = append(where, "updated_at < ?")
+		args = append(args, f.UpdatedBefore)
+	}
+	if f.Overdue {
+		// due_at が NULL の行は比較で落ちる
+		where = append(where, "done = FALSE", "due_at < ?")
+		args = append(args, q.Now)
+	}
+	if f.DueWithinDays > 0 {
+		from, to := f.DueWindow(q.Now)
+		where = append(where, "due_at >= ?", "due_at < ?")
+		args = append(args, from, to)
+	}
+	if n := len(f.LabelIDs); n > 0 {
+		// LabelIDs は重複除去済みなので、「すべて」は一致した件数で判定できる
+		sub := `SELECT todo_id FROM todo_labels WHERE label_id IN (` + placeholders(n) + `)`
+		for _, id := range f.LabelIDs {
+			args = append(args, id)
+		}
+		if f.LabelMatch == domain_todo.LabelMatchAll {
+			sub += ` GROUP BY todo_id HAVING COUNT(*) = ?`
+			args = append(args, n)
+		}
+		where = append(where, "id IN ("+sub+")")
+	}
+
+	col, ok := orderColumns[q.OrderBy.Field]
+	if !ok {
+		col = "id"
+	}
+	dir, cmp := "ASC", ">"
+	if q.OrderBy.Desc {
+		dir, cmp = "DESC", "<"
+	}
+
+	// keyset: (col, id) が直前ページ最後の行より「後ろ」のものだけ
+	if c := q.After; c != nil {
+		if col == "id" {
+			where = append(where, "id "+cmp+" ?")
+			args = append(args, c.ID)
+		} else {
+			var key any = c.Time
+			switch q.OrderBy.Field {
+			case domain_todo.OrderByTitle:
+				key = c.Title
+			case domain_todo.OrderByPosition:
+				key = c.Position
+			}
+			where = append(where, "("+col+" "+cmp+" ? OR ("+col+" = ? AND id "+cmp+" ?))")
+			args = append(args, key, key, c.ID)
+		}
+	}
+
+	query := `SELECT ` + todoColumns + ` FROM todos WHERE ` + strings.Join(where, " AND ")
+	if col == "id" {
+		query += ` ORDER BY id ` + dir
+	} else {
+		query += ` ORDER BY ` + col + ` ` + dir + `, id ` + dir
+	}
+	if q.Limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, q.Limit)
+	}
+
+	return query, args
+}
+
+// placeholders は IN 句用の "?, ?, ?" を n 個分作る
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+// escapeLike は LIKE のワイルドカード（% _）とエスケープ文字自体をエスケープする
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
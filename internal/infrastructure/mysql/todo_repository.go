@@ -3,7 +3,9 @@ package mysql
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"time"
 
 	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
 	"go.uber.org/zap"
@@ -32,23 +34,38 @@ func NewTodoRepository(db *sql.DB, logger *zap.Logger) *TodoRepository {
 }
 
 // ctx に Tx がぶら下がっていればそれを使い、なければ通常の *sql.DB を使う。
-func (r *TodoRepository) getExecutor(ctx context.Context) executor {
+// 同じ Tx に乗れるよう、Repository ごとにこれを経由すること。
+func getExecutor(ctx context.Context, db *sql.DB) executor {
 	if tx, ok := TxFromContext(ctx); ok {
 		return tx
 	}
-	return r.db
+	return db
+}
+
+func (r *TodoRepository) getExecutor(ctx context.Context) executor {
+	return getExecutor(ctx, r.db)
 }
 
 func (r *TodoRepository) Create(ctx context.Context, t *domain_todo.Todo) (*domain_todo.Todo, error) {
 	exec := r.getExecutor(ctx)
 
 	res, err := exec.ExecContext(ctx,
-		`INSERT INTO todos (title, done) VALUES (?, ?)`,
+		`INSERT INTO todos (owner_id, assignee_id, list_id, title, done, due_at, priority, notes, auto_complete, position, recurrence) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		t.OwnerID,
+		nullString(t.AssigneeID),
+		nullID(t.ListID),
 		t.Title,
 		t.Done,
+		nullTime(t.DueAt),
+		t.Priority,
+		t.Notes,
+		t.AutoComplete,
+		t.Position,
+		t.Recurrence.String(),
 	)
 	if err != nil {
 		r.logger.Error("failed to insert todo",
+			zap.String("owner_id", t.OwnerID),
 			zap.String("title", t.Title),
 			zap.Bool("done", t.Done),
 			zap.Error(err),
@@ -64,8 +81,18 @@ func (r *TodoRepository) Create(ctx context.Context, t *domain_todo.Todo) (*doma
 
 	t.ID = id
 
+	// created_at / updated_at / version は DB 側のデフォルトで埋まるので読み戻す
+	if err := r.loadDBColumns(ctx, exec, t); err != nil {
+		r.logger.Error("failed to load db columns (create)",
+			zap.Int64("id", t.ID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("load todo db columns: %w", err)
+	}
+
 	r.logger.Info("todo created",
 		zap.Int64("id", t.ID),
+		zap.String("owner_id", t.OwnerID),
 		zap.String("title", t.Title),
 		zap.Bool("done", t.Done),
 	)
@@ -73,17 +100,109 @@ func (r *TodoRepository) Create(ctx context.Context, t *domain_todo.Todo) (*doma
 	return t, nil
 }
 
-func (r *TodoRepository) List(ctx context.Context) ([]*domain_todo.Todo, error) {
+// CreateMany は todos を入力の順に 1 行ずつ INSERT し、それぞれの LastInsertId を ID にする。
+// 複数行の VALUES にまとめると、先頭以外の ID を他の行と取り違えずに知る方法がない（連番とは限らない）ので、まとめない。
+// 途中で失敗したときに作成済みの行を残さないよう、Tx の中で呼ぶこと。
+func (r *TodoRepository) CreateMany(ctx context.Context, todos []*domain_todo.Todo) ([]*domain_todo.Todo, error) {
+	if len(todos) == 0 {
+		return todos, nil
+	}
+	exec := r.getExecutor(ctx)
+	ownerID := todos[0].OwnerID
+
+	ids := make([]any, 0, len(todos))
+	byID := make(map[int64]*domain_todo.Todo, len(todos))
+	for _, t := range todos {
+		if t.OwnerID != ownerID {
+			return nil, fmt.Errorf("create todos: mixed owners %q and %q", ownerID, t.OwnerID)
+		}
+		res, err := exec.ExecContext(ctx,
+			`INSERT INTO todos (owner_id, assignee_id, list_id, title, done, due_at, priority, notes, auto_complete, position, recurrence) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			t.OwnerID,
+			nullString(t.AssigneeID),
+			nullID(t.ListID),
+			t.Title,
+			t.Done,
+			nullTime(t.DueAt),
+			t.Priority,
+			t.Notes,
+			t.AutoComplete,
+			t.Position,
+			t.Recurrence.String(),
+		)
+		if err != nil {
+			r.logger.Error("failed to insert todos",
+				zap.String("owner_id", ownerID),
+				zap.Int("count", len(todos)),
+				zap.Int("inserted", len(ids)),
+				zap.Error(err),
+			)
+			return nil, fmt.Errorf("insert todos: %w", err)
+		}
+		id, err := res.LastInsertId()
+		if err != nil {
+			r.logger.Error("failed to get last insert id", zap.Error(err))
+			return nil, fmt.Errorf("get last insert id: %w", err)
+		}
+		t.ID = id
+		ids = append(ids, id)
+		byID[id] = t
+	}
+
+	// created_at / updated_at / version は DB 側のデフォルトで埋まるので、ID を指定してまとめて読み戻す
+	rows, err := exec.QueryContext(ctx,
+		`SELECT id, created_at, updated_at, version FROM todos WHERE id IN (`+placeholders(len(ids))+`)`,
+		ids...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("load todo db columns: %w", err)
+	}
+	defer rows.Close()
+
+	n := 0
+	for rows.Next() {
+		var (
+			id                   int64
+			createdAt, updatedAt time.Time
+			version              int64
+		)
+		if err := rows.Scan(&id, &createdAt, &updatedAt, &version); err != nil {
+			return nil, fmt.Errorf("scan todo db columns: %w", err)
+		}
+		t, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("load todo db columns: unexpected id %d", id)
+		}
+		t.CreatedAt, t.UpdatedAt, t.Version = createdAt, updatedAt, version
+		n++
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("load todo db columns: %w", err)
+	}
+	if n != len(todos) {
+		return nil, fmt.Errorf("load todo db columns: got %d rows, want %d", n, len(todos))
+	}
+
+	r.logger.Info("todos created",
+		zap.String("owner_id", ownerID),
+		zap.Int64("first_id", todos[0].ID),
+		zap.Int("count", len(todos)),
+	)
+
+	return todos, nil
+}
+
+func (r *TodoRepository) List(ctx context.Context, ownerID string, q domain_todo.ListQuery) ([]*domain_todo.Todo, error) {
 	exec := r.getExecutor(ctx)
 
 	// Tx の中では「Tx を貼り直してリトライ」ができないので、read-retry は使わない（安全側）
 	if _, inTx := TxFromContext(ctx); inTx {
-		return r.listOnce(ctx, exec)
+		return r.listOnce(ctx, exec, ownerID, q)
 	}
 
 	var todos []*domain_todo.Todo
 	err := doWithRetry(ctx, DefaultReadRetry, r.logger, func() error {
-		list, err := r.listOnce(ctx, exec)
+		list, err := r.listOnce(ctx, exec, ownerID, q)
 		if err != nil {
 			return err
 		}
@@ -91,22 +210,30 @@ func (r *TodoRepository) List(ctx context.Context) ([]*domain_todo.Todo, error)
 		return nil
 	})
 	if err != nil {
-		r.logger.Error("failed to list todos", zap.Error(err))
+		r.logger.Error("failed to list todos",
+			zap.String("owner_id", ownerID),
+			zap.Error(err),
+		)
 		return nil, fmt.Errorf("query todos: %w", err)
 	}
 
 	r.logger.Info("todos listed",
+		zap.String("owner_id", ownerID),
+		zap.String("order_by", q.OrderBy.String()),
+		zap.Bool("has_cursor", q.After != nil),
+		zap.Int("limit", q.Limit),
 		zap.Int("count", len(todos)),
 	)
 
 	return todos, nil
 }
 
-// listOnce は 1 回だけ SELECT して全件読み切る（リトライの最小単位）
-func (r *TodoRepository) listOnce(ctx context.Context, exec executor) ([]*domain_todo.Todo, error) {
-	rows, err := exec.QueryContext(ctx,
-		`SELECT id, title, done FROM todos ORDER BY id`,
-	)
+// listOnce は 1 回だけ SELECT して 1 ページ分読み切る（リトライの最小単位）
+// OFFSET を使わない keyset ページングなので、深いページでも遅くならない。
+func (r *TodoRepository) listOnce(ctx context.Context, exec executor, ownerID string, q domain_todo.ListQuery) ([]*domain_todo.Todo, error) {
+	query, args := buildListQuery(ownerID, q)
+
+	rows, err := exec.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -114,36 +241,124 @@ func (r *TodoRepository) listOnce(ctx context.Context, exec executor) ([]*domain
 
 	var todos []*domain_todo.Todo
 	for rows.Next() {
-		var (
-			t       domain_todo.Todo
-			doneInt int
-		)
-		if err := rows.Scan(&t.ID, &t.Title, &doneInt); err != nil {
+		t, err := scanTodo(rows)
+		if err != nil {
 			return nil, err
 		}
-		t.Done = doneInt == 1
-		todos = append(todos, &t)
+		todos = append(todos, t)
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
 
+	if err := r.loadLabels(ctx, exec, todos...); err != nil {
+		return nil, err
+	}
+	if err := r.loadItems(ctx, exec, todos...); err != nil {
+		return nil, err
+	}
+
 	return todos, nil
 }
 
+func (r *TodoRepository) Get(ctx context.Context, ownerID string, id int64) (*domain_todo.Todo, error) {
+	exec := r.getExecutor(ctx)
+
+	// List と同じく、Tx の中では read-retry は使わない（安全側）
+	if _, inTx := TxFromContext(ctx); inTx {
+		return r.getOnce(ctx, exec, ownerID, id, false)
+	}
+
+	var todo *domain_todo.Todo
+	err := doWithRetry(ctx, DefaultReadRetry, r.logger, func() error {
+		t, err := r.getOnce(ctx, exec, ownerID, id, false)
+		if err != nil {
+			return err
+		}
+		todo = t
+		return nil
+	})
+	if errors.Is(err, domain_todo.ErrNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		r.logger.Error("failed to get todo",
+			zap.Int64("id", id),
+			zap.String("owner_id", ownerID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("query todo: %w", err)
+	}
+
+	return todo, nil
+}
+
+// GetForUpdate は SELECT ... FOR UPDATE で 1 件読む。
+// Tx の外で呼んでもロックはすぐ外れるだけなので、WithinTx の中で使うこと。
+func (r *TodoRepository) GetForUpdate(ctx context.Context, ownerID string, id int64) (*domain_todo.Todo, error) {
+	t, err := r.getOnce(ctx, r.getExecutor(ctx), ownerID, id, true)
+	if err != nil && !errors.Is(err, domain_todo.ErrNotFound) {
+		r.logger.Error("failed to get todo for update",
+			zap.Int64("id", id),
+			zap.String("owner_id", ownerID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("query todo for update: %w", err)
+	}
+	return t, err
+}
+
+// getOnce は 1 回だけ SELECT して 1 件読む（リトライの最小単位）
+// 行が無ければ domain_todo.ErrNotFound を返す（retryable ではないので即返る）
+func (r *TodoRepository) getOnce(ctx context.Context, exec executor, ownerID string, id int64, forUpdate bool) (*domain_todo.Todo, error) {
+	query := `SELECT ` + todoColumns + ` FROM todos WHERE id = ? AND owner_id = ? AND deleted_at IS NULL`
+	if forUpdate {
+		query += ` FOR UPDATE`
+	}
+
+	t, err := scanTodo(exec.QueryRowContext(ctx, query, id, ownerID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain_todo.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadLabels(ctx, exec, t); err != nil {
+		return nil, err
+	}
+	if err := r.loadItems(ctx, exec, t); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
 func (r *TodoRepository) Update(ctx context.Context, t *domain_todo.Todo) (*domain_todo.Todo, error) {
 	exec := r.getExecutor(ctx)
 
+	// version を条件に入れて楽観ロックする（同時に 1 増やす）
 	res, err := exec.ExecContext(ctx,
-		`UPDATE todos SET title = ?, done = ? WHERE id = ?`,
+		`UPDATE todos SET assignee_id = ?, list_id = ?, title = ?, done = ?, due_at = ?, priority = ?, notes = ?, auto_complete = ?, position = ?, recurrence = ?, version = version + 1 WHERE id = ? AND owner_id = ? AND version = ? AND deleted_at IS NULL`,
+		nullString(t.AssigneeID),
+		nullID(t.ListID),
 		t.Title,
 		t.Done,
+		nullTime(t.DueAt),
+		t.Priority,
+		t.Notes,
+		t.AutoComplete,
+		t.Position,
+		t.Recurrence.String(),
 		t.ID,
+		t.OwnerID,
+		t.Version,
 	)
 	if err != nil {
 		r.logger.Error("failed to update todo",
 			zap.Int64("id", t.ID),
+			zap.String("owner_id", t.OwnerID),
 			zap.String("title", t.Title),
 			zap.Bool("done", t.Done),
 			zap.Error(err),
@@ -151,49 +366,170 @@ func (r *TodoRepository) Update(ctx context.Context, t *domain_todo.Todo) (*doma
 		return nil, fmt.Errorf("update todo: %w", err)
 	}
 
-	if n, err := res.RowsAffected(); err == nil {
-		if n == 0 {
-			r.logger.Warn("no todo updated", zap.Int64("id", t.ID))
-		}
-	} else {
+	n, err := res.RowsAffected()
+	if err != nil {
 		r.logger.Warn("failed to get rows affected (update)", zap.Error(err))
+		return nil, fmt.Errorf("rows affected (update): %w", err)
+	}
+
+	// updated_at / version は DB 側で更新されるので読み戻す。
+	// version は必ず変わるので RowsAffected=0 は「存在しない（他人の Todo を含む）」か「version 不一致」。
+	if err := r.loadDBColumns(ctx, exec, t); err != nil {
+		if errors.Is(err, domain_todo.ErrNotFound) {
+			r.logger.Info("no todo updated",
+				zap.Int64("id", t.ID),
+				zap.String("owner_id", t.OwnerID),
+			)
+			return nil, err
+		}
+		r.logger.Error("failed to load db columns (update)",
+			zap.Int64("id", t.ID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("load todo db columns: %w", err)
+	}
+	if n == 0 {
+		r.logger.Info("todo version mismatch",
+			zap.Int64("id", t.ID),
+			zap.String("owner_id", t.OwnerID),
+			zap.Int64("current_version", t.Version),
+		)
+		return nil, domain_todo.ErrVersionMismatch
 	}
 
 	r.logger.Info("todo updated",
 		zap.Int64("id", t.ID),
 		zap.String("title", t.Title),
 		zap.Bool("done", t.Done),
+		zap.Int64("version", t.Version),
 	)
 
 	return t, nil
 }
 
-func (r *TodoRepository) Delete(ctx context.Context, id int64) (bool, error) {
-	exec := r.getExecutor(ctx)
+// Delete は論理削除（ゴミ箱へ移動）。version も 1 増やす。
+func (r *TodoRepository) Delete(ctx context.Context, ownerID string, id int64) (bool, error) {
+	return r.execOwnedRow(ctx, "delete", ownerID, id,
+		`UPDATE todos SET deleted_at = CURRENT_TIMESTAMP, version = version + 1 WHERE id = ? AND owner_id = ? AND deleted_at IS NULL`,
+	)
+}
 
-	res, err := exec.ExecContext(ctx,
-		`DELETE FROM todos WHERE id = ?`,
-		id,
+// Restore はゴミ箱の Todo を元に戻す。version も 1 増やす。
+func (r *TodoRepository) Restore(ctx context.Context, ownerID string, id int64) (bool, error) {
+	return r.execOwnedRow(ctx, "restore", ownerID, id,
+		`UPDATE todos SET deleted_at = NULL, version = version + 1 WHERE id = ? AND owner_id = ? AND deleted_at IS NOT NULL`,
 	)
+}
+
+// Purge はゴミ箱の Todo を物理削除する（ゴミ箱に無いものは消さない）。
+func (r *TodoRepository) Purge(ctx context.Context, ownerID string, id int64) (bool, error) {
+	return r.execOwnedRow(ctx, "purge", ownerID, id,
+		`DELETE FROM todos WHERE id = ? AND owner_id = ? AND deleted_at IS NOT NULL`,
+	)
+}
+
+// execOwnedRow は「id + owner_id で 1 行だけ対象にする」書き込みを実行し、対象があったかを返す。
+// query のプレースホルダは id, owner_id の順。
+func (r *TodoRepository) execOwnedRow(ctx context.Context, op string, ownerID string, id int64, query string) (bool, error) {
+	exec := r.getExecutor(ctx)
+
+	res, err := exec.ExecContext(ctx, query, id, ownerID)
 	if err != nil {
-		r.logger.Error("failed to delete todo",
+		r.logger.Error("failed to "+op+" todo",
 			zap.Int64("id", id),
+			zap.String("owner_id", ownerID),
 			zap.Error(err),
 		)
-		return false, fmt.Errorf("delete todo: %w", err)
+		return false, fmt.Errorf("%s todo: %w", op, err)
 	}
 
 	n, err := res.RowsAffected()
 	if err != nil {
-		r.logger.Warn("failed to get rows affected (delete)", zap.Error(err))
-		return false, fmt.Errorf("rows affected (delete): %w", err)
+		r.logger.Warn("failed to get rows affected ("+op+")", zap.Error(err))
+		return false, fmt.Errorf("rows affected (%s): %w", op, err)
 	}
 
 	if n == 0 {
-		r.logger.Info("no todo deleted", zap.Int64("id", id))
+		r.logger.Info("no todo affected ("+op+")",
+			zap.Int64("id", id),
+			zap.String("owner_id", ownerID),
+		)
 		return false, nil
 	}
 
-	r.logger.Info("todo deleted", zap.Int64("id", id))
+	r.logger.Info("todo "+op+"d",
+		zap.Int64("id", id),
+		zap.String("owner_id", ownerID),
+	)
 	return true, nil
 }
+
+// PurgeDeletedBefore は消す Todo を FOR UPDATE で選んでから ID で消す（呼び出し側が共有・履歴を扱えるよう ID と所有者を返すため）。
+func (r *TodoRepository) PurgeDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*domain_todo.Todo, error) {
+	exec := r.getExecutor(ctx)
+
+	todos, err := r.selectPurgeTargets(ctx, exec, before, limit)
+	if err != nil {
+		r.logger.Error("failed to select deleted todos",
+			zap.Time("before", before),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("select deleted todos: %w", err)
+	}
+	if len(todos) == 0 {
+		return nil, nil
+	}
+
+	args := make([]any, len(todos))
+	for i, t := range todos {
+		args[i] = t.ID
+	}
+	if _, err := exec.ExecContext(ctx,
+		`DELETE FROM todos WHERE id IN (`+placeholders(len(todos))+`)`,
+		args...,
+	); err != nil {
+		r.logger.Error("failed to purge deleted todos",
+			zap.Time("before", before),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("purge deleted todos: %w", err)
+	}
+	return todos, nil
+}
+
+func (r *TodoRepository) selectPurgeTargets(ctx context.Context, exec executor, before time.Time, limit int) ([]*domain_todo.Todo, error) {
+	rows, err := exec.QueryContext(ctx,
+		`SELECT id, owner_id FROM todos WHERE deleted_at IS NOT NULL AND deleted_at < ? ORDER BY deleted_at LIMIT ? FOR UPDATE`,
+		before,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var todos []*domain_todo.Todo
+	for rows.Next() {
+		t := &domain_todo.Todo{}
+		if err := rows.Scan(&t.ID, &t.OwnerID); err != nil {
+			return nil, err
+		}
+		todos = append(todos, t)
+	}
+	return todos, rows.Err()
+}
+
+// loadDBColumns は t.ID / t.OwnerID の行から DB 側で決まる列
+// （created_at / updated_at / version）を読み戻して t に詰める。
+// 行が無ければ domain_todo.ErrNotFound を返す。
+func (r *TodoRepository) loadDBColumns(ctx context.Context, exec executor, t *domain_todo.Todo) error {
+	err := exec.QueryRowContext(ctx,
+		`SELECT created_at, updated_at, version FROM todos WHERE id = ? AND owner_id = ? AND deleted_at IS NULL`,
+		t.ID,
+		t.OwnerID,
+	).Scan(&t.CreatedAt, &t.UpdatedAt, &t.Version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain_todo.ErrNotFound
+	}
+	return err
+}
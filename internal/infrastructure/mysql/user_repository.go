@@ -0,0 +1,76 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// UserRepository はユーザーのディレクトリの MySQL 実装。
+// 認証はまだダミー（AuthHandler.Login）なので、ログインしたことのある JWT の sub を users に記録し、それを「いるユーザー」とする。
+type UserRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewUserRepository(db *sql.DB, logger *zap.Logger) *UserRepository {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &UserRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *UserRepository) getExecutor(ctx context.Context) executor {
+	return getExecutor(ctx, r.db)
+}
+
+// RecordLogin は userID を users に追加する（既にいれば最終ログイン時刻だけ更新する）。
+func (r *UserRepository) RecordLogin(ctx context.Context, userID string) error {
+	exec := r.getExecutor(ctx)
+
+	now := time.Now()
+	_, err := exec.ExecContext(ctx,
+		`INSERT INTO users (user_id, created_at, last_login_at) VALUES (?, ?, ?)
+		 ON DUPLICATE KEY UPDATE last_login_at = VALUES(last_login_at)`,
+		userID,
+		now,
+		now,
+	)
+	if err != nil {
+		r.logger.Error("failed to record login",
+			zap.String("user_id", userID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("record login: %w", err)
+	}
+	return nil
+}
+
+func (r *UserRepository) UserExists(ctx context.Context, userID string) (bool, error) {
+	exec := r.getExecutor(ctx)
+
+	var exists bool
+	existsOnce := func() error {
+		return exec.QueryRowContext(ctx,
+			`SELECT EXISTS (SELECT 1 FROM users WHERE user_id = ?)`,
+			userID,
+		).Scan(&exists)
+	}
+	// AssignTodo からは Tx の中で呼ばれるので、そのときは read-retry しない
+	var err error
+	if _, inTx := TxFromContext(ctx); inTx {
+		err = existsOnce()
+	} else {
+		err = doWithRetry(ctx, DefaultReadRetry, r.logger, existsOnce)
+	}
+	if err != nil {
+		return false, fmt.Errorf("query user: %w", err)
+	}
+	return exists, nil
+}
@@ -0,0 +1,21 @@
+package grpcadapter
+
+import (
+	"context"
+
+	todov1 "github.com/hijjiri/grpc-echo/api/todo/v1"
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+)
+
+// --- Assignee ---
+func (h *TodoHandler) AssignTodo(ctx context.Context, req *todov1.AssignTodoRequest) (*todov1.Todo, error) {
+	return h.changeTodo(ctx, req.GetVersion(), func(ctx context.Context, ownerID string, version int64) (*domain_todo.Todo, error) {
+		return h.uc.AssignTodo(ctx, ownerID, req.GetId(), req.GetAssigneeId(), version)
+	})
+}
+
+func (h *TodoHandler) UnassignTodo(ctx context.Context, req *todov1.UnassignTodoRequest) (*todov1.Todo, error) {
+	return h.changeTodo(ctx, req.GetVersion(), func(ctx context.Context, ownerID string, version int64) (*domain_todo.Todo, error) {
+		return h.uc.UnassignTodo(ctx, ownerID, req.GetId(), version)
+	})
+}
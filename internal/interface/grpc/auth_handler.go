@@ -18,17 +18,25 @@ type AuthHandler struct {
 	authv1.UnimplementedAuthServiceServer
 
 	authSecret string
+	users      LoginRecorder
 	logger     *zap.Logger
 }
 
+// LoginRecorder はログインしたユーザーを記録する先（担当者の存在確認に使うユーザーのディレクトリ）。
+type LoginRecorder interface {
+	RecordLogin(ctx context.Context, userID string) error
+}
+
 // ハンドラ層で「処理の上限」を決める（本番目線の保険）
 // ※ 後で設定化するならここを config から注入に置き換える
 const defaultAuthRPCTimeout = 3 * time.Second
 
 // NewAuthHandler は AuthHandler を初期化するコンストラクタです。
-func NewAuthHandler(logger *zap.Logger, authSecret string) *AuthHandler {
+// users が nil ならログインしたユーザーを記録しない。
+func NewAuthHandler(logger *zap.Logger, authSecret string, users LoginRecorder) *AuthHandler {
 	return &AuthHandler{
 		authSecret: authSecret,
+		users:      users,
 		logger:     logger,
 	}
 }
@@ -77,6 +85,16 @@ func (h *AuthHandler) Login(ctx context.Context, req *authv1.LoginRequest) (*aut
 		return nil, status.Error(codes.Internal, "failed to generate token")
 	}
 
+	// ユーザーのディレクトリに記録する（失敗してもログインは通す。担当者に指定できないだけ）
+	if h.users != nil {
+		if err := h.users.RecordLogin(ctx, subject); err != nil {
+			h.logger.Warn("failed to record login",
+				zap.String("username", username),
+				zap.Error(err),
+			)
+		}
+	}
+
 	// ここまで来る前に ctx が切れていたら、正常レスポンスは返さない（本番寄り）
 	select {
 	case <-ctx.Done():
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 
+	todov1 "github.com/hijjiri/grpc-echo/api/todo/v1"
 	"github.com/hijjiri/grpc-echo/internal/auth"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -20,6 +21,11 @@ func isAuthSkippedMethod(fullMethod string) bool {
 		return true
 	}
 
+	// カレンダーのフィードは JWT の代わりにリクエストの feed_token で認証する（ハンドラで確認する）
+	if fullMethod == todov1.TodoService_GetCalendarFeed_FullMethodName {
+		return true
+	}
+
 	// ヘルスチェックも認証スキップしておくと便利
 	if fullMethod == "/grpc.health.v1.Health/Check" {
 		return true
@@ -0,0 +1,163 @@
+package grpcadapter
+
+import (
+	"context"
+	"errors"
+
+	todov1 "github.com/hijjiri/grpc-echo/api/todo/v1"
+	todo_usecase "github.com/hijjiri/grpc-echo/internal/usecase/todo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// --- Batch ---
+func (h *TodoHandler) BatchCreateTodos(ctx context.Context, req *todov1.BatchCreateTodosRequest) (*todov1.BatchCreateTodosResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoBatchTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := runBatch(req.GetRequests(), req.GetMode(), toCreateParams,
+		func(items []todo_usecase.CreateParams, mode todo_usecase.BatchMode) ([]todo_usecase.BatchResult, error) {
+			return h.uc.BatchCreate(ctx, ownerID, items, mode)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &todov1.BatchCreateTodosResponse{Results: results}, nil
+}
+
+func (h *TodoHandler) BatchUpdateTodos(ctx context.Context, req *todov1.BatchUpdateTodosRequest) (*todov1.BatchUpdateTodosResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoBatchTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	toItem := func(r *todov1.UpdateTodoRequest) (todo_usecase.BatchUpdateItem, error) {
+		params, err := toUpdateParams(r)
+		if err != nil {
+			return todo_usecase.BatchUpdateItem{}, err
+		}
+		// If-Match は 1 件分の version なので、一括では項目ごとの version だけを見る
+		params.ExpectedVersion = r.GetVersion()
+		return todo_usecase.BatchUpdateItem{ID: r.GetId(), Params: params}, nil
+	}
+	results, err := runBatch(req.GetRequests(), req.GetMode(), toItem,
+		func(items []todo_usecase.BatchUpdateItem, mode todo_usecase.BatchMode) ([]todo_usecase.BatchResult, error) {
+			return h.uc.BatchUpdate(ctx, ownerID, items, mode)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &todov1.BatchUpdateTodosResponse{Results: results}, nil
+}
+
+func (h *TodoHandler) BatchDeleteTodos(ctx context.Context, req *todov1.BatchDeleteTodosRequest) (*todov1.BatchDeleteTodosResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoBatchTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	toItem := func(r *todov1.DeleteTodoRequest) (todo_usecase.BatchDeleteItem, error) {
+		return todo_usecase.BatchDeleteItem{ID: r.GetId(), ExpectedVersion: r.GetVersion()}, nil
+	}
+	results, err := runBatch(req.GetRequests(), req.GetMode(), toItem,
+		func(items []todo_usecase.BatchDeleteItem, mode todo_usecase.BatchMode) ([]todo_usecase.BatchResult, error) {
+			return h.uc.BatchDelete(ctx, ownerID, items, mode)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &todov1.BatchDeleteTodosResponse{Results: results}, nil
+}
+
+// runBatch は各項目を usecase の入力に変換し、変換できた項目だけで run を呼んで、結果を入力の順に並べ直す。
+// 変換できなかった項目は、BEST_EFFORT ならその項目の結果に、ALL_OR_NOTHING なら全体のエラーにする。
+// convert は gRPC のステータスエラーを返すこと。
+func runBatch[Req, Item any](
+	reqs []Req,
+	mode todov1.BatchMode,
+	convert func(Req) (Item, error),
+	run func(items []Item, mode todo_usecase.BatchMode) ([]todo_usecase.BatchResult, error),
+) ([]*todov1.BatchTodoResult, error) {
+	ucMode, err := toBatchMode(mode)
+	if err != nil {
+		return nil, err
+	}
+	// 上限を超えるものは変換する前に弾く
+	if len(reqs) > todo_usecase.MaxBatchSize {
+		return nil, toGRPCError(todo_usecase.ErrBatchTooLarge)
+	}
+
+	results := make([]*todov1.BatchTodoResult, len(reqs))
+	items := make([]Item, 0, len(reqs))
+	indexes := make([]int, 0, len(reqs)) // items[k] は reqs[indexes[k]]
+	for i, r := range reqs {
+		item, err := convert(r)
+		if err != nil {
+			if ucMode == todo_usecase.BatchAllOrNothing {
+				return nil, batchItemError(i, err)
+			}
+			st := status.Convert(err)
+			results[i] = &todov1.BatchTodoResult{Code: int32(st.Code()), Message: st.Message()}
+			continue
+		}
+		items = append(items, item)
+		indexes = append(indexes, i)
+	}
+	if len(reqs) > 0 && len(items) == 0 {
+		// 全項目が変換の時点で失敗した（BEST_EFFORT）
+		return results, nil
+	}
+
+	res, err := run(items, ucMode)
+	if err != nil {
+		var itemErr *todo_usecase.BatchItemError
+		if errors.As(err, &itemErr) {
+			return nil, batchItemError(indexes[itemErr.Index], toGRPCError(err))
+		}
+		return nil, toGRPCError(err)
+	}
+	for k, r := range res {
+		results[indexes[k]] = toProtoBatchResult(r)
+	}
+	return results, nil
+}
+
+func toBatchMode(m todov1.BatchMode) (todo_usecase.BatchMode, error) {
+	switch m {
+	case todov1.BatchMode_BATCH_MODE_ALL_OR_NOTHING:
+		return todo_usecase.BatchAllOrNothing, nil
+	case todov1.BatchMode_BATCH_MODE_BEST_EFFORT:
+		return todo_usecase.BatchBestEffort, nil
+	default:
+		return 0, status.Error(codes.InvalidArgument, "invalid mode")
+	}
+}
+
+// batchItemError は ALL_OR_NOTHING で失敗した項目のステータスに、その添字を付ける（コードはそのまま）。
+func batchItemError(index int, err error) error {
+	st := status.Convert(err)
+	return status.Errorf(st.Code(), "requests[%d]: %s", index, st.Message())
+}
+
+func toProtoBatchResult(r todo_usecase.BatchResult) *todov1.BatchTodoResult {
+	if r.Err != nil {
+		st := status.Convert(toGRPCError(r.Err))
+		return &todov1.BatchTodoResult{Code: int32(st.Code()), Message: st.Message()}
+	}
+	pr := &todov1.BatchTodoResult{Code: int32(codes.OK)}
+	if r.Todo != nil {
+		pr.Todo = toProtoTodo(r.Todo)
+	}
+	return pr
+}
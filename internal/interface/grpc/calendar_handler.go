@@ -0,0 +1,52 @@
+package grpcadapter
+
+import (
+	"context"
+	"io"
+
+	todov1 "github.com/hijjiri/grpc-echo/api/todo/v1"
+)
+
+// --- Calendar feed ---
+
+func (h *TodoHandler) IssueCalendarFeedToken(ctx context.Context, req *todov1.IssueCalendarFeedTokenRequest) (*todov1.CalendarFeedToken, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoWriteTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := h.uc.IssueFeedToken(ctx, ownerID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &todov1.CalendarFeedToken{Token: token}, nil
+}
+
+func (h *TodoHandler) RevokeCalendarFeedToken(ctx context.Context, req *todov1.RevokeCalendarFeedTokenRequest) (*todov1.RevokeCalendarFeedTokenResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoWriteTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.uc.RevokeFeedToken(ctx, ownerID); err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &todov1.RevokeCalendarFeedTokenResponse{Ok: true}, nil
+}
+
+// GetCalendarFeed は JWT ではなく feed_token で所有者を決める（認証の interceptor は通らない）。
+func (h *TodoHandler) GetCalendarFeed(req *todov1.GetCalendarFeedRequest, stream todov1.TodoService_GetCalendarFeedServer) error {
+	ctx, cancel := context.WithTimeout(stream.Context(), defaultTodoTransferTimeout)
+	defer cancel()
+
+	return sendFile(stream, func(w io.Writer) error {
+		_, err := h.uc.CalendarFeed(ctx, req.GetFeedToken(), w)
+		return err
+	})
+}
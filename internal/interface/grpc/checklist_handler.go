@@ -0,0 +1,78 @@
+package grpcadapter
+
+import (
+	"context"
+
+	todov1 "github.com/hijjiri/grpc-echo/api/todo/v1"
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+)
+
+// --- Checklist ---
+func (h *TodoHandler) AddChecklistItem(ctx context.Context, req *todov1.AddChecklistItemRequest) (*todov1.Todo, error) {
+	return h.changeTodo(ctx, req.GetVersion(), func(ctx context.Context, ownerID string, version int64) (*domain_todo.Todo, error) {
+		return h.uc.AddChecklistItem(ctx, ownerID, req.GetTodoId(), req.GetTitle(), version)
+	})
+}
+
+func (h *TodoHandler) ToggleChecklistItem(ctx context.Context, req *todov1.ToggleChecklistItemRequest) (*todov1.Todo, error) {
+	return h.changeTodo(ctx, req.GetVersion(), func(ctx context.Context, ownerID string, version int64) (*domain_todo.Todo, error) {
+		return h.uc.ToggleChecklistItem(ctx, ownerID, req.GetTodoId(), req.GetId(), req.GetDone(), version)
+	})
+}
+
+func (h *TodoHandler) RemoveChecklistItem(ctx context.Context, req *todov1.RemoveChecklistItemRequest) (*todov1.Todo, error) {
+	return h.changeTodo(ctx, req.GetVersion(), func(ctx context.Context, ownerID string, version int64) (*domain_todo.Todo, error) {
+		return h.uc.RemoveChecklistItem(ctx, ownerID, req.GetTodoId(), req.GetId(), version)
+	})
+}
+
+func (h *TodoHandler) ReorderChecklistItems(ctx context.Context, req *todov1.ReorderChecklistItemsRequest) (*todov1.Todo, error) {
+	return h.changeTodo(ctx, req.GetVersion(), func(ctx context.Context, ownerID string, version int64) (*domain_todo.Todo, error) {
+		return h.uc.ReorderChecklistItems(ctx, ownerID, req.GetTodoId(), req.GetItemIds(), version)
+	})
+}
+
+// changeTodo は Todo 1 件を変えて Todo 全体を返す RPC（チェックリスト・担当者）共通の処理（認証・If-Match・ETag）
+func (h *TodoHandler) changeTodo(
+	ctx context.Context,
+	reqVersion int64,
+	change func(ctx context.Context, ownerID string, version int64) (*domain_todo.Todo, error),
+) (*todov1.Todo, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoWriteTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := expectedVersion(ctx, reqVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := change(ctx, ownerID, version)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	setETagHeader(ctx, t.Version)
+	return toProtoTodo(t), nil
+}
+
+func toProtoChecklistItems(items []domain_todo.ChecklistItem) []*todov1.ChecklistItem {
+	if len(items) == 0 {
+		return nil
+	}
+	out := make([]*todov1.ChecklistItem, 0, len(items))
+	for _, it := range items {
+		out = append(out, &todov1.ChecklistItem{
+			Id:        it.ID,
+			Title:     it.Title,
+			Done:      it.Done,
+			Position:  int32(it.Position),
+			CreatedAt: toTimestamp(it.CreatedAt),
+			UpdatedAt: toTimestamp(it.UpdatedAt),
+		})
+	}
+	return out
+}
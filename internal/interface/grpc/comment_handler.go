@@ -0,0 +1,93 @@
+package grpcadapter
+
+import (
+	"context"
+
+	todov1 "github.com/hijjiri/grpc-echo/api/todo/v1"
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	todo_usecase "github.com/hijjiri/grpc-echo/internal/usecase/todo"
+)
+
+// --- Comments ---
+func (h *TodoHandler) AddComment(ctx context.Context, req *todov1.AddCommentRequest) (*todov1.Comment, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoWriteTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := h.uc.AddComment(ctx, ownerID, req.GetTodoId(), req.GetBody())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return toProtoComment(c), nil
+}
+
+func (h *TodoHandler) ListComments(ctx context.Context, req *todov1.ListCommentsRequest) (*todov1.ListCommentsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoReadTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.uc.ListComments(ctx, ownerID, req.GetTodoId(), todo_usecase.CommentListParams{
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &todov1.ListCommentsResponse{NextPageToken: res.NextPageToken}
+	for _, c := range res.Comments {
+		resp.Comments = append(resp.Comments, toProtoComment(c))
+	}
+	return resp, nil
+}
+
+func (h *TodoHandler) EditComment(ctx context.Context, req *todov1.EditCommentRequest) (*todov1.Comment, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoWriteTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := h.uc.EditComment(ctx, ownerID, req.GetTodoId(), req.GetId(), req.GetBody())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return toProtoComment(c), nil
+}
+
+func (h *TodoHandler) DeleteComment(ctx context.Context, req *todov1.DeleteCommentRequest) (*todov1.DeleteCommentResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoWriteTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.uc.DeleteComment(ctx, ownerID, req.GetTodoId(), req.GetId()); err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &todov1.DeleteCommentResponse{Ok: true}, nil
+}
+
+func toProtoComment(c *domain_todo.Comment) *todov1.Comment {
+	return &todov1.Comment{
+		Id:        c.ID,
+		TodoId:    c.TodoID,
+		AuthorId:  c.AuthorID,
+		Body:      c.Body,
+		CreatedAt: toTimestamp(c.CreatedAt),
+		UpdatedAt: toTimestamp(c.UpdatedAt),
+		Edited:    c.Edited,
+	}
+}
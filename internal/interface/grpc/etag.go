@@ -0,0 +1,60 @@
+package grpcadapter
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// HTTP の ETag / If-Match を gRPC metadata で受け渡すためのキー。
+// http_gateway 側でヘッダ <-> metadata の対応付けをしている。
+const (
+	mdKeyETag    = "etag"
+	mdKeyIfMatch = "if-match"
+)
+
+// formatETag は version を強い ETag（"<version>"）にする
+func formatETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// setETagHeader はレスポンスヘッダに ETag を載せる（失敗してもレスポンス自体は返す）
+func setETagHeader(ctx context.Context, version int64) {
+	if version <= 0 {
+		return
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(mdKeyETag, formatETag(version)))
+}
+
+// expectedVersion は楽観ロックで比較する version を決める。
+//   - リクエストの version フィールドが 0 以外ならそれを使う
+//   - そうでなければ If-Match（metadata "if-match"）を使う。"*" はチェックなし
+//   - どちらも無ければ 0（チェックなし）
+func expectedVersion(ctx context.Context, fromRequest int64) (int64, error) {
+	if fromRequest != 0 {
+		return fromRequest, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	vals := md.Get(mdKeyIfMatch)
+	if len(vals) == 0 {
+		return 0, nil
+	}
+
+	raw := strings.TrimSpace(vals[0])
+	if raw == "*" {
+		return 0, nil
+	}
+
+	// If-Match は強い比較なので、弱い ETag（W/"..."）や複数指定は受け付けない
+	v, err := strconv.ParseInt(strings.Trim(raw, `"`), 10, 64)
+	if err != nil || v <= 0 || len(vals) > 1 || strings.HasPrefix(raw, "W/") {
+		return 0, status.Error(codes.InvalidArgument, "invalid If-Match header")
+	}
+	return v, nil
+}
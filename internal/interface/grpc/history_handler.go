@@ -0,0 +1,52 @@
+package grpcadapter
+
+import (
+	"context"
+
+	todov1 "github.com/hijjiri/grpc-echo/api/todo/v1"
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	todo_usecase "github.com/hijjiri/grpc-echo/internal/usecase/todo"
+)
+
+// --- History ---
+func (h *TodoHandler) ListTodoHistory(ctx context.Context, req *todov1.ListTodoHistoryRequest) (*todov1.ListTodoHistoryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoReadTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.uc.ListTodoHistory(ctx, ownerID, req.GetTodoId(), todo_usecase.HistoryListParams{
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &todov1.ListTodoHistoryResponse{NextPageToken: res.NextPageToken}
+	for _, e := range res.Entries {
+		resp.Entries = append(resp.Entries, toProtoHistoryEntry(e))
+	}
+	return resp, nil
+}
+
+func toProtoHistoryEntry(e *domain_todo.HistoryEntry) *todov1.TodoHistoryEntry {
+	pe := &todov1.TodoHistoryEntry{
+		Id:        e.ID,
+		TodoId:    e.TodoID,
+		Action:    todov1.HistoryAction(e.Action),
+		Actor:     e.Actor,
+		RequestId: e.RequestID,
+		CreatedAt: toTimestamp(e.CreatedAt),
+	}
+	if e.Before != nil {
+		pe.Before = toProtoTodo(e.Before)
+	}
+	if e.After != nil {
+		pe.After = toProtoTodo(e.After)
+	}
+	return pe
+}
@@ -0,0 +1,183 @@
+package grpcadapter
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"time"
+
+	todov1 "github.com/hijjiri/grpc-echo/api/todo/v1"
+	idempotency_usecase "github.com/hijjiri/grpc-echo/internal/usecase/idempotency"
+	"go.uber.org/zap"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// 冪等キーを gRPC metadata で受け渡すためのキー。
+// http_gateway 側で Idempotency-Key / Idempotent-Replayed ヘッダと対応付けている。
+const (
+	mdKeyIdempotencyKey     = "idempotency-key"
+	mdKeyIdempotentReplayed = "idempotent-replayed"
+)
+
+// finishIdempotencyTimeout は結果の記録にかける上限（リクエストの ctx が切れていても記録する）
+const finishIdempotencyTimeout = 3 * time.Second
+
+// idempotentMethods は冪等キーを受け付ける（状態を変える）メソッド。読み取り系ではキーを無視する。
+var idempotentMethods = map[string]bool{
+	todov1.TodoService_CreateTodo_FullMethodName:             true,
+	todov1.TodoService_UpdateTodo_FullMethodName:             true,
+	todov1.TodoService_DeleteTodo_FullMethodName:             true,
+	todov1.TodoService_RestoreTodo_FullMethodName:            true,
+	todov1.TodoService_PurgeTodo_FullMethodName:              true,
+	todov1.TodoService_CreateLabel_FullMethodName:            true,
+	todov1.TodoService_UpdateLabel_FullMethodName:            true,
+	todov1.TodoService_DeleteLabel_FullMethodName:            true,
+	todov1.TodoService_AttachLabels_FullMethodName:           true,
+	todov1.TodoService_DetachLabels_FullMethodName:           true,
+	todov1.TodoService_MoveTodo_FullMethodName:               true,
+	todov1.TodoService_AddChecklistItem_FullMethodName:       true,
+	todov1.TodoService_ToggleChecklistItem_FullMethodName:    true,
+	todov1.TodoService_RemoveChecklistItem_FullMethodName:    true,
+	todov1.TodoService_ReorderChecklistItems_FullMethodName:  true,
+	todov1.TodoService_BatchCreateTodos_FullMethodName:       true,
+	todov1.TodoService_BatchUpdateTodos_FullMethodName:       true,
+	todov1.TodoService_BatchDeleteTodos_FullMethodName:       true,
+	todov1.TodoService_AddCollaborator_FullMethodName:        true,
+	todov1.TodoService_ChangeCollaboratorRole_FullMethodName: true,
+	todov1.TodoService_RemoveCollaborator_FullMethodName:     true,
+	todov1.TodoService_AssignTodo_FullMethodName:             true,
+	todov1.TodoService_UnassignTodo_FullMethodName:           true,
+	todov1.TodoService_AddComment_FullMethodName:             true,
+	todov1.TodoService_EditComment_FullMethodName:            true,
+	todov1.TodoService_DeleteComment_FullMethodName:          true,
+	todov1.TodoListService_CreateTodoList_FullMethodName:     true,
+	todov1.TodoListService_UpdateTodoList_FullMethodName:     true,
+	todov1.TodoListService_ArchiveTodoList_FullMethodName:    true,
+	todov1.TodoListService_UnarchiveTodoList_FullMethodName:  true,
+	todov1.TodoListService_DeleteTodoList_FullMethodName:     true,
+}
+
+// NewIdempotencyUnaryInterceptor は metadata "idempotency-key" の付いた書き込み系のリクエストを
+// キーごとに 1 回だけ実行し、同じキーで再送されたら保存した結果（エラーを含む）をそのまま返す。
+// 同じキーを別のリクエストに使うと INVALID_ARGUMENT、前のリクエストが処理中なら ABORTED。
+// 認証済みのユーザーごとにキーを分けるので、Auth interceptor より後ろに置くこと。
+func NewIdempotencyUnaryInterceptor(logger *zap.Logger, uc idempotency_usecase.Usecase) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !idempotentMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		md, _ := metadata.FromIncomingContext(ctx)
+		vals := md.Get(mdKeyIdempotencyKey)
+		if len(vals) == 0 {
+			return handler(ctx, req)
+		}
+		if len(vals) > 1 {
+			return nil, status.Error(codes.InvalidArgument, "invalid idempotency-key header")
+		}
+		ownerID, err := ownerIDFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		hash, err := requestHash(info.FullMethod, msg)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+
+		replay, claim, err := uc.Begin(ctx, ownerID, vals[0], info.FullMethod, hash)
+		switch {
+		case errors.Is(err, idempotency_usecase.ErrInvalidKey):
+			return nil, status.Error(codes.InvalidArgument, "invalid idempotency-key header")
+		case errors.Is(err, idempotency_usecase.ErrKeyReused):
+			return nil, status.Error(codes.InvalidArgument, "idempotency-key was already used for a different request")
+		case errors.Is(err, idempotency_usecase.ErrInProgress):
+			// gateway 経由では HTTP 409 Conflict になる
+			return nil, status.Error(codes.Aborted, "a request with this idempotency-key is in progress or its result was not recorded")
+		case err != nil:
+			return nil, toGRPCError(err)
+		case claim == nil:
+			return replayResponse(ctx, replay)
+		}
+
+		resp, handlerErr := handler(idempotency_usecase.WithClaim(ctx, claim), req)
+		if handlerErr != nil && claim.Conflicted() {
+			return nil, status.Error(codes.Aborted, "a request with this idempotency-key is in progress")
+		}
+
+		recorded, err := encodeResponse(resp, handlerErr)
+		if err == nil {
+			finishCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), finishIdempotencyTimeout)
+			err = uc.Finish(finishCtx, claim, recorded, handlerErr == nil)
+			cancel()
+		}
+		if err != nil {
+			// 変更自体は済んでいるので、レスポンスはそのまま返す（再送されると ABORTED になる）
+			logger.Warn("failed to record idempotent response",
+				zap.String("method", info.FullMethod),
+				zap.Error(err),
+			)
+		}
+		return resp, handlerErr
+	}
+}
+
+// requestHash はメソッドとリクエストのハッシュ。同じ内容なら同じ値になるよう、決定的にシリアライズする。
+func requestHash(method string, req proto.Message) ([]byte, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write(b)
+	return h.Sum(nil), nil
+}
+
+// encodeResponse は結果を Any にして保存用にシリアライズする。エラーは google.rpc.Status として残す。
+func encodeResponse(resp any, err error) ([]byte, error) {
+	var msg proto.Message = status.Convert(err).Proto()
+	if err == nil {
+		m, ok := resp.(proto.Message)
+		if !ok {
+			return nil, errors.New("response is not a proto message")
+		}
+		msg = m
+	}
+	a, err := anypb.New(msg)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(a)
+}
+
+// replayResponse は保存した結果を返す。ETag も元のレスポンスと同じものを付け直す。
+func replayResponse(ctx context.Context, recorded []byte) (any, error) {
+	var a anypb.Any
+	if err := proto.Unmarshal(recorded, &a); err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	msg, err := a.UnmarshalNew()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(mdKeyIdempotentReplayed, "true"))
+	switch m := msg.(type) {
+	case *spb.Status:
+		return nil, status.ErrorProto(m)
+	case *todov1.Todo:
+		setETagHeader(ctx, m.GetVersion())
+	}
+	return msg, nil
+}
@@ -0,0 +1,159 @@
+package grpcadapter
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	todov1 "github.com/hijjiri/grpc-echo/api/todo/v1"
+	idempotency_usecase "github.com/hijjiri/grpc-echo/internal/usecase/idempotency"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeIdempotency はメモリ上の idempotency_usecase.Usecase。Begin した後、Finish されるまでは処理中として扱う。
+type fakeIdempotency struct {
+	records map[string]*fakeIdempotencyRecord
+	claims  map[*idempotency_usecase.Claim]string
+}
+
+type fakeIdempotencyRecord struct {
+	hash     []byte
+	response []byte
+}
+
+func newFakeIdempotency() *fakeIdempotency {
+	return &fakeIdempotency{
+		records: map[string]*fakeIdempotencyRecord{},
+		claims:  map[*idempotency_usecase.Claim]string{},
+	}
+}
+
+func (f *fakeIdempotency) Begin(ctx context.Context, ownerID, key, method string, requestHash []byte) ([]byte, *idempotency_usecase.Claim, error) {
+	k := ownerID + "/" + key
+	r, ok := f.records[k]
+	switch {
+	case !ok:
+		f.records[k] = &fakeIdempotencyRecord{hash: requestHash}
+		claim := &idempotency_usecase.Claim{}
+		f.claims[claim] = k
+		return nil, claim, nil
+	case !bytes.Equal(r.hash, requestHash):
+		return nil, nil, idempotency_usecase.ErrKeyReused
+	case r.response == nil:
+		return nil, nil, idempotency_usecase.ErrInProgress
+	}
+	return r.response, nil, nil
+}
+
+func (f *fakeIdempotency) Finish(ctx context.Context, claim *idempotency_usecase.Claim, response []byte, succeeded bool) error {
+	f.records[f.claims[claim]].response = response
+	return nil
+}
+
+func (f *fakeIdempotency) PurgeExpired(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
+// headerStream は grpc.SetHeader で付けたヘッダを記録する ServerTransportStream
+type headerStream struct {
+	header metadata.MD
+}
+
+func (s *headerStream) Method() string { return "" }
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *headerStream) SendHeader(md metadata.MD) error { return s.SetHeader(md) }
+
+func (s *headerStream) SetTrailer(md metadata.MD) error { return nil }
+
+func TestIdempotencyUnaryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	interceptor := NewIdempotencyUnaryInterceptor(zap.NewNop(), newFakeIdempotency())
+	createInfo := &grpc.UnaryServerInfo{FullMethod: todov1.TodoService_CreateTodo_FullMethodName}
+	calls := 0
+	handler := func(ctx context.Context, req any) (any, error) {
+		calls++
+		return &todov1.Todo{Id: int64(calls), Title: req.(*todov1.CreateTodoRequest).GetTitle(), Version: 1}, nil
+	}
+
+	// call は user-1 として key を付けて呼び、レスポンスと付いたヘッダを返す
+	call := func(info *grpc.UnaryServerInfo, key string, req proto.Message, h grpc.UnaryHandler) (any, metadata.MD, error) {
+		stream := &headerStream{}
+		ctx := grpc.NewContextWithServerTransportStream(WithUserID(context.Background(), "user-1"), stream)
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(mdKeyIdempotencyKey, key))
+		resp, err := interceptor(ctx, req, info, h)
+		return resp, stream.header, err
+	}
+
+	req := &todov1.CreateTodoRequest{Title: "牛乳を買う"}
+	first, header, err := call(createInfo, "key-1", req, handler)
+	if err != nil {
+		t.Fatalf("first call returned error: %v", err)
+	}
+	if len(header.Get(mdKeyIdempotentReplayed)) != 0 {
+		t.Errorf("expected no %s header on the first call, got %v", mdKeyIdempotentReplayed, header)
+	}
+
+	// 同じキー・同じリクエストは実行し直さず、前回の結果を返す
+	replayed, header, err := call(createInfo, "key-1", req, handler)
+	if err != nil {
+		t.Fatalf("replayed call returned error: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected the handler to run once, ran %d times", calls)
+	}
+	if !proto.Equal(replayed.(proto.Message), first.(proto.Message)) {
+		t.Errorf("expected the recorded response %v, got %v", first, replayed)
+	}
+	if got := header.Get(mdKeyIdempotentReplayed); len(got) != 1 || got[0] != "true" {
+		t.Errorf("expected %s: true, got %v", mdKeyIdempotentReplayed, header)
+	}
+	if got := header.Get(mdKeyETag); len(got) != 1 {
+		t.Errorf("expected the etag header to be set again, got %v", header)
+	}
+
+	// 同じキーを別のリクエストに使うと INVALID_ARGUMENT
+	_, _, err = call(createInfo, "key-1", &todov1.CreateTodoRequest{Title: "パンを買う"}, handler)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument, got %v", err)
+	}
+
+	// 前のリクエストが処理中なら ABORTED
+	var nestedErr error
+	_, _, err = call(createInfo, "key-2", req, func(ctx context.Context, r any) (any, error) {
+		_, _, nestedErr = call(createInfo, "key-2", req, handler)
+		return handler(ctx, r)
+	})
+	if err != nil {
+		t.Fatalf("call returned error: %v", err)
+	}
+	if status.Code(nestedErr) != codes.Aborted {
+		t.Errorf("expected Aborted, got %v", nestedErr)
+	}
+
+	// 読み取り系ではキーを無視して毎回実行する
+	getInfo := &grpc.UnaryServerInfo{FullMethod: todov1.TodoService_GetTodo_FullMethodName}
+	gets := 0
+	get := func(ctx context.Context, req any) (any, error) {
+		gets++
+		return &todov1.Todo{Id: 1}, nil
+	}
+	for range 2 {
+		if _, header, err := call(getInfo, "key-3", &todov1.GetTodoRequest{Id: 1}, get); err != nil || len(header.Get(mdKeyIdempotentReplayed)) != 0 {
+			t.Errorf("GetTodo returned %v (header %v)", err, header)
+		}
+	}
+	if gets != 2 {
+		t.Errorf("expected GetTodo to run twice, ran %d times", gets)
+	}
+}
@@ -0,0 +1,154 @@
+package grpcadapter
+
+import (
+	"context"
+
+	todov1 "github.com/hijjiri/grpc-echo/api/todo/v1"
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	todo_usecase "github.com/hijjiri/grpc-echo/internal/usecase/todo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// --- Labels ---
+func (h *TodoHandler) ListLabels(ctx context.Context, _ *todov1.ListLabelsRequest) (*todov1.ListLabelsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoReadTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := h.uc.ListLabels(ctx, ownerID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &todov1.ListLabelsResponse{}
+	for _, l := range labels {
+		resp.Labels = append(resp.Labels, toProtoLabel(l))
+	}
+	return resp, nil
+}
+
+func (h *TodoHandler) CreateLabel(ctx context.Context, req *todov1.CreateLabelRequest) (*todov1.Label, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoWriteTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := h.uc.CreateLabel(ctx, ownerID, todo_usecase.LabelParams{
+		Name:  req.GetName(),
+		Color: req.GetColor(),
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return toProtoLabel(l), nil
+}
+
+func (h *TodoHandler) UpdateLabel(ctx context.Context, req *todov1.UpdateLabelRequest) (*todov1.Label, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoWriteTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := toLabelUpdateParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := h.uc.UpdateLabel(ctx, ownerID, req.GetId(), params)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return toProtoLabel(l), nil
+}
+
+func (h *TodoHandler) DeleteLabel(ctx context.Context, req *todov1.DeleteLabelRequest) (*todov1.DeleteLabelResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoWriteTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.uc.DeleteLabel(ctx, ownerID, req.GetId()); err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &todov1.DeleteLabelResponse{Ok: true}, nil
+}
+
+func (h *TodoHandler) AttachLabels(ctx context.Context, req *todov1.ChangeTodoLabelsRequest) (*todov1.Todo, error) {
+	return h.changeTodoLabels(ctx, req, h.uc.AttachLabels)
+}
+
+func (h *TodoHandler) DetachLabels(ctx context.Context, req *todov1.ChangeTodoLabelsRequest) (*todov1.Todo, error) {
+	return h.changeTodoLabels(ctx, req, h.uc.DetachLabels)
+}
+
+// changeTodoLabels は AttachLabels / DetachLabels 共通の処理（usecase のメソッドだけが違う）
+func (h *TodoHandler) changeTodoLabels(
+	ctx context.Context,
+	req *todov1.ChangeTodoLabelsRequest,
+	change func(ctx context.Context, ownerID string, todoID int64, labelIDs []int64, expectedVersion int64) (*domain_todo.Todo, error),
+) (*todov1.Todo, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoWriteTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := expectedVersion(ctx, req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := change(ctx, ownerID, req.GetId(), req.GetLabelIds(), version)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	setETagHeader(ctx, t.Version)
+	return toProtoTodo(t), nil
+}
+
+// toLabelUpdateParams は UpdateTodo と同じ規則で update_mask を解釈する。
+// id / created_at / updated_at は出力専用なので無視する。
+func toLabelUpdateParams(req *todov1.UpdateLabelRequest) (todo_usecase.LabelUpdateParams, error) {
+	src := req.GetLabel()
+
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = []string{"*"}
+	}
+
+	var p todo_usecase.LabelUpdateParams
+	for _, path := range paths {
+		switch path {
+		case "*":
+			name, color := src.GetName(), src.GetColor()
+			p.Name, p.Color = &name, &color
+		case "name":
+			name := src.GetName()
+			p.Name = &name
+		case "color":
+			color := src.GetColor()
+			p.Color = &color
+		case "id", "created_at", "updated_at":
+			// 出力専用
+		default:
+			return p, status.Errorf(codes.InvalidArgument, "invalid update_mask path: %q", path)
+		}
+	}
+	return p, nil
+}
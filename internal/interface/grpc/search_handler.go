@@ -0,0 +1,51 @@
+package grpcadapter
+
+import (
+	"context"
+
+	todov1 "github.com/hijjiri/grpc-echo/api/todo/v1"
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	todo_usecase "github.com/hijjiri/grpc-echo/internal/usecase/todo"
+)
+
+// --- Search ---
+func (h *TodoHandler) SearchTodos(ctx context.Context, req *todov1.SearchTodosRequest) (*todov1.SearchTodosResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoReadTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.uc.Search(ctx, ownerID, todo_usecase.SearchParams{
+		Query:     req.GetQuery(),
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &todov1.SearchTodosResponse{NextPageToken: res.NextPageToken}
+	for _, m := range res.Matches {
+		resp.Results = append(resp.Results, &todov1.SearchTodoResult{
+			Todo:       toProtoTodo(m.Todo),
+			Score:      m.Score,
+			Highlights: toProtoHighlights(m.Highlights),
+		})
+	}
+	return resp, nil
+}
+
+func toProtoHighlights(hs []domain_todo.Highlight) []*todov1.TextHighlight {
+	out := make([]*todov1.TextHighlight, 0, len(hs))
+	for _, h := range hs {
+		ph := &todov1.TextHighlight{Field: h.Field, Snippet: h.Snippet}
+		for _, r := range h.Ranges {
+			ph.Ranges = append(ph.Ranges, &todov1.TextRange{Start: int32(r.Start), End: int32(r.End)})
+		}
+		out = append(out, ph)
+	}
+	return out
+}
@@ -0,0 +1,128 @@
+package grpcadapter
+
+import (
+	"context"
+
+	todov1 "github.com/hijjiri/grpc-echo/api/todo/v1"
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+)
+
+// --- Collaborators ---
+
+func (h *TodoHandler) AddCollaborator(ctx context.Context, req *todov1.AddCollaboratorRequest) (*todov1.Collaborator, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoWriteTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := h.uc.AddCollaborator(ctx, ownerID, toResource(req.GetTarget()), req.GetUserId(), domain_todo.Role(req.GetRole()))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return toProtoCollaborator(g), nil
+}
+
+func (h *TodoHandler) ChangeCollaboratorRole(ctx context.Context, req *todov1.ChangeCollaboratorRoleRequest) (*todov1.Collaborator, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoWriteTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := h.uc.ChangeCollaboratorRole(ctx, ownerID, toResource(req.GetTarget()), req.GetUserId(), domain_todo.Role(req.GetRole()))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return toProtoCollaborator(g), nil
+}
+
+func (h *TodoHandler) RemoveCollaborator(ctx context.Context, req *todov1.RemoveCollaboratorRequest) (*todov1.RemoveCollaboratorResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoWriteTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.uc.RemoveCollaborator(ctx, ownerID, toResource(req.GetTarget()), req.GetUserId()); err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &todov1.RemoveCollaboratorResponse{Ok: true}, nil
+}
+
+func (h *TodoHandler) ListCollaborators(ctx context.Context, req *todov1.ListCollaboratorsRequest) (*todov1.ListCollaboratorsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoReadTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, err := h.uc.ListCollaborators(ctx, ownerID, toResource(req.GetTarget()))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &todov1.ListCollaboratorsResponse{Collaborators: toProtoCollaborators(grants)}, nil
+}
+
+func (h *TodoHandler) ListSharedWithMe(ctx context.Context, req *todov1.ListSharedWithMeRequest) (*todov1.ListSharedWithMeResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoReadTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, err := h.uc.ListSharedWithMe(ctx, ownerID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &todov1.ListSharedWithMeResponse{Collaborators: toProtoCollaborators(grants)}, nil
+}
+
+// toResource は target を共有の対象にする。どちらも無ければ種類が未指定のまま（usecase で ErrInvalidResource）。
+func toResource(t *todov1.ShareTarget) domain_todo.Resource {
+	switch x := t.GetTarget().(type) {
+	case *todov1.ShareTarget_TodoId:
+		return domain_todo.Resource{Type: domain_todo.ResourceTodo, ID: x.TodoId}
+	case *todov1.ShareTarget_ListId:
+		return domain_todo.Resource{Type: domain_todo.ResourceList, ID: x.ListId}
+	default:
+		return domain_todo.Resource{}
+	}
+}
+
+func toProtoShareTarget(r domain_todo.Resource) *todov1.ShareTarget {
+	if r.Type == domain_todo.ResourceList {
+		return &todov1.ShareTarget{Target: &todov1.ShareTarget_ListId{ListId: r.ID}}
+	}
+	return &todov1.ShareTarget{Target: &todov1.ShareTarget_TodoId{TodoId: r.ID}}
+}
+
+func toProtoCollaborators(grants []*domain_todo.Grant) []*todov1.Collaborator {
+	out := make([]*todov1.Collaborator, 0, len(grants))
+	for _, g := range grants {
+		out = append(out, toProtoCollaborator(g))
+	}
+	return out
+}
+
+func toProtoCollaborator(g *domain_todo.Grant) *todov1.Collaborator {
+	return &todov1.Collaborator{
+		Target:    toProtoShareTarget(g.Resource),
+		OwnerId:   g.OwnerID,
+		UserId:    g.GranteeID,
+		Role:      todov1.CollaboratorRole(g.Role),
+		GrantedBy: g.GrantedBy,
+		CreatedAt: toTimestamp(g.CreatedAt),
+		UpdatedAt: toTimestamp(g.UpdatedAt),
+	}
+}
@@ -4,9 +4,19 @@ import (
 	"context"
 	"time"
 
+	todov1 "github.com/hijjiri/grpc-echo/api/todo/v1"
 	"google.golang.org/grpc"
 )
 
+// untimedStreams は全体の timeout を付けない stream。
+// WatchTodos はクライアントが切るまで続け、ExportTodos / ImportTodos / GetCalendarFeed はハンドラで長めの timeout を付ける。
+var untimedStreams = map[string]bool{
+	todov1.TodoService_WatchTodos_FullMethodName:      true,
+	todov1.TodoService_ExportTodos_FullMethodName:     true,
+	todov1.TodoService_ImportTodos_FullMethodName:     true,
+	todov1.TodoService_GetCalendarFeed_FullMethodName: true,
+}
+
 // wrappedServerStream は stream.Context() を差し替えるための薄いラッパ
 type wrappedServerStream struct {
 	grpc.ServerStream
@@ -42,6 +52,7 @@ func NewTimeoutUnaryInterceptor(timeout time.Duration) grpc.UnaryServerIntercept
 
 // NewTimeoutStreamInterceptor は gRPC Stream 全体に timeout を付与する interceptor。
 // stream は 1 RPC が長くなりがちなので、必要なら別値に分けてもOK。
+// untimedStreams（WatchTodos など）には付けない。
 func NewTimeoutStreamInterceptor(timeout time.Duration) grpc.StreamServerInterceptor {
 	if timeout <= 0 {
 		return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
@@ -50,6 +61,10 @@ func NewTimeoutStreamInterceptor(timeout time.Duration) grpc.StreamServerInterce
 	}
 
 	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if untimedStreams[info.FullMethod] {
+			return handler(srv, ss)
+		}
+
 		// 既存 deadline があるなら、それを尊重（短い方を採用）
 		if deadline, ok := ss.Context().Deadline(); ok {
 			remain := time.Until(deadline)
@@ -10,6 +10,9 @@ import (
 	todo_usecase "github.com/hijjiri/grpc-echo/internal/usecase/todo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type TodoHandler struct {
@@ -23,9 +26,11 @@ func NewTodoHandler(uc todo_usecase.Usecase) *TodoHandler {
 
 // 本番目線：handler 層で「処理上限」を決めて、DB詰まり等で無限にぶら下がらないようにする
 const (
-	defaultTodoWriteTimeout  = 3 * time.Second  // Create/Update/Delete
-	defaultTodoReadTimeout   = 5 * time.Second  // List
-	defaultTodoStreamTimeout = 10 * time.Second // Stream List の「取得」側
+	defaultTodoWriteTimeout    = 3 * time.Second  // Create/Update/Delete
+	defaultTodoReadTimeout     = 5 * time.Second  // Get/List
+	defaultTodoStreamTimeout   = 10 * time.Second // Stream List の「取得」側
+	defaultTodoBatchTimeout    = 10 * time.Second // Batch Create/Update/Delete（最大 MaxBatchSize 件）
+	defaultTodoTransferTimeout = time.Minute      // Export/Import（ファイル全体。最大 MaxImportRows 件）
 )
 
 // --- Create ---
@@ -33,33 +38,75 @@ func (h *TodoHandler) CreateTodo(ctx context.Context, req *todov1.CreateTodoRequ
 	ctx, cancel := context.WithTimeout(ctx, defaultTodoWriteTimeout)
 	defer cancel()
 
-	t, err := h.uc.Create(ctx, req.GetTitle())
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := toCreateParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := h.uc.Create(ctx, ownerID, params)
 	if err != nil {
 		return nil, toGRPCError(err)
 	}
+	setETagHeader(ctx, t.Version)
 	return toProtoTodo(t), nil
 }
 
-// --- List ---
-func (h *TodoHandler) ListTodos(ctx context.Context, req *todov1.ListTodosRequest) (*todov1.ListTodosResponse, error) {
+func toCreateParams(req *todov1.CreateTodoRequest) (todo_usecase.CreateParams, error) {
+	recurrence, err := toRecurrence(req.GetRecurrence())
+	if err != nil {
+		return todo_usecase.CreateParams{}, toGRPCError(err)
+	}
+
+	return todo_usecase.CreateParams{
+		Title:    req.GetTitle(),
+		DueAt:    toTime(req.GetDueAt()),
+		Priority: domain_todo.Priority(req.GetPriority()),
+		Notes:    req.GetNotes(),
+		ListID:   req.GetListId(),
+
+		AutoComplete: req.GetAutoComplete(),
+		Recurrence:   recurrence,
+	}, nil
+}
+
+// --- Get ---
+func (h *TodoHandler) GetTodo(ctx context.Context, req *todov1.GetTodoRequest) (*todov1.Todo, error) {
 	ctx, cancel := context.WithTimeout(ctx, defaultTodoReadTimeout)
 	defer cancel()
 
-	if userID, ok := UserIDFromContext(ctx); ok {
-		// ここで userID に応じたフィルタや認可を付けることもできる
-		_ = userID
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	list, err := h.uc.List(ctx)
+	t, err := h.uc.Get(ctx, ownerID, req.GetId())
 	if err != nil {
 		return nil, toGRPCError(err)
 	}
+	setETagHeader(ctx, t.Version)
+	return toProtoTodo(t), nil
+}
 
-	resp := &todov1.ListTodosResponse{}
-	for _, t := range list {
-		resp.Todos = append(resp.Todos, toProtoTodo(t))
+// --- List ---
+func (h *TodoHandler) ListTodos(ctx context.Context, req *todov1.ListTodosRequest) (*todov1.ListTodosResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoReadTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.uc.List(ctx, ownerID, toListParams(req))
+	if err != nil {
+		return nil, toGRPCError(err)
 	}
-	return resp, nil
+	return toProtoListResponse(res), nil
 }
 
 // --- Delete ---
@@ -67,7 +114,17 @@ func (h *TodoHandler) DeleteTodo(ctx context.Context, req *todov1.DeleteTodoRequ
 	ctx, cancel := context.WithTimeout(ctx, defaultTodoWriteTimeout)
 	defer cancel()
 
-	if err := h.uc.Delete(ctx, req.GetId()); err != nil {
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := expectedVersion(ctx, req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.uc.Delete(ctx, ownerID, req.GetId(), version); err != nil {
 		return nil, toGRPCError(err)
 	}
 	// proto 側にフィールドが無いので、空メッセージだけ返す
@@ -78,20 +135,311 @@ func (h *TodoHandler) UpdateTodo(ctx context.Context, req *todov1.UpdateTodoRequ
 	ctx, cancel := context.WithTimeout(ctx, defaultTodoWriteTimeout)
 	defer cancel()
 
-	t, err := h.uc.Update(ctx, req.GetId(), req.GetTitle(), req.GetDone())
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := toUpdateParams(req)
+	if err != nil {
+		return nil, err
+	}
+	params.ExpectedVersion, err = expectedVersion(ctx, req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := h.uc.Update(ctx, ownerID, req.GetId(), params)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	setETagHeader(ctx, t.Version)
+	return toProtoTodo(t), nil
+}
+
+// --- Trash ---
+func (h *TodoHandler) ListDeletedTodos(ctx context.Context, req *todov1.ListTodosRequest) (*todov1.ListTodosResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoReadTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.uc.ListDeleted(ctx, ownerID, toListParams(req))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return toProtoListResponse(res), nil
+}
+
+func (h *TodoHandler) RestoreTodo(ctx context.Context, req *todov1.RestoreTodoRequest) (*todov1.Todo, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoWriteTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := h.uc.Restore(ctx, ownerID, req.GetId())
 	if err != nil {
 		return nil, toGRPCError(err)
 	}
+	setETagHeader(ctx, t.Version)
 	return toProtoTodo(t), nil
 }
 
+func (h *TodoHandler) PurgeTodo(ctx context.Context, req *todov1.PurgeTodoRequest) (*todov1.PurgeTodoResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoWriteTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.uc.Purge(ctx, ownerID, req.GetId()); err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &todov1.PurgeTodoResponse{Ok: true}, nil
+}
+
+// --- identity ---
+
+// ownerIDFromContext は Auth interceptor が詰めた userID を Todo の所有者として取り出す。
+// 取れない場合（認証を通っていない）は Unauthenticated を返す。
+func ownerIDFromContext(ctx context.Context) (string, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok || userID == "" {
+		return "", status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return userID, nil
+}
+
+// --- converter (proto -> usecase) ---
+func toListParams(req *todov1.ListTodosRequest) todo_usecase.ListParams {
+	p := todo_usecase.ListParams{
+		Filter: domain_todo.ListFilter{
+			TitleContains: req.GetTitleContains(),
+			CreatedAfter:  toTime(req.GetCreatedAfter()),
+			CreatedBefore: toTime(req.GetCreatedBefore()),
+			UpdatedAfter:  toTime(req.GetUpdatedAfter()),
+			UpdatedBefore: toTime(req.GetUpdatedBefore()),
+			Overdue:       req.GetOverdue(),
+			DueWithinDays: int(req.GetDueWithinDays()),
+			LabelIDs:      req.GetLabelIds(),
+			LabelMatch:    domain_todo.LabelMatch(req.GetLabelMatch()),
+			AssignedToMe:  req.GetAssignedToMe(),
+		},
+		OrderBy:   req.GetOrderBy(),
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	}
+	if d := req.GetDone(); d != nil {
+		done := d.GetValue()
+		p.Filter.Done = &done
+	}
+	if l := req.GetListId(); l != nil {
+		listID := l.GetValue()
+		p.Filter.ListID = &listID
+	}
+	return p
+}
+
+// toUpdateParams は UpdateTodoRequest を「変更するフィールドだけ非 nil」の UpdateParams にする。
+//   - todo 未指定（旧形式）: title / done の両方を上書き
+//   - update_mask が "*": todo の title / done / due_at / priority / notes / labels / auto_complete / recurrence をすべて上書き
+//   - update_mask が空: todo で値の入っているフィールドだけ（AIP-134 の implied mask。ゼロ値で上書きしてしまわないように）
+//   - それ以外: update_mask に含まれるフィールドだけ
+//
+// id / created_at / updated_at / version / deleted_at / list_id / items / position / assignee_id は出力専用なので、マスクに含まれていても無視する
+// （GET の結果をそのまま PATCH ボディに使っても通るように）。
+func toUpdateParams(req *todov1.UpdateTodoRequest) (todo_usecase.UpdateParams, error) {
+	src := req.GetTodo()
+	if src == nil {
+		title, done := req.GetTitle(), req.GetDone()
+		return todo_usecase.UpdateParams{Title: &title, Done: &done}, nil
+	}
+
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = populatedPaths(src)
+	}
+
+	var p todo_usecase.UpdateParams
+	for _, path := range paths {
+		switch path {
+		case "*":
+			title, done := src.GetTitle(), src.GetDone()
+			dueAt, priority, notes := toTime(src.GetDueAt()), domain_todo.Priority(src.GetPriority()), src.GetNotes()
+			p.Title, p.Done = &title, &done
+			labelIDs := labelIDsOf(src.GetLabels())
+			p.DueAt, p.Priority, p.Notes, p.LabelIDs = &dueAt, &priority, &notes, &labelIDs
+			autoComplete := src.GetAutoComplete()
+			p.AutoComplete = &autoComplete
+			recurrence, err := toRecurrence(src.GetRecurrence())
+			if err != nil {
+				return p, toGRPCError(err)
+			}
+			p.Recurrence = &recurrence
+		case "title":
+			title := src.GetTitle()
+			p.Title = &title
+		case "done":
+			done := src.GetDone()
+			p.Done = &done
+		case "due_at":
+			// 未設定（null）なら期限を外す
+			dueAt := toTime(src.GetDueAt())
+			p.DueAt = &dueAt
+		case "priority":
+			priority := domain_todo.Priority(src.GetPriority())
+			p.Priority = &priority
+		case "notes":
+			notes := src.GetNotes()
+			p.Notes = &notes
+		case "labels":
+			labelIDs := labelIDsOf(src.GetLabels())
+			p.LabelIDs = &labelIDs
+		case "auto_complete":
+			autoComplete := src.GetAutoComplete()
+			p.AutoComplete = &autoComplete
+		case "recurrence":
+			// 未設定（null）なら繰り返しをやめる
+			recurrence, err := toRecurrence(src.GetRecurrence())
+			if err != nil {
+				return p, toGRPCError(err)
+			}
+			p.Recurrence = &recurrence
+		case "id", "created_at", "updated_at", "version", "deleted_at", "list_id", "items", "position", "assignee_id":
+			// 出力専用（list_id / position は MoveTodo、items はチェックリストの RPC、assignee_id は AssignTodo で変える）
+		default:
+			return p, status.Errorf(codes.InvalidArgument, "invalid update_mask path: %q", path)
+		}
+	}
+	return p, nil
+}
+
+// populatedPaths は m で値の入っている（ゼロ値でない）フィールドの名前を返す
+func populatedPaths(m proto.Message) []string {
+	var paths []string
+	m.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		paths = append(paths, string(fd.Name()))
+		return true
+	})
+	return paths
+}
+
+// labelIDsOf は Label の id だけを取り出す（名前・色は見ない）
+func labelIDsOf(labels []*todov1.Label) []int64 {
+	ids := make([]int64, 0, len(labels))
+	for _, l := range labels {
+		ids = append(ids, l.GetId())
+	}
+	return ids
+}
+
+// toRecurrence は proto の繰り返しルールをドメインの値にする。未指定（nil）は「繰り返さない」。
+// rrule があればそれを読み、無ければ frequency / interval から作る（範囲のチェックはドメイン側で行う）。
+func toRecurrence(src *todov1.Recurrence) (domain_todo.Recurrence, error) {
+	if src.GetRrule() != "" {
+		return domain_todo.ParseRecurrence(src.GetRrule())
+	}
+	if src.GetFrequency() == todov1.Frequency_FREQUENCY_UNSPECIFIED {
+		if src.GetInterval() != 0 {
+			return domain_todo.Recurrence{}, domain_todo.ErrInvalidRecurrence
+		}
+		return domain_todo.Recurrence{}, nil
+	}
+	return domain_todo.Recurrence{
+		Freq:     domain_todo.Frequency(src.GetFrequency()),
+		Interval: int(src.GetInterval()),
+	}, nil
+}
+
+// toTime は未指定（nil）の Timestamp をゼロ値の time.Time にする
+func toTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
 // --- converter (domain -> proto) ---
 func toProtoTodo(t *domain_todo.Todo) *todov1.Todo {
 	return &todov1.Todo{
-		Id:    t.ID,
-		Title: t.Title,
-		Done:  t.Done,
+		Id:        t.ID,
+		Title:     t.Title,
+		Done:      t.Done,
+		CreatedAt: toTimestamp(t.CreatedAt),
+		UpdatedAt: toTimestamp(t.UpdatedAt),
+		Version:   t.Version,
+		DeletedAt: toTimestamp(t.DeletedAt),
+		DueAt:     toTimestamp(t.DueAt),
+		Priority:  todov1.Priority(t.Priority),
+		Notes:     t.Notes,
+		Labels:    toProtoLabels(t.Labels),
+		ListId:    t.ListID,
+
+		Items:        toProtoChecklistItems(t.Items),
+		AutoComplete: t.AutoComplete,
+		Position:     t.Position,
+		Recurrence:   toProtoRecurrence(t.Recurrence),
+		AssigneeId:   t.AssigneeID,
+	}
+}
+
+// toProtoRecurrence は繰り返さない場合 nil を返す
+func toProtoRecurrence(r domain_todo.Recurrence) *todov1.Recurrence {
+	if r.IsZero() {
+		return nil
+	}
+	return &todov1.Recurrence{
+		Frequency: todov1.Frequency(r.Freq),
+		Interval:  int32(max(r.Interval, 1)),
+		Rrule:     r.String(),
+	}
+}
+
+func toProtoLabels(labels []domain_todo.Label) []*todov1.Label {
+	if len(labels) == 0 {
+		return nil
+	}
+	out := make([]*todov1.Label, 0, len(labels))
+	for i := range labels {
+		out = append(out, toProtoLabel(&labels[i]))
 	}
+	return out
+}
+
+func toProtoLabel(l *domain_todo.Label) *todov1.Label {
+	return &todov1.Label{
+		Id:        l.ID,
+		Name:      l.Name,
+		Color:     l.Color,
+		CreatedAt: toTimestamp(l.CreatedAt),
+		UpdatedAt: toTimestamp(l.UpdatedAt),
+	}
+}
+
+func toProtoListResponse(res *todo_usecase.ListResult) *todov1.ListTodosResponse {
+	resp := &todov1.ListTodosResponse{
+		NextPageToken: res.NextPageToken,
+	}
+	for _, t := range res.Todos {
+		resp.Todos = append(resp.Todos, toProtoTodo(t))
+	}
+	return resp
+}
+
+// toTimestamp はゼロ値の time.Time を未設定（nil）として扱う
+func toTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
 }
 
 // --- error mapper ---
@@ -111,9 +459,162 @@ func toGRPCError(err error) error {
 	case errors.Is(err, todo_usecase.ErrInvalidID):
 		return status.Error(codes.InvalidArgument, "invalid id")
 
+	case errors.Is(err, todo_usecase.ErrInvalidPriority):
+		return status.Error(codes.InvalidArgument, "invalid priority")
+
+	case errors.Is(err, todo_usecase.ErrDueBeforeCreation):
+		return status.Error(codes.InvalidArgument, "due_at must not be before created_at")
+
+	case errors.Is(err, todo_usecase.ErrNotesTooLong):
+		return status.Errorf(codes.InvalidArgument, "notes must be at most %d characters", domain_todo.MaxNotesLength)
+
+	case errors.Is(err, todo_usecase.ErrInvalidRecurrence):
+		return status.Error(codes.InvalidArgument, "invalid recurrence rule")
+
+	case errors.Is(err, todo_usecase.ErrEmptyLabelName):
+		return status.Error(codes.InvalidArgument, "label name is required")
+
+	case errors.Is(err, todo_usecase.ErrLabelNameTooLong):
+		return status.Errorf(codes.InvalidArgument, "label name must be at most %d characters", domain_todo.MaxLabelNameLength)
+
+	case errors.Is(err, todo_usecase.ErrInvalidLabelColor):
+		return status.Error(codes.InvalidArgument, "label color must be #rrggbb")
+
+	case errors.Is(err, todo_usecase.ErrEmptyLabelIDs):
+		return status.Error(codes.InvalidArgument, "label_ids is required")
+
+	case errors.Is(err, todo_usecase.ErrTooManyLabels):
+		return status.Errorf(codes.InvalidArgument, "a todo can have at most %d labels", domain_todo.MaxLabelsPerTodo)
+
+	case errors.Is(err, todo_usecase.ErrLabelNotFound):
+		return status.Error(codes.NotFound, "label not found")
+
+	case errors.Is(err, todo_usecase.ErrLabelAlreadyExists):
+		return status.Error(codes.AlreadyExists, "label already exists")
+
+	case errors.Is(err, todo_usecase.ErrEmptyListName):
+		return status.Error(codes.InvalidArgument, "todo list name is required")
+
+	case errors.Is(err, todo_usecase.ErrListNameTooLong):
+		return status.Errorf(codes.InvalidArgument, "todo list name must be at most %d characters", domain_todo.MaxTodoListNameLength)
+
+	case errors.Is(err, todo_usecase.ErrListNotFound):
+		return status.Error(codes.NotFound, "todo list not found")
+
+	case errors.Is(err, todo_usecase.ErrListArchived):
+		return status.Error(codes.FailedPrecondition, "todo list is archived")
+
+	case errors.Is(err, todo_usecase.ErrListNotEmpty):
+		return status.Error(codes.FailedPrecondition, "todo list is not empty: archive it or move its todos first")
+
+	case errors.Is(err, todo_usecase.ErrEmptyItemTitle):
+		return status.Error(codes.InvalidArgument, "checklist item title is required")
+
+	case errors.Is(err, todo_usecase.ErrItemTitleTooLong):
+		return status.Errorf(codes.InvalidArgument, "checklist item title must be at most %d characters", domain_todo.MaxChecklistItemTitleLength)
+
+	case errors.Is(err, todo_usecase.ErrTooManyItems):
+		return status.Errorf(codes.InvalidArgument, "a todo can have at most %d checklist items", domain_todo.MaxChecklistItems)
+
+	case errors.Is(err, todo_usecase.ErrInvalidItemOrder):
+		return status.Error(codes.InvalidArgument, "item_ids must list every checklist item exactly once")
+
+	case errors.Is(err, todo_usecase.ErrItemNotFound):
+		return status.Error(codes.NotFound, "checklist item not found")
+
+	case errors.Is(err, todo_usecase.ErrChecklistIncomplete):
+		return status.Error(codes.FailedPrecondition, "todo has unfinished checklist items")
+
+	case errors.Is(err, todo_usecase.ErrInvalidMoveAnchor):
+		return status.Error(codes.InvalidArgument, "specify either before_id or after_id, other than the todo itself")
+
+	case errors.Is(err, todo_usecase.ErrInvalidOrderBy):
+		return status.Error(codes.InvalidArgument, "invalid order_by")
+
+	case errors.Is(err, todo_usecase.ErrInvalidFilter):
+		return status.Error(codes.InvalidArgument, "invalid filter")
+
+	case errors.Is(err, todo_usecase.ErrEmptyBatch):
+		return status.Error(codes.InvalidArgument, "requests must not be empty")
+
+	case errors.Is(err, todo_usecase.ErrBatchTooLarge):
+		return status.Errorf(codes.InvalidArgument, "requests must have at most %d items", todo_usecase.MaxBatchSize)
+
+	case errors.Is(err, todo_usecase.ErrInvalidFormat):
+		return status.Error(codes.InvalidArgument, "invalid format")
+
+	case errors.Is(err, todo_usecase.ErrInvalidImportFile):
+		// どこが読めなかったかを返す（"invalid import file: csv header must have a title column" など）
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, todo_usecase.ErrImportTooLarge):
+		return status.Errorf(codes.InvalidArgument, "import must have at most %d rows", todo_usecase.MaxImportRows)
+
+	case errors.Is(err, todo_usecase.ErrInvalidPageSize):
+		return status.Error(codes.InvalidArgument, "page_size must not be negative")
+
+	case errors.Is(err, todo_usecase.ErrInvalidPageToken):
+		return status.Error(codes.InvalidArgument, "invalid page_token")
+
+	case errors.Is(err, todo_usecase.ErrInvalidSearchQuery):
+		return status.Errorf(codes.InvalidArgument, "query must have 1 to %d terms and at most %d characters", domain_todo.MaxSearchTerms, domain_todo.MaxSearchQueryLength)
+
+	case errors.Is(err, todo_usecase.ErrInvalidResumeToken):
+		return status.Error(codes.InvalidArgument, "invalid resume_token")
+
+	case errors.Is(err, todo_usecase.ErrSlowSubscriber):
+		return status.Error(codes.ResourceExhausted, "watcher fell behind and was dropped; reconnect with the last resume_token")
+
+	case errors.Is(err, todo_usecase.ErrInvalidFeedToken):
+		// トークンの形が違う・発行されていない・無効にしたものを区別しない
+		return status.Error(codes.Unauthenticated, "invalid feed token")
+
+	case errors.Is(err, todo_usecase.ErrFeedTokenNotFound):
+		return status.Error(codes.NotFound, "feed token not found")
+
+	case errors.Is(err, todo_usecase.ErrInvalidRole):
+		return status.Error(codes.InvalidArgument, "invalid collaborator role")
+
+	case errors.Is(err, todo_usecase.ErrInvalidResource):
+		return status.Error(codes.InvalidArgument, "target must have either todo_id or list_id")
+
+	case errors.Is(err, todo_usecase.ErrInvalidGrantee):
+		return status.Error(codes.InvalidArgument, "user_id must be someone other than the owner and yourself")
+
+	case errors.Is(err, todo_usecase.ErrGrantAlreadyExists):
+		return status.Error(codes.AlreadyExists, "already shared with the user")
+
+	case errors.Is(err, todo_usecase.ErrGrantNotFound):
+		return status.Error(codes.NotFound, "collaborator not found")
+
+	case errors.Is(err, todo_usecase.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, "permission denied")
+
+	case errors.Is(err, todo_usecase.ErrInvalidAssignee):
+		return status.Error(codes.InvalidArgument, "assignee_id is required")
+
+	case errors.Is(err, todo_usecase.ErrAssigneeNotFound):
+		return status.Error(codes.InvalidArgument, "assignee not found")
+
+	case errors.Is(err, todo_usecase.ErrEmptyComment):
+		return status.Error(codes.InvalidArgument, "comment body is required")
+
+	case errors.Is(err, todo_usecase.ErrCommentTooLong):
+		return status.Errorf(codes.InvalidArgument, "comment body must be at most %d characters", domain_todo.MaxCommentLength)
+
+	case errors.Is(err, todo_usecase.ErrCommentNotFound):
+		return status.Error(codes.NotFound, "comment not found")
+
+	case errors.Is(err, todo_usecase.ErrEmptyOwner):
+		return status.Error(codes.Unauthenticated, "unauthenticated")
+
 	case errors.Is(err, todo_usecase.ErrNotFound):
 		return status.Error(codes.NotFound, "todo not found")
 
+	case errors.Is(err, todo_usecase.ErrVersionMismatch):
+		// gateway 経由では HTTP 409 Conflict になる
+		return status.Error(codes.Aborted, "todo version mismatch")
+
 	default:
 		// Internal詳細はログ側にだけ残す（handler や interceptor で）
 		return status.Error(codes.Internal, "internal error")
@@ -121,7 +622,9 @@ func toGRPCError(err error) error {
 }
 
 // 既存の ListTodos と同じように usecase を呼んで、
-// 返ってきた slice を 1 件ずつ stream.Send するだけ
+// 返ってきた slice を 1 件ずつ stream.Send する。
+// 全件を一度にメモリへ載せないよう、page_token を辿ってページ単位で取得する
+// （page_size は 1 回の取得件数、page_token を渡すとその続きから流す）。
 func (h *TodoHandler) ListTodosStream(
 	req *todov1.ListTodosRequest,
 	stream todov1.TodoService_ListTodosStreamServer,
@@ -129,34 +632,43 @@ func (h *TodoHandler) ListTodosStream(
 	// stream の ctx はクライアント切断を反映するので基本はこれを使う
 	baseCtx := stream.Context()
 
+	ownerID, err := ownerIDFromContext(baseCtx)
+	if err != nil {
+		return err
+	}
+
 	// 「取得」部分だけは timeout を付与して、DB詰まりで無限に待たないようにする
 	listCtx, cancel := context.WithTimeout(baseCtx, defaultTodoStreamTimeout)
 	defer cancel()
 
-	todos, err := h.uc.List(listCtx)
-	if err != nil {
-		return toGRPCError(err)
+	params := toListParams(req)
+	if params.PageSize == 0 {
+		params.PageSize = todo_usecase.MaxPageSize
 	}
 
-	for _, t := range todos {
-		// 送信前に ctx を尊重（クライアント切断を早く検知）
-		select {
-		case <-baseCtx.Done():
-			return toGRPCError(baseCtx.Err())
-		default:
+	for {
+		res, err := h.uc.List(listCtx, ownerID, params)
+		if err != nil {
+			return toGRPCError(err)
 		}
 
-		resp := &todov1.Todo{
-			Id:    t.ID,
-			Title: t.Title,
-			Done:  t.Done,
+		for _, t := range res.Todos {
+			// 送信前に ctx を尊重（クライアント切断を早く検知）
+			select {
+			case <-baseCtx.Done():
+				return toGRPCError(baseCtx.Err())
+			default:
+			}
+
+			if err := stream.Send(toProtoTodo(t)); err != nil {
+				// transport error（切断等）
+				return err
+			}
 		}
 
-		if err := stream.Send(resp); err != nil {
-			// transport error（切断等）
-			return err
+		if res.NextPageToken == "" {
+			return nil
 		}
+		params.PageToken = res.NextPageToken
 	}
-
-	return nil
 }
@@ -0,0 +1,68 @@
+package grpcadapter
+
+import (
+	"testing"
+
+	todov1 "github.com/hijjiri/grpc-echo/api/todo/v1"
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestToUpdateParams(t *testing.T) {
+	t.Parallel()
+
+	// update_mask なし: 値の入っているフィールドだけを更新し、他（ラベル・期限・繰り返しなど）は触らない
+	p, err := toUpdateParams(&todov1.UpdateTodoRequest{
+		Id:   1,
+		Todo: &todov1.Todo{Id: 1, Title: "牛乳を買う", Priority: todov1.Priority(domain_todo.PriorityHigh), Version: 3},
+	})
+	if err != nil {
+		t.Fatalf("toUpdateParams returned error: %v", err)
+	}
+	if p.Title == nil || *p.Title != "牛乳を買う" {
+		t.Errorf("expected title to be updated, got %v", p.Title)
+	}
+	if p.Priority == nil || *p.Priority != domain_todo.PriorityHigh {
+		t.Errorf("expected priority to be updated, got %v", p.Priority)
+	}
+	if p.Done != nil || p.DueAt != nil || p.Notes != nil || p.LabelIDs != nil || p.AutoComplete != nil || p.Recurrence != nil {
+		t.Errorf("expected unset fields to be left alone, got %+v", p)
+	}
+
+	// "*" なら全フィールドを上書きする
+	p, err = toUpdateParams(&todov1.UpdateTodoRequest{
+		Id:         1,
+		Todo:       &todov1.Todo{Title: "牛乳を買う"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"*"}},
+	})
+	if err != nil {
+		t.Fatalf("toUpdateParams returned error: %v", err)
+	}
+	if p.Done == nil || p.DueAt == nil || p.LabelIDs == nil || p.Recurrence == nil {
+		t.Errorf("expected every field to be overwritten, got %+v", p)
+	}
+
+	// ゼロ値にしたいフィールドは update_mask で指定する
+	p, err = toUpdateParams(&todov1.UpdateTodoRequest{
+		Id:         1,
+		Todo:       &todov1.Todo{Title: "牛乳を買う"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"done", "due_at"}},
+	})
+	if err != nil {
+		t.Fatalf("toUpdateParams returned error: %v", err)
+	}
+	if p.Done == nil || *p.Done || p.DueAt == nil || !p.DueAt.IsZero() || p.Title != nil {
+		t.Errorf("expected only done / due_at to be cleared, got %+v", p)
+	}
+
+	_, err = toUpdateParams(&todov1.UpdateTodoRequest{
+		Id:         1,
+		Todo:       &todov1.Todo{},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"owner"}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for an unknown path, got %v", err)
+	}
+}
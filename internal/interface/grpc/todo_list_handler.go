@@ -0,0 +1,186 @@
+package grpcadapter
+
+import (
+	"context"
+
+	todov1 "github.com/hijjiri/grpc-echo/api/todo/v1"
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	todo_usecase "github.com/hijjiri/grpc-echo/internal/usecase/todo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TodoListHandler は TodoListService の実装。Todo をリストへ移す MoveTodo は TodoHandler 側にある。
+type TodoListHandler struct {
+	todov1.UnimplementedTodoListServiceServer
+	uc todo_usecase.Usecase
+}
+
+func NewTodoListHandler(uc todo_usecase.Usecase) *TodoListHandler {
+	return &TodoListHandler{uc: uc}
+}
+
+func (h *TodoListHandler) ListTodoLists(ctx context.Context, req *todov1.ListTodoListsRequest) (*todov1.ListTodoListsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoReadTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lists, err := h.uc.ListTodoLists(ctx, ownerID, req.GetIncludeArchived())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp := &todov1.ListTodoListsResponse{}
+	for _, l := range lists {
+		resp.TodoLists = append(resp.TodoLists, toProtoTodoList(l))
+	}
+	return resp, nil
+}
+
+func (h *TodoListHandler) GetTodoList(ctx context.Context, req *todov1.GetTodoListRequest) (*todov1.TodoList, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoReadTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := h.uc.GetTodoList(ctx, ownerID, req.GetId())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return toProtoTodoList(l), nil
+}
+
+func (h *TodoListHandler) CreateTodoList(ctx context.Context, req *todov1.CreateTodoListRequest) (*todov1.TodoList, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoWriteTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := h.uc.CreateTodoList(ctx, ownerID, req.GetName())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return toProtoTodoList(l), nil
+}
+
+func (h *TodoListHandler) UpdateTodoList(ctx context.Context, req *todov1.UpdateTodoListRequest) (*todov1.TodoList, error) {
+	params, err := toTodoListUpdateParams(req)
+	if err != nil {
+		return nil, err
+	}
+	return h.updateTodoList(ctx, req.GetId(), params)
+}
+
+func (h *TodoListHandler) ArchiveTodoList(ctx context.Context, req *todov1.ArchiveTodoListRequest) (*todov1.TodoList, error) {
+	archived := true
+	return h.updateTodoList(ctx, req.GetId(), todo_usecase.TodoListUpdateParams{Archived: &archived})
+}
+
+func (h *TodoListHandler) UnarchiveTodoList(ctx context.Context, req *todov1.ArchiveTodoListRequest) (*todov1.TodoList, error) {
+	archived := false
+	return h.updateTodoList(ctx, req.GetId(), todo_usecase.TodoListUpdateParams{Archived: &archived})
+}
+
+// updateTodoList は UpdateTodoList / ArchiveTodoList / UnarchiveTodoList 共通の処理
+func (h *TodoListHandler) updateTodoList(ctx context.Context, id int64, p todo_usecase.TodoListUpdateParams) (*todov1.TodoList, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoWriteTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := h.uc.UpdateTodoList(ctx, ownerID, id, p)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return toProtoTodoList(l), nil
+}
+
+func (h *TodoListHandler) DeleteTodoList(ctx context.Context, req *todov1.DeleteTodoListRequest) (*todov1.DeleteTodoListResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoWriteTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.uc.DeleteTodoList(ctx, ownerID, req.GetId()); err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &todov1.DeleteTodoListResponse{Ok: true}, nil
+}
+
+func (h *TodoHandler) MoveTodo(ctx context.Context, req *todov1.MoveTodoRequest) (*todov1.Todo, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTodoWriteTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := expectedVersion(ctx, req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := h.uc.MoveTodo(ctx, ownerID, req.GetId(), todo_usecase.MoveParams{
+		ListID:          req.GetListId(),
+		BeforeID:        req.GetBeforeId(),
+		AfterID:         req.GetAfterId(),
+		ExpectedVersion: version,
+	})
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	setETagHeader(ctx, t.Version)
+	return toProtoTodo(t), nil
+}
+
+// toTodoListUpdateParams は UpdateTodo と同じ規則で update_mask を解釈する。
+// アーカイブの状態は ArchiveTodoList / UnarchiveTodoList で変えるので、archived_at は出力専用として無視する。
+func toTodoListUpdateParams(req *todov1.UpdateTodoListRequest) (todo_usecase.TodoListUpdateParams, error) {
+	src := req.GetTodoList()
+
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = []string{"*"}
+	}
+
+	var p todo_usecase.TodoListUpdateParams
+	for _, path := range paths {
+		switch path {
+		case "*", "name":
+			name := src.GetName()
+			p.Name = &name
+		case "id", "archived_at", "created_at", "updated_at":
+			// 出力専用
+		default:
+			return p, status.Errorf(codes.InvalidArgument, "invalid update_mask path: %q", path)
+		}
+	}
+	return p, nil
+}
+
+func toProtoTodoList(l *domain_todo.TodoList) *todov1.TodoList {
+	return &todov1.TodoList{
+		Id:         l.ID,
+		Name:       l.Name,
+		ArchivedAt: toTimestamp(l.ArchivedAt),
+		CreatedAt:  toTimestamp(l.CreatedAt),
+		UpdatedAt:  toTimestamp(l.UpdatedAt),
+	}
+}
@@ -0,0 +1,190 @@
+package grpcadapter
+
+import (
+	"bufio"
+	"context"
+	"io"
+
+	todov1 "github.com/hijjiri/grpc-echo/api/todo/v1"
+	todo_usecase "github.com/hijjiri/grpc-echo/internal/usecase/todo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportChunkSize は ExportTodosResponse 1 つに載せるおおよそのバイト数
+const exportChunkSize = 32 * 1024
+
+// --- Import / Export ---
+
+// ExportTodos は usecase が書き出すファイルを exportChunkSize ごとに区切って流す（sendFile）。
+func (h *TodoHandler) ExportTodos(req *todov1.ExportTodosRequest, stream todov1.TodoService_ExportTodosServer) error {
+	ctx, cancel := context.WithTimeout(stream.Context(), defaultTodoTransferTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	format, err := toTransferFormat(req.GetFormat())
+	if err != nil {
+		return err
+	}
+
+	return sendFile(stream, func(w io.Writer) error {
+		_, err := h.uc.Export(ctx, ownerID, format, w)
+		return err
+	})
+}
+
+// exportSender は ExportTodosResponse でファイルを流す stream（ExportTodos / GetCalendarFeed）
+type exportSender interface {
+	Send(*todov1.ExportTodosResponse) error
+}
+
+// sendFile は write が書くファイルを exportChunkSize ごとに区切って stream に流す。
+func sendFile(stream exportSender, write func(w io.Writer) error) error {
+	sender := &chunkSender{stream: stream}
+	w := bufio.NewWriterSize(sender, exportChunkSize)
+	err := write(w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if sender.err != nil {
+		// transport error（切断等）
+		return sender.err
+	}
+	if err != nil {
+		return toGRPCError(err)
+	}
+	return nil
+}
+
+// chunkSender は書かれたバイト列をそのまま 1 つの ExportTodosResponse として送る io.Writer。
+type chunkSender struct {
+	stream exportSender
+	err    error // 最初の送信エラー
+}
+
+func (s *chunkSender) Write(p []byte) (int, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+	// Send が戻った後も p は書き手に再利用されるので、コピーしてから渡す
+	if s.err = s.stream.Send(&todov1.ExportTodosResponse{Data: append([]byte(nil), p...)}); s.err != nil {
+		return 0, s.err
+	}
+	return len(p), nil
+}
+
+// ImportTodos は最初のメッセージで options を受け取り、残りの data を繋いだものを 1 つのファイルとして usecase に読ませる。
+func (h *TodoHandler) ImportTodos(stream todov1.TodoService_ImportTodosServer) error {
+	ctx, cancel := context.WithTimeout(stream.Context(), defaultTodoTransferTimeout)
+	defer cancel()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "options must be sent first")
+	}
+	if err != nil {
+		return err
+	}
+	opts := first.GetOptions()
+	if opts == nil {
+		return status.Error(codes.InvalidArgument, "options must be sent first")
+	}
+	format, err := toTransferFormat(opts.GetFormat())
+	if err != nil {
+		return err
+	}
+
+	r := &importStreamReader{stream: stream}
+	res, err := h.uc.Import(ctx, ownerID, r, todo_usecase.ImportParams{Format: format, DryRun: opts.GetDryRun()})
+	if r.err != nil {
+		// 受信エラー（切断等）・options の再送
+		return r.err
+	}
+	if err != nil {
+		st := status.Convert(toGRPCError(err))
+		if res != nil && res.Created > 0 {
+			// それまでの Tx で作成した分は残っているので、件数を知らせる
+			return status.Errorf(st.Code(), "%s (%d todos were created before the failure)", st.Message(), res.Created)
+		}
+		return st.Err()
+	}
+	return stream.SendAndClose(toProtoImportResult(res))
+}
+
+// importStreamReader は ImportTodosRequest の data を順に繋いで読む io.Reader。
+type importStreamReader struct {
+	stream todov1.TodoService_ImportTodosServer
+	buf    []byte
+	err    error // io.EOF 以外で読めなくなった理由（gRPC のステータスエラー）
+}
+
+func (r *importStreamReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		req, err := r.stream.Recv()
+		if err == io.EOF {
+			return 0, io.EOF
+		}
+		if err != nil {
+			r.err = err
+			return 0, err
+		}
+		if req.GetOptions() != nil {
+			r.err = status.Error(codes.InvalidArgument, "options must be sent only once")
+			return 0, r.err
+		}
+		r.buf = req.GetData()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func toTransferFormat(f todov1.TodoFormat) (todo_usecase.TransferFormat, error) {
+	switch f {
+	case todov1.TodoFormat_TODO_FORMAT_JSON_LINES:
+		return todo_usecase.FormatJSONLines, nil
+	case todov1.TodoFormat_TODO_FORMAT_CSV:
+		return todo_usecase.FormatCSV, nil
+	case todov1.TodoFormat_TODO_FORMAT_MARKDOWN:
+		return todo_usecase.FormatMarkdown, nil
+	case todov1.TodoFormat_TODO_FORMAT_ICALENDAR:
+		return todo_usecase.FormatICalendar, nil
+	default:
+		return 0, toGRPCError(todo_usecase.ErrInvalidFormat)
+	}
+}
+
+func toProtoImportResult(res *todo_usecase.ImportResult) *todov1.ImportTodosResponse {
+	pr := &todov1.ImportTodosResponse{
+		DryRun:       res.DryRun,
+		CreatedCount: int32(res.Created),
+		ErrorCount:   int32(res.ErrorCount),
+	}
+	for _, e := range res.RowErrors {
+		pr.Errors = append(pr.Errors, &todov1.ImportRowError{Line: int32(e.Line), Message: importRowMessage(e.Err)})
+	}
+	for _, t := range res.Preview {
+		pr.Preview = append(pr.Preview, toProtoTodo(t))
+	}
+	return pr
+}
+
+// importRowMessage は行のエラーの説明。ドメインのエラーは 1 件ずつの作成と同じ文言にし、
+// それ以外（ファイル形式ごとの項目の書式の誤り）はエラーの文言をそのまま使う。
+func importRowMessage(err error) string {
+	if st := status.Convert(toGRPCError(err)); st.Code() != codes.Internal {
+		return st.Message()
+	}
+	return err.Error()
+}
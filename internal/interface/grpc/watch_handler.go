@@ -0,0 +1,94 @@
+package grpcadapter
+
+import (
+	"context"
+
+	todov1 "github.com/hijjiri/grpc-echo/api/todo/v1"
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	todo_usecase "github.com/hijjiri/grpc-echo/internal/usecase/todo"
+)
+
+// --- Watch ---
+
+// WatchTodos は購読を始めてから一覧（スナップショット）を送り、その後は変更を流し続ける。
+// 購読を先に始めるので、一覧を読んでいる間の変更も取りこぼさない（一覧と重なることはある）。
+func (h *TodoHandler) WatchTodos(req *todov1.WatchTodosRequest, stream todov1.TodoService_WatchTodosServer) error {
+	ctx := stream.Context()
+
+	ownerID, err := ownerIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	w, err := h.uc.Watch(ctx, ownerID, req.GetResumeToken())
+	if err != nil {
+		return toGRPCError(err)
+	}
+	defer w.Close()
+
+	if !w.Resumed() {
+		if err := h.sendSnapshot(ctx, ownerID, stream); err != nil {
+			return err
+		}
+		if err := stream.Send(&todov1.TodoEvent{
+			Type:        todov1.TodoEventType_TODO_EVENT_TYPE_SNAPSHOT_END,
+			ResumeToken: w.ResumeToken(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for {
+		ev, err := w.Next(ctx)
+		if err != nil {
+			return toGRPCError(err)
+		}
+		if err := stream.Send(&todov1.TodoEvent{
+			Type:        toProtoEventType(ev.Type),
+			Todo:        toProtoTodo(ev.Todo),
+			ResumeToken: ev.ResumeToken,
+		}); err != nil {
+			// transport error（切断等）
+			return err
+		}
+	}
+}
+
+// sendSnapshot はゴミ箱の外の Todo を全件、SNAPSHOT として送る（ListTodosStream と同じくページ単位で読む）。
+func (h *TodoHandler) sendSnapshot(ctx context.Context, ownerID string, stream todov1.TodoService_WatchTodosServer) error {
+	listCtx, cancel := context.WithTimeout(ctx, defaultTodoStreamTimeout)
+	defer cancel()
+
+	params := todo_usecase.ListParams{PageSize: todo_usecase.MaxPageSize}
+	for {
+		res, err := h.uc.List(listCtx, ownerID, params)
+		if err != nil {
+			return toGRPCError(err)
+		}
+		for _, t := range res.Todos {
+			if err := stream.Send(&todov1.TodoEvent{
+				Type: todov1.TodoEventType_TODO_EVENT_TYPE_SNAPSHOT,
+				Todo: toProtoTodo(t),
+			}); err != nil {
+				return err
+			}
+		}
+		if res.NextPageToken == "" {
+			return nil
+		}
+		params.PageToken = res.NextPageToken
+	}
+}
+
+func toProtoEventType(t domain_todo.EventType) todov1.TodoEventType {
+	switch t {
+	case domain_todo.EventTypeCreated:
+		return todov1.TodoEventType_TODO_EVENT_TYPE_CREATED
+	case domain_todo.EventTypeUpdated:
+		return todov1.TodoEventType_TODO_EVENT_TYPE_UPDATED
+	case domain_todo.EventTypeDeleted:
+		return todov1.TodoEventType_TODO_EVENT_TYPE_DELETED
+	default:
+		return todov1.TodoEventType_TODO_EVENT_TYPE_UNSPECIFIED
+	}
+}
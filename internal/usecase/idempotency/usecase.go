@@ -0,0 +1,211 @@
+package idempotency_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	domain_idempotency "github.com/hijjiri/grpc-echo/internal/domain/idempotency"
+	"go.uber.org/zap"
+)
+
+// 冪等キーは「変更と同じ Tx でキーの行を追加し、処理が終わったら結果を書き足す」ことで、
+// コミットの結果が分からない（TxManager は commit をリトライしない）場合でも二重に実行しないようにする。
+//   - キーの行が無い: 変更はコミットされていないので、もう一度実行してよい
+//   - 行があり結果も記録済み: 保存した結果をそのまま返す
+//   - 行はあるが結果が無い: 処理中か、コミットした後に結果を記録できなかった（ErrInProgress）
+// Tx を使わない処理では、成功した後にキーと結果をまとめて記録する。
+
+// DefaultTTL はキーを保持する期間の既定値
+const DefaultTTL = 24 * time.Hour
+
+// purgeBatchSize は PurgeExpired が 1 回の DELETE で消す最大件数
+const purgeBatchSize = 500
+
+var (
+	ErrInvalidKey = domain_idempotency.ErrInvalidKey
+	ErrKeyReused  = errors.New("idempotency key was used for a different request")
+	ErrInProgress = errors.New("request with this idempotency key is in progress or its result was not recorded")
+)
+
+// TxManager は todo_usecase.TxManager と同じ形（NewTxManager で包む）。
+type TxManager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type Usecase interface {
+	// Begin はキーを確認する。保存済みの結果があればそれを返す（claim は nil）。
+	// 無ければ Claim を返すので、WithClaim した ctx で処理を行い、終わったら Finish を呼ぶこと。
+	// 別のメソッド・リクエストで使われたキーは ErrKeyReused、結果の無いキーは ErrInProgress。
+	Begin(ctx context.Context, ownerID, key, method string, requestHash []byte) (replay []byte, claim *Claim, err error)
+	// Finish は処理の結果（response）を記録する。succeeded が false で、
+	// キーの行を追加した Tx がコミットされていなければ何もしない（同じキーでやり直せる）。
+	Finish(ctx context.Context, claim *Claim, response []byte, succeeded bool) error
+	// PurgeExpired は期限切れのキーを削除する。バックグラウンド用。戻り値は削除した件数。
+	PurgeExpired(ctx context.Context) (int64, error)
+}
+
+// Claim は 1 リクエスト分のキーの予約。
+type Claim struct {
+	record     domain_idempotency.Record
+	inserted   bool // キーの行を追加した Tx がコミットされた
+	conflicted bool // 同じキーの行が先にコミットされていた（同じキーのリクエストが同時に来た）
+}
+
+// Conflicted は、同時に来た同じキーのリクエストに先を越されて処理が失敗したかどうか。
+func (c *Claim) Conflicted() bool {
+	return c.conflicted
+}
+
+type claimKey struct{}
+
+// WithClaim は c を ctx に載せる。この ctx で NewTxManager の WithinTx を呼ぶと、キーの行も同じ Tx で追加する。
+func WithClaim(ctx context.Context, c *Claim) context.Context {
+	return context.WithValue(ctx, claimKey{}, c)
+}
+
+func claimFromContext(ctx context.Context) (*Claim, bool) {
+	c, ok := ctx.Value(claimKey{}).(*Claim)
+	return c, ok && c != nil
+}
+
+type usecase struct {
+	repo   domain_idempotency.Repository
+	ttl    time.Duration
+	logger *zap.Logger
+}
+
+// New は冪等キーの Usecase を構築する。ttl が 0 以下なら DefaultTTL。
+func New(repo domain_idempotency.Repository, ttl time.Duration, logger *zap.Logger) Usecase {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &usecase{repo: repo, ttl: ttl, logger: logger}
+}
+
+func (u *usecase) Begin(ctx context.Context, ownerID, key, method string, requestHash []byte) ([]byte, *Claim, error) {
+	if err := domain_idempotency.ValidateKey(key); err != nil {
+		return nil, nil, ErrInvalidKey
+	}
+
+	now := time.Now()
+	rec, err := u.repo.Get(ctx, ownerID, key, now)
+	switch {
+	case errors.Is(err, domain_idempotency.ErrNotFound):
+		return nil, &Claim{record: domain_idempotency.Record{
+			OwnerID:     ownerID,
+			Key:         key,
+			Method:      method,
+			RequestHash: requestHash,
+			ExpiresAt:   now.Add(u.ttl),
+		}}, nil
+	case err != nil:
+		u.logger.Error("failed to get idempotency key",
+			zap.String("owner_id", ownerID),
+			zap.String("method", method),
+			zap.Error(err),
+		)
+		return nil, nil, fmt.Errorf("get idempotency key: %w", err)
+	case !rec.Matches(method, requestHash):
+		return nil, nil, ErrKeyReused
+	case !rec.Completed():
+		return nil, nil, ErrInProgress
+	}
+
+	u.logger.Info("idempotent request replayed (usecase)",
+		zap.String("owner_id", ownerID),
+		zap.String("method", method),
+		zap.Time("created_at", rec.CreatedAt),
+	)
+	return rec.Response, nil, nil
+}
+
+func (u *usecase) Finish(ctx context.Context, claim *Claim, response []byte, succeeded bool) error {
+	var err error
+	switch {
+	case claim.inserted:
+		err = u.repo.SaveResponse(ctx, claim.record.OwnerID, claim.record.Key, response)
+	case succeeded:
+		// Tx を使わない処理。キーと結果をまとめて記録する（同時に来た同じキーに先を越されたら、そちらを残す）
+		rec := claim.record
+		rec.Response = response
+		if err = u.repo.Insert(ctx, &rec); errors.Is(err, domain_idempotency.ErrKeyInUse) {
+			err = nil
+		}
+	default:
+		return nil
+	}
+	if err != nil {
+		u.logger.Error("failed to save idempotent response",
+			zap.String("owner_id", claim.record.OwnerID),
+			zap.String("method", claim.record.Method),
+			zap.Error(err),
+		)
+		return fmt.Errorf("save idempotent response: %w", err)
+	}
+	return nil
+}
+
+func (u *usecase) PurgeExpired(ctx context.Context) (int64, error) {
+	now := time.Now()
+
+	var total int64
+	for {
+		n, err := u.repo.DeleteExpired(ctx, now, purgeBatchSize)
+		if err != nil {
+			u.logger.Error("failed to purge expired idempotency keys",
+				zap.Int64("purged_so_far", total),
+				zap.Error(err),
+			)
+			return total, fmt.Errorf("purge expired idempotency keys: %w", err)
+		}
+		total += n
+		if n < purgeBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		u.logger.Info("expired idempotency keys purged (usecase)", zap.Int64("count", total))
+	}
+	return total, nil
+}
+
+// txManager は、ctx に Claim が載っていれば、最初にコミットする Tx の中でキーの行を追加する TxManager。
+type txManager struct {
+	inner TxManager
+	repo  domain_idempotency.Repository
+}
+
+// NewTxManager は inner を包んで、変更とキーの行を同じ Tx でコミットするようにする。
+// キーの行は、同じキーのリクエストが同時に来ても片方しか追加できない（もう片方の Tx は失敗する）。
+func NewTxManager(inner TxManager, repo domain_idempotency.Repository) TxManager {
+	return &txManager{inner: inner, repo: repo}
+}
+
+func (m *txManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	c, ok := claimFromContext(ctx)
+	if !ok || c.inserted {
+		return m.inner.WithinTx(ctx, fn)
+	}
+
+	err := m.inner.WithinTx(ctx, func(txCtx context.Context) error {
+		// 先に追加しておくと、同じキーの Tx はここで待たされ、先の Tx がコミットされたら失敗する
+		rec := c.record
+		if err := m.repo.Insert(txCtx, &rec); err != nil {
+			if errors.Is(err, domain_idempotency.ErrKeyInUse) {
+				c.conflicted = true
+			}
+			return err
+		}
+		return fn(txCtx)
+	})
+	if err == nil {
+		c.inserted = true
+	}
+	return err
+}
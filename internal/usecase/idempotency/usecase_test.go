@@ -0,0 +1,179 @@
+package idempotency_usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domain_idempotency "github.com/hijjiri/grpc-echo/internal/domain/idempotency"
+	"go.uber.org/zap"
+)
+
+// memRepo はメモリ上の Repository。Tx の中（fakeTx）で追加した行は、コミットされるまで見えない。
+type memRepo struct {
+	records map[string]domain_idempotency.Record
+}
+
+func newMemRepo() *memRepo {
+	return &memRepo{records: map[string]domain_idempotency.Record{}}
+}
+
+type pendingKey struct{}
+
+func (m *memRepo) Get(ctx context.Context, ownerID, key string, now time.Time) (*domain_idempotency.Record, error) {
+	r, ok := m.records[ownerID+"/"+key]
+	if !ok || !r.ExpiresAt.After(now) {
+		return nil, domain_idempotency.ErrNotFound
+	}
+	return &r, nil
+}
+
+func (m *memRepo) Insert(ctx context.Context, r *domain_idempotency.Record) error {
+	k := r.OwnerID + "/" + r.Key
+	if cur, ok := m.records[k]; ok && cur.ExpiresAt.After(time.Now()) {
+		return domain_idempotency.ErrKeyInUse
+	}
+	if pending, ok := ctx.Value(pendingKey{}).(*[]domain_idempotency.Record); ok {
+		*pending = append(*pending, *r)
+		return nil
+	}
+	m.records[k] = *r
+	return nil
+}
+
+func (m *memRepo) SaveResponse(ctx context.Context, ownerID, key string, response []byte) error {
+	r, ok := m.records[ownerID+"/"+key]
+	if !ok {
+		return domain_idempotency.ErrNotFound
+	}
+	r.Response = response
+	m.records[ownerID+"/"+key] = r
+	return nil
+}
+
+func (m *memRepo) DeleteExpired(ctx context.Context, before time.Time, limit int) (int64, error) {
+	var n int64
+	for k, r := range m.records {
+		if !r.ExpiresAt.After(before) && n < int64(limit) {
+			delete(m.records, k)
+			n++
+		}
+	}
+	return n, nil
+}
+
+// fakeTx は fn が成功したときだけ、Tx の中で追加した行を repo に反映する TxManager
+type fakeTx struct{ repo *memRepo }
+
+func (f fakeTx) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	var pending []domain_idempotency.Record
+	if err := fn(context.WithValue(ctx, pendingKey{}, &pending)); err != nil {
+		return err
+	}
+	for _, r := range pending {
+		f.repo.records[r.OwnerID+"/"+r.Key] = r
+	}
+	return nil
+}
+
+func TestUsecase_ReplaysRecordedResponse(t *testing.T) {
+	t.Parallel()
+
+	repo := newMemRepo()
+	uc := New(repo, time.Hour, zap.NewNop())
+	tx := NewTxManager(fakeTx{repo}, repo)
+	ctx := context.Background()
+	hash := []byte("hash")
+
+	replay, claim, err := uc.Begin(ctx, "user-1", "key-1", "/m", hash)
+	if err != nil || replay != nil || claim == nil {
+		t.Fatalf("expected a claim, got replay=%v claim=%v err=%v", replay, claim, err)
+	}
+
+	// 処理中（結果を記録する前）の再送は ABORTED 相当
+	if err := tx.WithinTx(WithClaim(ctx, claim), func(ctx context.Context) error { return nil }); err != nil {
+		t.Fatalf("WithinTx returned error: %v", err)
+	}
+	if _, _, err := uc.Begin(ctx, "user-1", "key-1", "/m", hash); !errors.Is(err, ErrInProgress) {
+		t.Errorf("expected ErrInProgress, got %v", err)
+	}
+
+	if err := uc.Finish(ctx, claim, []byte("resp"), true); err != nil {
+		t.Fatalf("Finish returned error: %v", err)
+	}
+	replay, claim, err = uc.Begin(ctx, "user-1", "key-1", "/m", hash)
+	if err != nil || claim != nil || string(replay) != "resp" {
+		t.Errorf("expected replay of resp, got replay=%q claim=%v err=%v", replay, claim, err)
+	}
+
+	// 別のリクエストには使えない。所有者が違えば別のキー
+	if _, _, err := uc.Begin(ctx, "user-1", "key-1", "/m", []byte("other")); !errors.Is(err, ErrKeyReused) {
+		t.Errorf("expected ErrKeyReused, got %v", err)
+	}
+	if _, claim, err := uc.Begin(ctx, "user-2", "key-1", "/m", hash); err != nil || claim == nil {
+		t.Errorf("expected a claim for another owner, got claim=%v err=%v", claim, err)
+	}
+}
+
+func TestUsecase_RolledBackChangeCanBeRetried(t *testing.T) {
+	t.Parallel()
+
+	repo := newMemRepo()
+	uc := New(repo, time.Hour, zap.NewNop())
+	tx := NewTxManager(fakeTx{repo}, repo)
+	ctx := context.Background()
+
+	_, claim, err := uc.Begin(ctx, "user-1", "key-1", "/m", []byte("hash"))
+	if err != nil {
+		t.Fatalf("Begin returned error: %v", err)
+	}
+	fail := errors.New("boom")
+	if err := tx.WithinTx(WithClaim(ctx, claim), func(ctx context.Context) error { return fail }); err != fail {
+		t.Fatalf("expected boom, got %v", err)
+	}
+	if err := uc.Finish(ctx, claim, []byte("error"), false); err != nil {
+		t.Fatalf("Finish returned error: %v", err)
+	}
+
+	// キーの行も一緒にロールバックされているので、やり直せる
+	if _, claim, err := uc.Begin(ctx, "user-1", "key-1", "/m", []byte("hash")); err != nil || claim == nil {
+		t.Errorf("expected a claim, got claim=%v err=%v", claim, err)
+	}
+}
+
+func TestUsecase_ConcurrentClaimConflicts(t *testing.T) {
+	t.Parallel()
+
+	repo := newMemRepo()
+	uc := New(repo, time.Hour, zap.NewNop())
+	tx := NewTxManager(fakeTx{repo}, repo)
+	ctx := context.Background()
+
+	// 同じキーで同時に Begin し、先にコミットした方が勝つ
+	_, first, _ := uc.Begin(ctx, "user-1", "key-1", "/m", []byte("hash"))
+	_, second, _ := uc.Begin(ctx, "user-1", "key-1", "/m", []byte("hash"))
+
+	if err := tx.WithinTx(WithClaim(ctx, first), func(ctx context.Context) error { return nil }); err != nil {
+		t.Fatalf("WithinTx returned error: %v", err)
+	}
+	called := false
+	err := tx.WithinTx(WithClaim(ctx, second), func(ctx context.Context) error {
+		called = true
+		return nil
+	})
+	if !errors.Is(err, domain_idempotency.ErrKeyInUse) || !second.Conflicted() || called {
+		t.Errorf("expected conflict before running fn, got err=%v conflicted=%v called=%v", err, second.Conflicted(), called)
+	}
+}
+
+func TestUsecase_InvalidKey(t *testing.T) {
+	t.Parallel()
+
+	uc := New(newMemRepo(), time.Hour, zap.NewNop())
+	for _, key := range []string{"", "改行\n", string(make([]byte, domain_idempotency.MaxKeyLength+1))} {
+		if _, _, err := uc.Begin(context.Background(), "user-1", key, "/m", nil); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("key %q: expected ErrInvalidKey, got %v", key, err)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package todo_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"go.uber.org/zap"
+)
+
+// 担当者の割り当ては Todo の中身の変更として modify に載せる（RoleEditor で共有された人もできる）。
+// 担当者にできるのは Todo の所有者とコラボレーターだけ（checkAssignee）。
+
+var (
+	ErrInvalidAssignee  = domain_todo.ErrInvalidAssignee
+	ErrAssigneeNotFound = domain_todo.ErrAssigneeNotFound
+)
+
+// errNoUserDirectory は WithUserDirectory を渡さずに New したときのエラー（設定ミスなので Internal 扱い）
+var errNoUserDirectory = errors.New("user directory is not configured")
+
+func (u *usecase) AssignTodo(ctx context.Context, ownerID string, id int64, assigneeID string, expectedVersion int64) (*domain_todo.Todo, error) {
+	if err := validateTodoRef(ownerID, id); err != nil {
+		return nil, err
+	}
+	if err := domain_todo.ValidateOwnerID(assigneeID); err != nil {
+		return nil, ErrInvalidAssignee
+	}
+	if u.userDirectory == nil {
+		return nil, errNoUserDirectory
+	}
+	// 担当者の確認は Todo を変えてよいと分かってから行う（見えない Todo で他人の存在を探れないように）
+	return u.modify(ctx, "assign", domain_todo.RoleEditor, ownerID, id, expectedVersion, func(txCtx context.Context, t *domain_todo.Todo) ([]int64, error) {
+		if err := u.checkAssignee(txCtx, t, assigneeID); err != nil {
+			return nil, err
+		}
+		return nil, t.Assign(assigneeID)
+	})
+}
+
+func (u *usecase) UnassignTodo(ctx context.Context, ownerID string, id int64, expectedVersion int64) (*domain_todo.Todo, error) {
+	if err := validateTodoRef(ownerID, id); err != nil {
+		return nil, err
+	}
+	return u.modify(ctx, "unassign", domain_todo.RoleEditor, ownerID, id, expectedVersion, func(_ context.Context, t *domain_todo.Todo) ([]int64, error) {
+		t.Unassign()
+		return nil, nil
+	})
+}
+
+// checkAssignee は assigneeID に t を割り当てられるかを確かめる。割り当てられるのは t の所有者と、
+// t（か t の入っているリスト）を共有された人のうち、ユーザーとしている人だけ（担当者が必ず t を見られるように）。
+// どの条件で外れても ErrAssigneeNotFound にして、共有していない人がいるかどうかは分からないようにする。
+func (u *usecase) checkAssignee(ctx context.Context, t *domain_todo.Todo, assigneeID string) error {
+	if assigneeID != t.OwnerID {
+		if u.grantRepo == nil {
+			return ErrAssigneeNotFound
+		}
+		_, role, err := u.grantRepo.TodoAccess(ctx, assigneeID, t.ID)
+		if err != nil {
+			return err
+		}
+		if role == domain_todo.RoleNone {
+			return ErrAssigneeNotFound
+		}
+	}
+
+	ok, err := u.userDirectory.UserExists(ctx, assigneeID)
+	if err != nil {
+		u.logger.Error("failed to look up assignee",
+			zap.String("owner_id", t.OwnerID),
+			zap.String("assignee_id", assigneeID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("look up assignee: %w", err)
+	}
+	if !ok {
+		return ErrAssigneeNotFound
+	}
+	return nil
+}
@@ -0,0 +1,309 @@
+package todo_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// 一括操作（BatchCreate / BatchUpdate / BatchDelete）。
+// BatchAllOrNothing では全件を 1 つの Tx で処理し、1 件でも失敗したら何も変えずに *BatchItemError を返す。
+// BatchBestEffort では失敗した項目だけを飛ばし、項目ごとの結果（BatchResult.Err）を返す。
+// どちらのモードでも、1 件ずつの操作と同じ履歴を残す。
+
+// BatchMode は一括操作で失敗した項目の扱い。
+type BatchMode int32
+
+const (
+	BatchAllOrNothing BatchMode = iota
+	BatchBestEffort
+)
+
+// MaxBatchSize は 1 回の一括操作で扱える最大件数（サーバ側で強制する）
+const MaxBatchSize = 500
+
+var (
+	ErrEmptyBatch    = errors.New("batch must not be empty")
+	ErrBatchTooLarge = fmt.Errorf("batch must have at most %d items", MaxBatchSize)
+)
+
+// BatchItemError は BatchAllOrNothing で失敗した項目（入力の添字）とその理由。
+// errors.Is / As は Err まで辿るので、理由ごとの扱いは 1 件ずつの操作と同じにできる。
+type BatchItemError struct {
+	Index int
+	Err   error
+}
+
+func (e *BatchItemError) Error() string {
+	return fmt.Sprintf("item %d: %v", e.Index, e.Err)
+}
+
+func (e *BatchItemError) Unwrap() error {
+	return e.Err
+}
+
+// BatchResult は一括操作の 1 項目の結果。入力と同じ順に返す。
+type BatchResult struct {
+	Todo *domain_todo.Todo // 作成・更新した Todo（失敗した項目と BatchDelete では nil）
+	Err  error             // BatchBestEffort で失敗した項目の理由
+}
+
+// BatchUpdateItem は BatchUpdate の 1 項目。
+type BatchUpdateItem struct {
+	ID     int64
+	Params UpdateParams
+}
+
+// BatchDeleteItem は BatchDelete の 1 項目。
+type BatchDeleteItem struct {
+	ID int64
+
+	// 0 以外なら、保存済みの version と一致するときだけ削除する（楽観ロック）
+	ExpectedVersion int64
+}
+
+func validateBatch(ownerID string, n int) error {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return ErrEmptyOwner
+	}
+	switch {
+	case n == 0:
+		return ErrEmptyBatch
+	case n > MaxBatchSize:
+		return ErrBatchTooLarge
+	}
+	return nil
+}
+
+// batchError は一括操作全体が失敗したときの戻り値を決める。
+// 項目の入力値のエラーならそのまま返し（ログは不要）、それ以外はログに残してラップする。
+func (u *usecase) batchError(op, ownerID string, err error, known []error) error {
+	var itemErr *BatchItemError
+	if errors.As(err, &itemErr) {
+		if inputErr := inputError(itemErr.Err, known); inputErr != nil {
+			return &BatchItemError{Index: itemErr.Index, Err: inputErr}
+		}
+	}
+	u.logger.Error("failed to batch "+op+" todos",
+		zap.String("owner_id", ownerID),
+		zap.Error(err),
+	)
+	return fmt.Errorf("batch %s todos: %w", op, err)
+}
+
+// BatchCreate は 1 つの Tx でまとめて作成する。並び順は入力の順で末尾に続ける。
+// 入力値のエラーとリストのエラー（存在しない・アーカイブ済み）は項目ごとの失敗になるが、
+// INSERT 自体が失敗した場合は BatchBestEffort でも全体が失敗する。
+func (u *usecase) BatchCreate(ctx context.Context, ownerID string, items []CreateParams, mode BatchMode) ([]BatchResult, error) {
+	if err := validateBatch(ownerID, len(items)); err != nil {
+		return nil, err
+	}
+
+	results := make([]BatchResult, len(items))
+	todos := make([]*domain_todo.Todo, len(items)) // 入力値のエラーになった項目は nil
+	for i, p := range items {
+		t, err := newTodo(ownerID, p)
+		if err == nil && p.ListID < 0 {
+			err = ErrInvalidID
+		}
+		if err != nil {
+			if mode == BatchAllOrNothing {
+				return nil, &BatchItemError{Index: i, Err: err}
+			}
+			results[i].Err = err
+			continue
+		}
+		todos[i] = t
+	}
+
+	err := u.tx.WithinTx(ctx, func(txCtx context.Context) error {
+		// デッドロックで Tx ごとやり直すことがあるので、Tx の中で決めるものは毎回作り直す
+		var (
+			batch   []*domain_todo.Todo
+			indexes []int
+		)
+		for i, t := range todos {
+			if t == nil {
+				continue
+			}
+			results[i] = BatchResult{}
+			if err := u.moveTo(txCtx, t, items[i].ListID); err != nil {
+				if mode == BatchAllOrNothing || inputError(err, createInputErrors) == nil {
+					return &BatchItemError{Index: i, Err: err}
+				}
+				results[i].Err = err
+				continue
+			}
+			batch = append(batch, t)
+			indexes = append(indexes, i)
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+
+		created, err := u.insertAtEnd(txCtx, ownerID, batch)
+		if err != nil {
+			return err
+		}
+		for k, t := range created {
+			results[indexes[k]].Todo = t
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, u.batchError("create", ownerID, err, createInputErrors)
+	}
+
+	n := countSucceeded(results)
+	todoCreatedCounter.Add(ctx, int64(n),
+		metric.WithAttributes(attribute.String("source", "grpc")),
+	)
+	u.logger.Info("todos batch created (usecase)",
+		zap.String("owner_id", ownerID),
+		zap.Int32("mode", int32(mode)),
+		zap.Int("count", len(items)),
+		zap.Int("succeeded", n),
+	)
+	return results, nil
+}
+
+// insertAtEnd は todos を入力の順で末尾に並べてまとめて作成し、作成の履歴を残す（Tx の中で呼ぶ）。
+func (u *usecase) insertAtEnd(txCtx context.Context, ownerID string, todos []*domain_todo.Todo) ([]*domain_todo.Todo, error) {
+	pos, err := u.writeRepo.LastPosition(txCtx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range todos {
+		pos = domain_todo.PositionAfter(pos)
+		t.Position = pos
+	}
+
+	created, err := u.writeRepo.CreateMany(txCtx, todos)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range created {
+		if err := u.recordHistory(txCtx, domain_todo.HistoryActionCreate, ownerID, t.ID, nil, t.Snapshot()); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
+// BatchUpdate は項目ごとに Update と同じ部分更新を行う。
+// BatchBestEffort では項目ごとに別の Tx で更新する（ある項目の失敗が他の項目を巻き戻さない）。
+func (u *usecase) BatchUpdate(ctx context.Context, ownerID string, items []BatchUpdateItem, mode BatchMode) ([]BatchResult, error) {
+	if err := validateBatch(ownerID, len(items)); err != nil {
+		return nil, err
+	}
+
+	results := make([]BatchResult, len(items))
+	params := make([]UpdateParams, len(items))
+	for i, it := range items {
+		err := domain_todo.ValidateID(it.ID)
+		if err != nil {
+			err = ErrInvalidID
+		} else {
+			params[i], err = it.Params.normalize()
+		}
+		if err != nil {
+			if mode == BatchAllOrNothing {
+				return nil, &BatchItemError{Index: i, Err: err}
+			}
+			results[i].Err = err
+		}
+	}
+
+	if mode == BatchBestEffort {
+		for i, it := range items {
+			if results[i].Err != nil {
+				continue
+			}
+			results[i].Todo, results[i].Err = u.modify(ctx, "update", domain_todo.RoleEditor, ownerID, it.ID, params[i].ExpectedVersion, params[i].mutation())
+		}
+	} else {
+		err := u.tx.WithinTx(ctx, func(txCtx context.Context) error {
+			for i, it := range items {
+				updated, err := u.modifyInTx(txCtx, domain_todo.RoleEditor, ownerID, it.ID, params[i].ExpectedVersion, params[i].mutation())
+				if err != nil {
+					return &BatchItemError{Index: i, Err: err}
+				}
+				results[i].Todo = updated
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, u.batchError("update", ownerID, err, modifyInputErrors)
+		}
+	}
+
+	u.logger.Info("todos batch updated (usecase)",
+		zap.String("owner_id", ownerID),
+		zap.Int32("mode", int32(mode)),
+		zap.Int("count", len(items)),
+		zap.Int("succeeded", countSucceeded(results)),
+	)
+	return results, nil
+}
+
+// BatchDelete は項目ごとに Delete と同じく論理削除する。
+// BatchBestEffort では項目ごとに別の Tx で削除する。
+func (u *usecase) BatchDelete(ctx context.Context, ownerID string, items []BatchDeleteItem, mode BatchMode) ([]BatchResult, error) {
+	if err := validateBatch(ownerID, len(items)); err != nil {
+		return nil, err
+	}
+
+	results := make([]BatchResult, len(items))
+	for i, it := range items {
+		if err := domain_todo.ValidateID(it.ID); err != nil {
+			if mode == BatchAllOrNothing {
+				return nil, &BatchItemError{Index: i, Err: ErrInvalidID}
+			}
+			results[i].Err = ErrInvalidID
+		}
+	}
+
+	if mode == BatchBestEffort {
+		for i, it := range items {
+			if results[i].Err != nil {
+				continue
+			}
+			results[i].Err = u.Delete(ctx, ownerID, it.ID, it.ExpectedVersion)
+		}
+	} else {
+		err := u.tx.WithinTx(ctx, func(txCtx context.Context) error {
+			for i, it := range items {
+				if err := u.deleteInTx(txCtx, ownerID, it.ID, it.ExpectedVersion); err != nil {
+					return &BatchItemError{Index: i, Err: err}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, u.batchError("delete", ownerID, err, deleteInputErrors)
+		}
+	}
+
+	u.logger.Info("todos batch deleted (usecase)",
+		zap.String("owner_id", ownerID),
+		zap.Int32("mode", int32(mode)),
+		zap.Int("count", len(items)),
+		zap.Int("succeeded", countSucceeded(results)),
+	)
+	return results, nil
+}
+
+func countSucceeded(results []BatchResult) int {
+	n := 0
+	for _, r := range results {
+		if r.Err == nil {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,99 @@
+package todo_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"go.uber.org/zap"
+)
+
+// カレンダーのフィード（iCalendar）。
+// フィードの中身は FormatICalendar の Export と同じ（期限のある Todo だけ）。
+// Bearer トークンの代わりに、所有者ごとのフィードトークン（domain_todo の feed_token.go）でも読めるようにする。
+
+var (
+	ErrFeedTokenNotFound = domain_todo.ErrFeedTokenNotFound
+	ErrInvalidFeedToken  = domain_todo.ErrInvalidFeedToken
+)
+
+// errNoFeedTokenRepository は WithFeedTokenRepository を渡さずに New したときのエラー（設定ミスなので Internal 扱い）
+var errNoFeedTokenRepository = errors.New("feed token repository is not configured")
+
+func (u *usecase) IssueFeedToken(ctx context.Context, ownerID string) (string, error) {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return "", ErrEmptyOwner
+	}
+	if u.feedTokenRepo == nil {
+		return "", errNoFeedTokenRepository
+	}
+
+	token, hash, err := domain_todo.NewFeedToken()
+	if err != nil {
+		return "", fmt.Errorf("issue feed token: %w", err)
+	}
+	if err := u.feedTokenRepo.SaveFeedToken(ctx, ownerID, hash); err != nil {
+		u.logger.Error("failed to save feed token",
+			zap.String("owner_id", ownerID),
+			zap.Error(err),
+		)
+		return "", fmt.Errorf("issue feed token: %w", err)
+	}
+
+	u.logger.Info("feed token issued (usecase)",
+		zap.String("owner_id", ownerID),
+	)
+	return token, nil
+}
+
+func (u *usecase) RevokeFeedToken(ctx context.Context, ownerID string) error {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return ErrEmptyOwner
+	}
+	if u.feedTokenRepo == nil {
+		return errNoFeedTokenRepository
+	}
+
+	ok, err := u.feedTokenRepo.DeleteFeedToken(ctx, ownerID)
+	if err != nil {
+		u.logger.Error("failed to delete feed token",
+			zap.String("owner_id", ownerID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("revoke feed token: %w", err)
+	}
+	if !ok {
+		return ErrFeedTokenNotFound
+	}
+
+	u.logger.Info("feed token revoked (usecase)",
+		zap.String("owner_id", ownerID),
+	)
+	return nil
+}
+
+// CalendarFeed はフィードトークンの持ち主の Todo を iCalendar で w に書く。
+// 形の違うトークン・発行されていない（無効にした）トークンはどちらも ErrInvalidFeedToken。
+func (u *usecase) CalendarFeed(ctx context.Context, feedToken string, w io.Writer) (int, error) {
+	if u.feedTokenRepo == nil {
+		return 0, errNoFeedTokenRepository
+	}
+	hash, err := domain_todo.HashFeedToken(feedToken)
+	if err != nil {
+		return 0, ErrInvalidFeedToken
+	}
+
+	ownerID, err := u.feedTokenRepo.FeedTokenOwner(ctx, hash)
+	if errors.Is(err, domain_todo.ErrFeedTokenNotFound) {
+		return 0, ErrInvalidFeedToken
+	}
+	if err != nil {
+		u.logger.Error("failed to look up feed token",
+			zap.Error(err),
+		)
+		return 0, fmt.Errorf("look up feed token: %w", err)
+	}
+	return u.Export(ctx, ownerID, FormatICalendar, w)
+}
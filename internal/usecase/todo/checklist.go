@@ -0,0 +1,67 @@
+package todo_usecase
+
+import (
+	"context"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+)
+
+// チェックリストの操作は Todo 集約の変更として modify に載せる。
+// 項目の変更で Todo の完了状態が変わることがあるので（ドメインのルール）、Todo の行も必ず保存し直す。
+
+func (u *usecase) AddChecklistItem(ctx context.Context, ownerID string, todoID int64, title string, expectedVersion int64) (*domain_todo.Todo, error) {
+	if err := validateTodoRef(ownerID, todoID); err != nil {
+		return nil, err
+	}
+	return u.modifyItems(ctx, "add checklist item to", ownerID, todoID, expectedVersion, func(t *domain_todo.Todo) error {
+		return t.AddItem(title)
+	})
+}
+
+func (u *usecase) ToggleChecklistItem(ctx context.Context, ownerID string, todoID, itemID int64, done bool, expectedVersion int64) (*domain_todo.Todo, error) {
+	if err := validateTodoRef(ownerID, todoID); err != nil {
+		return nil, err
+	}
+	return u.modifyItems(ctx, "toggle checklist item of", ownerID, todoID, expectedVersion, func(t *domain_todo.Todo) error {
+		return t.ToggleItem(itemID, done)
+	})
+}
+
+func (u *usecase) RemoveChecklistItem(ctx context.Context, ownerID string, todoID, itemID int64, expectedVersion int64) (*domain_todo.Todo, error) {
+	if err := validateTodoRef(ownerID, todoID); err != nil {
+		return nil, err
+	}
+	return u.modifyItems(ctx, "remove checklist item from", ownerID, todoID, expectedVersion, func(t *domain_todo.Todo) error {
+		return t.RemoveItem(itemID)
+	})
+}
+
+func (u *usecase) ReorderChecklistItems(ctx context.Context, ownerID string, todoID int64, itemIDs []int64, expectedVersion int64) (*domain_todo.Todo, error) {
+	if err := validateTodoRef(ownerID, todoID); err != nil {
+		return nil, err
+	}
+	return u.modifyItems(ctx, "reorder checklist items of", ownerID, todoID, expectedVersion, func(t *domain_todo.Todo) error {
+		return t.ReorderItems(itemIDs)
+	})
+}
+
+// modifyItems は change を適用したあと、チェックリストも同じ Tx で保存する。
+func (u *usecase) modifyItems(ctx context.Context, op, ownerID string, todoID, expectedVersion int64, change func(t *domain_todo.Todo) error) (*domain_todo.Todo, error) {
+	return u.modify(ctx, op, domain_todo.RoleEditor, ownerID, todoID, expectedVersion, func(txCtx context.Context, t *domain_todo.Todo) ([]int64, error) {
+		if err := change(t); err != nil {
+			return nil, err
+		}
+		return nil, u.writeRepo.SaveItems(txCtx, t)
+	})
+}
+
+// validateTodoRef は Todo 1 件を指す入力の共通チェック。
+func validateTodoRef(ownerID string, todoID int64) error {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return ErrEmptyOwner
+	}
+	if err := domain_todo.ValidateID(todoID); err != nil {
+		return ErrInvalidID
+	}
+	return nil
+}
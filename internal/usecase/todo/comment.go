@@ -0,0 +1,239 @@
+package todo_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"go.uber.org/zap"
+)
+
+// コメント。Todo が見える人（RoleViewer 以上）は読めて、RoleEditor 以上で共有された人と所有者が書ける。
+// 編集は書いた本人だけ、削除は本人と Todo の RoleOwner。ゴミ箱の中の Todo のコメントは読み書きできない（ErrNotFound）。
+// 役割を RoleViewer に下げられた人は、自分のコメントを消せるが直せない（直すのは新しく書くのと同じ扱い）。
+
+var (
+	ErrEmptyComment    = domain_todo.ErrEmptyComment
+	ErrCommentTooLong  = domain_todo.ErrCommentTooLong
+	ErrCommentNotFound = domain_todo.ErrCommentNotFound
+)
+
+// errNoCommentRepository は WithCommentRepository を渡さずに New したときのエラー（設定ミスなので Internal 扱い）
+var errNoCommentRepository = errors.New("comment repository is not configured")
+
+// CommentListParams は ListComments の入力（ページングだけ）。
+type CommentListParams struct {
+	PageSize  int    // 0 なら DefaultPageSize、MaxPageSize を超える値は MaxPageSize に丸める
+	PageToken string // 前回の CommentListResult.NextPageToken（空なら先頭から）。他の Todo のコメントには使えない
+}
+
+// CommentListResult は ListComments の結果。
+type CommentListResult struct {
+	Comments      []*domain_todo.Comment
+	NextPageToken string // 空なら最終ページ
+}
+
+// commentInputErrors はコメントの操作が入力値のエラーとしてそのまま返すもの。
+var commentInputErrors = []error{
+	ErrNotFound,
+	ErrPermissionDenied,
+	ErrEmptyComment,
+	ErrCommentTooLong,
+	ErrCommentNotFound,
+}
+
+// commentTodo はコメントの操作の共通チェック。callerID が Todo todoID に need 以上の役割を持ち、
+// Todo がゴミ箱に入っていなければ nil。
+func (u *usecase) commentTodo(ctx context.Context, callerID string, todoID int64, need domain_todo.Role) error {
+	todoOwner, err := u.authorizeTodo(ctx, callerID, todoID, need)
+	if err != nil {
+		return err
+	}
+	_, err = u.readRepo.Get(ctx, todoOwner, todoID)
+	return err
+}
+
+// commentError はコメントの操作が失敗したときの戻り値を決める（入力値のエラーはそのまま、それ以外はログに残してラップする）。
+func (u *usecase) commentError(op, ownerID string, todoID int64, err error) error {
+	if inputErr := inputError(err, commentInputErrors); inputErr != nil {
+		return inputErr
+	}
+	u.logger.Error("failed to "+op,
+		zap.String("owner_id", ownerID),
+		zap.Int64("todo_id", todoID),
+		zap.Error(err),
+	)
+	return fmt.Errorf("%s: %w", op, err)
+}
+
+func (u *usecase) AddComment(ctx context.Context, ownerID string, todoID int64, body string) (*domain_todo.Comment, error) {
+	if err := validateTodoRef(ownerID, todoID); err != nil {
+		return nil, err
+	}
+	if u.commentRepo == nil {
+		return nil, errNoCommentRepository
+	}
+	c, err := domain_todo.NewComment(todoID, ownerID, body)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.tx.WithinTx(ctx, func(txCtx context.Context) error {
+		if err := u.commentTodo(txCtx, ownerID, todoID, domain_todo.RoleEditor); err != nil {
+			return err
+		}
+		return u.commentRepo.CreateComment(txCtx, c)
+	})
+	if err != nil {
+		return nil, u.commentError("add comment", ownerID, todoID, err)
+	}
+
+	u.logger.Info("comment added (usecase)",
+		zap.String("owner_id", ownerID),
+		zap.Int64("todo_id", todoID),
+		zap.Int64("id", c.ID),
+	)
+	return c, nil
+}
+
+// EditComment は本文を変える。書いた本人だけができる（他人のコメントは ErrPermissionDenied）。
+// 本人でも、今 RoleEditor 以上でなければ ErrPermissionDenied。
+func (u *usecase) EditComment(ctx context.Context, ownerID string, todoID, id int64, body string) (*domain_todo.Comment, error) {
+	if err := validateTodoRef(ownerID, todoID); err != nil {
+		return nil, err
+	}
+	if err := domain_todo.ValidateID(id); err != nil {
+		return nil, ErrInvalidID
+	}
+	if u.commentRepo == nil {
+		return nil, errNoCommentRepository
+	}
+
+	var edited *domain_todo.Comment
+	err := u.tx.WithinTx(ctx, func(txCtx context.Context) error {
+		if err := u.commentTodo(txCtx, ownerID, todoID, domain_todo.RoleEditor); err != nil {
+			return err
+		}
+		c, err := u.commentRepo.GetComment(txCtx, todoID, id)
+		if err != nil {
+			return err
+		}
+		if err := c.Edit(ownerID, body); err != nil {
+			return err
+		}
+		if err := u.commentRepo.UpdateComment(txCtx, c); err != nil {
+			return err
+		}
+		edited = c
+		return nil
+	})
+	if err != nil {
+		return nil, u.commentError("edit comment", ownerID, todoID, err)
+	}
+
+	u.logger.Info("comment edited (usecase)",
+		zap.String("owner_id", ownerID),
+		zap.Int64("todo_id", todoID),
+		zap.Int64("id", id),
+	)
+	return edited, nil
+}
+
+// DeleteComment はコメントを削除する。書いた本人（Todo が見えていればよい）のほか、Todo の RoleOwner（所有者を含む）もできる。
+func (u *usecase) DeleteComment(ctx context.Context, ownerID string, todoID, id int64) error {
+	if err := validateTodoRef(ownerID, todoID); err != nil {
+		return err
+	}
+	if err := domain_todo.ValidateID(id); err != nil {
+		return ErrInvalidID
+	}
+	if u.commentRepo == nil {
+		return errNoCommentRepository
+	}
+
+	err := u.tx.WithinTx(ctx, func(txCtx context.Context) error {
+		if err := u.commentTodo(txCtx, ownerID, todoID, domain_todo.RoleViewer); err != nil {
+			return err
+		}
+		c, err := u.commentRepo.GetComment(txCtx, todoID, id)
+		if err != nil {
+			return err
+		}
+		if c.AuthorID != ownerID {
+			// 他人のコメントは Todo を管理する人だけが消せる
+			if _, err := u.authorizeTodo(txCtx, ownerID, todoID, domain_todo.RoleOwner); err != nil {
+				return err
+			}
+		}
+		ok, err := u.commentRepo.DeleteComment(txCtx, todoID, id)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ErrCommentNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return u.commentError("delete comment", ownerID, todoID, err)
+	}
+
+	u.logger.Info("comment deleted (usecase)",
+		zap.String("owner_id", ownerID),
+		zap.Int64("todo_id", todoID),
+		zap.Int64("id", id),
+	)
+	return nil
+}
+
+// ListComments は Todo のコメントを古い順に返す。
+func (u *usecase) ListComments(ctx context.Context, ownerID string, todoID int64, p CommentListParams) (*CommentListResult, error) {
+	if err := validateTodoRef(ownerID, todoID); err != nil {
+		return nil, err
+	}
+	if u.commentRepo == nil {
+		return nil, errNoCommentRepository
+	}
+	pageSize, err := normalizePageSize(p.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// ページトークンは Todo ごとに区別する（List・履歴のトークンとも混ざらない）
+	fingerprint := "comments:" + strconv.FormatInt(todoID, 10)
+	q := domain_todo.CommentQuery{
+		// 1 件多めに取って「次ページがあるか」を判定する
+		Limit: pageSize + 1,
+	}
+	if p.PageToken != "" {
+		cur, err := u.pageToken.decode(p.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		if cur.OwnerID != ownerID || cur.Query != fingerprint {
+			return nil, ErrInvalidPageToken
+		}
+		q.AfterID = cur.AfterID
+	}
+
+	if err := u.commentTodo(ctx, ownerID, todoID, domain_todo.RoleViewer); err != nil {
+		return nil, u.commentError("list comments", ownerID, todoID, err)
+	}
+	comments, err := u.commentRepo.ListComments(ctx, todoID, q)
+	if err != nil {
+		return nil, u.commentError("list comments", ownerID, todoID, err)
+	}
+
+	res := &CommentListResult{Comments: comments}
+	if len(comments) > pageSize {
+		res.Comments = comments[:pageSize]
+		last := res.Comments[pageSize-1]
+		res.NextPageToken, err = u.pageToken.encode(pageCursor{OwnerID: ownerID, Query: fingerprint, AfterID: last.ID})
+		if err != nil {
+			return nil, fmt.Errorf("encode page token: %w", err)
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,135 @@
+package todo_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"go.uber.org/zap"
+)
+
+// 変更履歴は、Todo を変える操作（作成・更新・削除・復元・物理削除）ごとに 1 行、
+// その変更と同じ Tx で追記する。履歴を書けなければ変更ごとロールバックする。
+// owner を跨いで消す PurgeExpired（バックグラウンドの purger）は操作者がいないので、Actor を domain_todo.SystemActor にする。
+
+// HistoryListParams は ListTodoHistory の入力（ページングだけ）。
+type HistoryListParams struct {
+	PageSize  int    // 0 なら DefaultPageSize、MaxPageSize を超える値は MaxPageSize に丸める
+	PageToken string // 前回の HistoryListResult.NextPageToken（空なら先頭から）。他の Todo の履歴には使えない
+}
+
+// HistoryListResult は ListTodoHistory の結果。
+type HistoryListResult struct {
+	Entries       []*domain_todo.HistoryEntry
+	NextPageToken string // 空なら最終ページ
+}
+
+// auditContext は ctx から操作者・リクエスト ID を取り出す関数（WithAuditContext）。
+type auditContext struct {
+	actor     func(ctx context.Context) (string, bool)
+	requestID func(ctx context.Context) (string, bool)
+}
+
+// actorOf は操作者を返す。取り出せなければ所有者本人の操作とみなす。
+func (a auditContext) actorOf(ctx context.Context, ownerID string) string {
+	if a.actor != nil {
+		if actor, ok := a.actor(ctx); ok && actor != "" {
+			return actor
+		}
+	}
+	return ownerID
+}
+
+func (a auditContext) requestIDOf(ctx context.Context) string {
+	if a.requestID != nil {
+		if rid, ok := a.requestID(ctx); ok {
+			return rid
+		}
+	}
+	return ""
+}
+
+// recordHistory は変更履歴を 1 行追記する。before / after は呼び出し側で Snapshot を取っておくこと。WithinTx の中で呼ぶ。
+// 同じ変更を Watch の購読者にも配信する（コミットできたときだけ）。
+func (u *usecase) recordHistory(txCtx context.Context, action domain_todo.HistoryAction, ownerID string, todoID int64, before, after *domain_todo.Todo) error {
+	return u.recordHistoryAs(txCtx, u.audit.actorOf(txCtx, ownerID), action, ownerID, todoID, before, after)
+}
+
+// recordHistoryAs は操作者を actor として recordHistory する（ctx から操作者を取り出せない処理用）。
+func (u *usecase) recordHistoryAs(txCtx context.Context, actor string, action domain_todo.HistoryAction, ownerID string, todoID int64, before, after *domain_todo.Todo) error {
+	emitEvent(txCtx, action, ownerID, before, after)
+	return u.historyRepo.AppendHistory(txCtx, &domain_todo.HistoryEntry{
+		OwnerID:   ownerID,
+		TodoID:    todoID,
+		Action:    action,
+		Actor:     actor,
+		RequestID: u.audit.requestIDOf(txCtx),
+		Before:    before,
+		After:     after,
+	})
+}
+
+func (u *usecase) ListTodoHistory(ctx context.Context, ownerID string, todoID int64, p HistoryListParams) (*HistoryListResult, error) {
+	if err := validateTodoRef(ownerID, todoID); err != nil {
+		return nil, err
+	}
+	pageSize, err := normalizePageSize(p.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// ページトークンは Todo ごとに区別する（List のトークンとも混ざらない）
+	fingerprint := "history:" + strconv.FormatInt(todoID, 10)
+	q := domain_todo.HistoryQuery{
+		// 1 件多めに取って「次ページがあるか」を判定する
+		Limit: pageSize + 1,
+	}
+	if p.PageToken != "" {
+		cur, err := u.pageToken.decode(p.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		if cur.OwnerID != ownerID || cur.Query != fingerprint {
+			return nil, ErrInvalidPageToken
+		}
+		q.BeforeID = cur.AfterID
+	}
+
+	todoOwner, err := u.authorizeTodo(ctx, ownerID, todoID, domain_todo.RoleViewer)
+	var entries []*domain_todo.HistoryEntry
+	if err == nil {
+		entries, err = u.historyRepo.ListHistory(ctx, todoOwner, todoID, q)
+	}
+	if errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		u.logger.Error("failed to list todo history",
+			zap.String("owner_id", ownerID),
+			zap.Int64("todo_id", todoID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("list todo history: %w", err)
+	}
+	// 存在しない（他人の）Todo でも NotFound にはしない（履歴の導入前に作った Todo と区別できないため）。
+	// 他人の履歴は owner_id で絞っているので見えない（Todo が残っていて共有されていなければ、上の authorize で NotFound）
+	res := &HistoryListResult{Entries: entries}
+	if len(entries) > pageSize {
+		res.Entries = entries[:pageSize]
+		last := res.Entries[pageSize-1]
+		res.NextPageToken, err = u.pageToken.encode(pageCursor{OwnerID: ownerID, Query: fingerprint, AfterID: last.ID})
+		if err != nil {
+			return nil, fmt.Errorf("encode page token: %w", err)
+		}
+	}
+
+	u.logger.Info("todo history listed (usecase)",
+		zap.String("owner_id", ownerID),
+		zap.Int64("todo_id", todoID),
+		zap.Int("count", len(res.Entries)),
+		zap.Bool("has_next", res.NextPageToken != ""),
+	)
+	return res, nil
+}
@@ -0,0 +1,210 @@
+package todo_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"go.uber.org/zap"
+)
+
+// LabelParams はラベル作成の入力。
+type LabelParams struct {
+	Name  string
+	Color string // "#rrggbb"。空なら未指定
+}
+
+// LabelUpdateParams はラベルの部分更新の入力。nil のフィールドは変更しない。
+type LabelUpdateParams struct {
+	Name  *string
+	Color *string
+}
+
+func (u *usecase) ListLabels(ctx context.Context, ownerID string) ([]*domain_todo.Label, error) {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return nil, ErrEmptyOwner
+	}
+
+	labels, err := u.labelRepo.ListLabels(ctx, ownerID)
+	if err != nil {
+		u.logger.Error("failed to list labels",
+			zap.String("owner_id", ownerID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("list labels: %w", err)
+	}
+	return labels, nil
+}
+
+func (u *usecase) CreateLabel(ctx context.Context, ownerID string, p LabelParams) (*domain_todo.Label, error) {
+	l, err := domain_todo.NewLabel(ownerID, p.Name, p.Color)
+	if err != nil {
+		if errors.Is(err, domain_todo.ErrEmptyOwner) {
+			return nil, ErrEmptyOwner
+		}
+		return nil, err
+	}
+
+	var created *domain_todo.Label
+
+	// 書き込み系なので Tx を貼る
+	err = u.tx.WithinTx(ctx, func(txCtx context.Context) error {
+		var repoErr error
+		created, repoErr = u.labelRepo.CreateLabel(txCtx, l)
+		return repoErr
+	})
+	if errors.Is(err, domain_todo.ErrLabelAlreadyExists) {
+		return nil, ErrLabelAlreadyExists
+	}
+	if err != nil {
+		u.logger.Error("failed to create label",
+			zap.String("owner_id", ownerID),
+			zap.String("name", l.Name),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("create label: %w", err)
+	}
+
+	u.logger.Info("label created (usecase)",
+		zap.Int64("id", created.ID),
+		zap.String("owner_id", ownerID),
+		zap.String("name", created.Name),
+	)
+	return created, nil
+}
+
+func (u *usecase) UpdateLabel(ctx context.Context, ownerID string, id int64, p LabelUpdateParams) (*domain_todo.Label, error) {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return nil, ErrEmptyOwner
+	}
+	if err := domain_todo.ValidateID(id); err != nil {
+		return nil, ErrInvalidID
+	}
+
+	var updated *domain_todo.Label
+
+	// 書き込み系なので Tx を貼る（Todo の Update と同じく read-modify-write）
+	err := u.tx.WithinTx(ctx, func(txCtx context.Context) error {
+		l, err := u.labelRepo.GetLabel(txCtx, ownerID, id)
+		if err != nil {
+			return err
+		}
+		if p.Name != nil {
+			if err := l.Rename(*p.Name); err != nil {
+				return err
+			}
+		}
+		if p.Color != nil {
+			if err := l.ChangeColor(*p.Color); err != nil {
+				return err
+			}
+		}
+
+		updated, err = u.labelRepo.UpdateLabel(txCtx, l)
+		return err
+	})
+	switch {
+	case errors.Is(err, domain_todo.ErrLabelNotFound),
+		errors.Is(err, domain_todo.ErrLabelAlreadyExists),
+		errors.Is(err, domain_todo.ErrEmptyLabelName),
+		errors.Is(err, domain_todo.ErrLabelNameTooLong),
+		errors.Is(err, domain_todo.ErrInvalidLabelColor):
+		return nil, err
+	}
+	if err != nil {
+		u.logger.Error("failed to update label",
+			zap.String("owner_id", ownerID),
+			zap.Int64("id", id),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("update label: %w", err)
+	}
+
+	u.logger.Info("label updated (usecase)",
+		zap.Int64("id", updated.ID),
+		zap.String("name", updated.Name),
+	)
+	return updated, nil
+}
+
+func (u *usecase) DeleteLabel(ctx context.Context, ownerID string, id int64) error {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return ErrEmptyOwner
+	}
+	if err := domain_todo.ValidateID(id); err != nil {
+		return ErrInvalidID
+	}
+
+	var deleted bool
+
+	// 書き込み系なので Tx を貼る（Todo への付与も同じ Tx で外れる）
+	err := u.tx.WithinTx(ctx, func(txCtx context.Context) error {
+		var repoErr error
+		deleted, repoErr = u.labelRepo.DeleteLabel(txCtx, ownerID, id)
+		return repoErr
+	})
+	if err != nil {
+		u.logger.Error("failed to delete label",
+			zap.String("owner_id", ownerID),
+			zap.Int64("id", id),
+			zap.Error(err),
+		)
+		return fmt.Errorf("delete label: %w", err)
+	}
+	if !deleted {
+		return ErrLabelNotFound
+	}
+
+	u.logger.Info("label deleted (usecase)",
+		zap.String("owner_id", ownerID),
+		zap.Int64("id", id),
+	)
+	return nil
+}
+
+func (u *usecase) AttachLabels(ctx context.Context, ownerID string, todoID int64, labelIDs []int64, expectedVersion int64) (*domain_todo.Todo, error) {
+	adding, err := validateLabelChange(ownerID, todoID, labelIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.modify(ctx, "attach labels to", domain_todo.RoleEditor, ownerID, todoID, expectedVersion, func(_ context.Context, t *domain_todo.Todo) ([]int64, error) {
+		// 付け足した結果で上限をチェックする
+		return domain_todo.NormalizeLabelIDs(append(t.LabelIDs(), adding...))
+	})
+}
+
+func (u *usecase) DetachLabels(ctx context.Context, ownerID string, todoID int64, labelIDs []int64, expectedVersion int64) (*domain_todo.Todo, error) {
+	removing, err := validateLabelChange(ownerID, todoID, labelIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.modify(ctx, "detach labels from", domain_todo.RoleEditor, ownerID, todoID, expectedVersion, func(_ context.Context, t *domain_todo.Todo) ([]int64, error) {
+		// nil だと「変更なし」になるので、全部外れる場合も空のスライスを返す
+		remaining := slices.DeleteFunc(t.LabelIDs(), func(id int64) bool {
+			_, found := slices.BinarySearch(removing, id)
+			return found
+		})
+		if remaining == nil {
+			remaining = []int64{}
+		}
+		return remaining, nil
+	})
+}
+
+// validateLabelChange は付け外しの入力をチェックし、ラベル ID を正規化（重複除去・昇順）して返す。
+func validateLabelChange(ownerID string, todoID int64, labelIDs []int64) ([]int64, error) {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return nil, ErrEmptyOwner
+	}
+	if err := domain_todo.ValidateID(todoID); err != nil {
+		return nil, ErrInvalidID
+	}
+	if len(labelIDs) == 0 {
+		return nil, ErrEmptyLabelIDs
+	}
+	return domain_todo.NormalizeLabelIDs(labelIDs)
+}
@@ -0,0 +1,123 @@
+package todo_usecase
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+)
+
+// pageCursor は page_token の中身（クライアントからは不透明）。
+// 所有者と検索条件のフィンガープリントも含めておき、
+// 他人のトークンや、条件を変えた後の古いトークンを流用できないようにする。
+type pageCursor struct {
+	OwnerID  string `json:"o"`
+	Query    string `json:"q"`           // queryFingerprint
+	AfterID  int64  `json:"a"`           // 直前ページ最後の行の ID
+	Time     int64  `json:"t,omitempty"` // 同 created_at / updated_at（UnixNano）
+	Title    string `json:"s,omitempty"` // 同 title
+	Position int64  `json:"p,omitempty"` // 同 position
+	Offset   int    `json:"n,omitempty"` // 関連度順の検索（SearchTodos）で、読み飛ばす件数
+}
+
+func newPageCursor(ownerID, fingerprint string, c domain_todo.ListCursor) pageCursor {
+	cur := pageCursor{
+		OwnerID:  ownerID,
+		Query:    fingerprint,
+		AfterID:  c.ID,
+		Title:    c.Title,
+		Position: c.Position,
+	}
+	if !c.Time.IsZero() {
+		cur.Time = c.Time.UnixNano()
+	}
+	return cur
+}
+
+func (c pageCursor) listCursor() *domain_todo.ListCursor {
+	lc := &domain_todo.ListCursor{
+		ID:       c.AfterID,
+		Title:    c.Title,
+		Position: c.Position,
+	}
+	if c.Time != 0 {
+		lc.Time = time.Unix(0, c.Time)
+	}
+	return lc
+}
+
+// queryFingerprint は絞り込み条件と並び順から短いハッシュを作る。
+// トークン発行時と利用時で条件が変わっていないかの確認に使う。
+func queryFingerprint(f domain_todo.ListFilter, o domain_todo.OrderBy) string {
+	b, _ := json.Marshal(struct {
+		Filter  domain_todo.ListFilter
+		OrderBy string
+	}{f, o.String()})
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:8])
+}
+
+// pageTokenCodec は pageCursor を HMAC 署名付きの文字列に変換する。
+// 形式: base64url(JSON) + "." + base64url(HMAC-SHA256(JSON))
+// 署名があるので、クライアントが中身を書き換えても decode で弾ける。
+type pageTokenCodec struct {
+	key []byte
+}
+
+func newPageTokenCodec(key []byte) pageTokenCodec {
+	if len(key) == 0 {
+		// 鍵が未設定ならプロセスごとのランダム鍵にする（再起動・別レプリカではトークンが無効になる）
+		key = make([]byte, 32)
+		_, _ = rand.Read(key)
+	}
+	return pageTokenCodec{key: key}
+}
+
+func (c pageTokenCodec) encode(cur pageCursor) (string, error) {
+	payload, err := json.Marshal(cur)
+	if err != nil {
+		return "", err
+	}
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString(payload) + "." + enc.EncodeToString(c.sign(payload)), nil
+}
+
+// decode は署名を検証してから中身を取り出す。不正なトークンは ErrInvalidPageToken。
+func (c pageTokenCodec) decode(token string) (pageCursor, error) {
+	var cur pageCursor
+
+	rawPayload, rawSig, ok := strings.Cut(token, ".")
+	if !ok {
+		return cur, ErrInvalidPageToken
+	}
+
+	enc := base64.RawURLEncoding
+	payload, err := enc.DecodeString(rawPayload)
+	if err != nil {
+		return cur, ErrInvalidPageToken
+	}
+	sig, err := enc.DecodeString(rawSig)
+	if err != nil {
+		return cur, ErrInvalidPageToken
+	}
+	if !hmac.Equal(sig, c.sign(payload)) {
+		return cur, ErrInvalidPageToken
+	}
+
+	if err := json.Unmarshal(payload, &cur); err != nil {
+		return cur, ErrInvalidPageToken
+	}
+	return cur, nil
+}
+
+func (c pageTokenCodec) sign(payload []byte) []byte {
+	m := hmac.New(sha256.New, c.key)
+	m.Write(payload)
+	return m.Sum(nil)
+}
@@ -0,0 +1,117 @@
+package todo_usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"go.uber.org/zap"
+)
+
+// 全文検索（Search）。
+// 索引（domain_todo.SearchIndex）は WithSearchIndex で差し替えられる。
+// 索引の更新は Watch のイベントと同じく、変更がコミットされた後に行う（publishingTxManager）。
+// 関連度順なので keyset ページングはできず、page_token には読み飛ばす件数を持たせる。
+
+// MaxSearchResults は 1 つの検索で辿れる件数の上限（深いページで索引に重い OFFSET をさせない）
+const MaxSearchResults = 1000
+
+var ErrInvalidSearchQuery = domain_todo.ErrInvalidSearchQuery
+
+// errNoSearchIndex は WithSearchIndex を渡さずに New したときのエラー（設定ミスなので Internal 扱い）
+var errNoSearchIndex = errors.New("search index is not configured")
+
+// SearchParams は Search の入力。
+type SearchParams struct {
+	Query     string // 空白区切りの語（すべてを含む Todo を返す）
+	PageSize  int    // 0 なら DefaultPageSize、MaxPageSize を超える値は MaxPageSize に丸める
+	PageToken string // 前回の SearchResult.NextPageToken（空なら先頭から）。Query を変えたら使えない
+}
+
+// SearchMatch は検索結果の 1 件。
+type SearchMatch struct {
+	Todo       *domain_todo.Todo
+	Score      float64
+	Highlights []domain_todo.Highlight
+}
+
+// SearchResult は Search の結果（関連度の高い順）。
+type SearchResult struct {
+	Matches       []*SearchMatch
+	NextPageToken string // 空なら最終ページ
+}
+
+func (u *usecase) Search(ctx context.Context, ownerID string, p SearchParams) (*SearchResult, error) {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return nil, ErrEmptyOwner
+	}
+	terms, err := domain_todo.ParseSearchTerms(p.Query)
+	if err != nil {
+		return nil, ErrInvalidSearchQuery
+	}
+	pageSize, err := normalizePageSize(p.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	if u.searchIndex == nil {
+		return nil, errNoSearchIndex
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(terms, "\x00")))
+	fingerprint := "search:" + hex.EncodeToString(sum[:8])
+
+	offset := 0
+	if p.PageToken != "" {
+		cur, err := u.pageToken.decode(p.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		if cur.OwnerID != ownerID || cur.Query != fingerprint || cur.Offset <= 0 {
+			return nil, ErrInvalidPageToken
+		}
+		offset = cur.Offset
+	}
+	limit := min(pageSize, MaxSearchResults-offset)
+	if limit <= 0 {
+		return &SearchResult{}, nil
+	}
+
+	// 1 件多めに取って「次ページがあるか」を判定する
+	hits, err := u.searchIndex.Search(ctx, ownerID, domain_todo.SearchQuery{Terms: terms, Offset: offset, Limit: limit + 1})
+	if err != nil {
+		u.logger.Error("failed to search todos",
+			zap.String("owner_id", ownerID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("search todos: %w", err)
+	}
+
+	res := &SearchResult{}
+	if len(hits) > limit {
+		hits = hits[:limit]
+		if offset+limit < MaxSearchResults {
+			res.NextPageToken, err = u.pageToken.encode(pageCursor{OwnerID: ownerID, Query: fingerprint, Offset: offset + limit})
+			if err != nil {
+				return nil, fmt.Errorf("encode page token: %w", err)
+			}
+		}
+	}
+	for _, h := range hits {
+		res.Matches = append(res.Matches, &SearchMatch{
+			Todo:       h.Todo,
+			Score:      h.Score,
+			Highlights: domain_todo.Highlights(h.Todo, terms),
+		})
+	}
+
+	u.logger.Info("todos searched (usecase)",
+		zap.String("owner_id", ownerID),
+		zap.Int("terms", len(terms)),
+		zap.Int("count", len(res.Matches)),
+	)
+	return res, nil
+}
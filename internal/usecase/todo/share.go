@@ -0,0 +1,322 @@
+package todo_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"go.uber.org/zap"
+)
+
+// 共有（コラボレーター）。役割の意味は domain_todo の share.go。
+// Todo・リストを指定する操作では、呼び出し元（ownerID 引数。gRPC では認証インターセプタが ctx に入れた identity）が
+// 所有者でなければ共有で持つ役割を確かめ（authorize）、その Todo・リストの所有者として読み書きする。
+// 共有されていないものは ErrNotFound / ErrListNotFound、役割が足りない操作は ErrPermissionDenied。
+// リストを指定しない一覧・ゴミ箱・検索・一括操作の作成・エクスポート・Watch などは、自分の Todo だけのまま。
+
+var (
+	ErrInvalidRole        = domain_todo.ErrInvalidRole
+	ErrInvalidResource    = domain_todo.ErrInvalidResource
+	ErrInvalidGrantee     = domain_todo.ErrInvalidGrantee
+	ErrGrantAlreadyExists = domain_todo.ErrGrantAlreadyExists
+	ErrGrantNotFound      = domain_todo.ErrGrantNotFound
+	ErrPermissionDenied   = domain_todo.ErrPermissionDenied
+)
+
+// errNoGrantRepository は WithGrantRepository を渡さずに New したときのエラー（設定ミスなので Internal 扱い）
+var errNoGrantRepository = errors.New("grant repository is not configured")
+
+// authorize は callerID が r に need 以上の役割を持つか確かめ、r を読み書きするときの所有者を返す。
+// 共有を使わない（WithGrantRepository 無し）場合と r が無い場合は callerID を返し、
+// 続く（所有者で絞った）読み書きに ErrNotFound / ErrListNotFound を任せる（物理削除済みの Todo の履歴なども今まで通り読める）。
+func (u *usecase) authorize(ctx context.Context, callerID string, r domain_todo.Resource, need domain_todo.Role) (string, error) {
+	if u.grantRepo == nil {
+		return callerID, nil
+	}
+	ownerID, role, err := u.access(ctx, callerID, r)
+	switch {
+	case errors.Is(err, domain_todo.ErrNotFound), errors.Is(err, domain_todo.ErrListNotFound):
+		return callerID, nil
+	case err != nil:
+		return "", err
+	}
+	if err := checkRole(r, role, need); err != nil {
+		return "", err
+	}
+	return ownerID, nil
+}
+
+func (u *usecase) authorizeTodo(ctx context.Context, callerID string, id int64, need domain_todo.Role) (string, error) {
+	return u.authorize(ctx, callerID, domain_todo.Resource{Type: domain_todo.ResourceTodo, ID: id}, need)
+}
+
+func (u *usecase) authorizeList(ctx context.Context, callerID string, id int64, need domain_todo.Role) (string, error) {
+	return u.authorize(ctx, callerID, domain_todo.Resource{Type: domain_todo.ResourceList, ID: id}, need)
+}
+
+// access は r の所有者と、callerID が r に持つ役割を返す（所有者本人なら RoleOwner）。
+// r が無ければ ErrNotFound / ErrListNotFound。
+func (u *usecase) access(ctx context.Context, callerID string, r domain_todo.Resource) (string, domain_todo.Role, error) {
+	var (
+		ownerID string
+		role    domain_todo.Role
+		err     error
+	)
+	if r.Type == domain_todo.ResourceList {
+		ownerID, role, err = u.grantRepo.ListAccess(ctx, callerID, r.ID)
+	} else {
+		ownerID, role, err = u.grantRepo.TodoAccess(ctx, callerID, r.ID)
+	}
+	if err != nil {
+		return "", domain_todo.RoleNone, err
+	}
+	if ownerID == callerID {
+		role = domain_todo.RoleOwner
+	}
+	return ownerID, role, nil
+}
+
+// checkRole は role で need の役割が要る操作をできなければ、そのエラーを返す（見えなければ NotFound）。
+func checkRole(r domain_todo.Resource, role, need domain_todo.Role) error {
+	switch {
+	case role == domain_todo.RoleNone && r.Type == domain_todo.ResourceList:
+		return ErrListNotFound
+	case role == domain_todo.RoleNone:
+		return ErrNotFound
+	case !role.Allows(need):
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// deleteGrants は物理削除した Todo・リストへの共有を消す（行が残ると ListSharedWithMe などに出続けるため）。
+// 削除と同じ Tx の中で呼ぶこと。共有を使わない場合は何もしない。
+func (u *usecase) deleteGrants(ctx context.Context, typ domain_todo.ResourceType, ids ...int64) error {
+	if u.grantRepo == nil || len(ids) == 0 {
+		return nil
+	}
+	rs := make([]domain_todo.Resource, len(ids))
+	for i, id := range ids {
+		rs[i] = domain_todo.Resource{Type: typ, ID: id}
+	}
+	return u.grantRepo.DeleteGrantsFor(ctx, rs...)
+}
+
+// shareInputErrors は共有の操作が入力値のエラーとしてそのまま返すもの。
+var shareInputErrors = []error{
+	ErrNotFound,
+	ErrListNotFound,
+	ErrPermissionDenied,
+	ErrInvalidGrantee,
+	ErrGrantAlreadyExists,
+	ErrGrantNotFound,
+}
+
+// sharedResource は共有の操作の共通チェック。callerID が r に need 以上の役割を持てば、r の所有者を返す。
+// authorize と違い、r が無ければ ErrNotFound / ErrListNotFound にする。
+func (u *usecase) sharedResource(ctx context.Context, callerID string, r domain_todo.Resource, need domain_todo.Role) (string, error) {
+	ownerID, role, err := u.access(ctx, callerID, r)
+	switch {
+	case errors.Is(err, domain_todo.ErrNotFound):
+		return "", ErrNotFound
+	case errors.Is(err, domain_todo.ErrListNotFound):
+		return "", ErrListNotFound
+	case err != nil:
+		return "", err
+	}
+	if err := checkRole(r, role, need); err != nil {
+		return "", err
+	}
+	return ownerID, nil
+}
+
+// validateShare は共有の操作の入力の共通チェック。
+func (u *usecase) validateShare(callerID string, r domain_todo.Resource) error {
+	if err := domain_todo.ValidateOwnerID(callerID); err != nil {
+		return ErrEmptyOwner
+	}
+	if err := r.Validate(); err != nil {
+		if errors.Is(err, domain_todo.ErrInvalidID) {
+			return ErrInvalidID
+		}
+		return ErrInvalidResource
+	}
+	if u.grantRepo == nil {
+		return errNoGrantRepository
+	}
+	return nil
+}
+
+// shareError は共有の操作が失敗したときの戻り値を決める（入力値のエラーはそのまま、それ以外はログに残してラップする）。
+func (u *usecase) shareError(op, callerID string, r domain_todo.Resource, err error) error {
+	if inputErr := inputError(err, shareInputErrors); inputErr != nil {
+		return inputErr
+	}
+	u.logger.Error("failed to "+op,
+		zap.String("owner_id", callerID),
+		zap.Int32("resource_type", int32(r.Type)),
+		zap.Int64("resource_id", r.ID),
+		zap.Error(err),
+	)
+	return fmt.Errorf("%s: %w", op, err)
+}
+
+// AddCollaborator は r を granteeID に role で共有する。所有者と RoleOwner で共有された人だけができる。
+func (u *usecase) AddCollaborator(ctx context.Context, ownerID string, r domain_todo.Resource, granteeID string, role domain_todo.Role) (*domain_todo.Grant, error) {
+	if err := u.validateShare(ownerID, r); err != nil {
+		return nil, err
+	}
+	if err := role.Validate(); err != nil {
+		return nil, ErrInvalidRole
+	}
+	if granteeID == ownerID {
+		return nil, ErrInvalidGrantee
+	}
+
+	var created *domain_todo.Grant
+
+	// 書き込み系なので Tx を貼る（権限の確認も同じ Tx で行う）
+	err := u.tx.WithinTx(ctx, func(txCtx context.Context) error {
+		resourceOwner, err := u.sharedResource(txCtx, ownerID, r, domain_todo.RoleOwner)
+		if err != nil {
+			return err
+		}
+		g, err := domain_todo.NewGrant(r, resourceOwner, granteeID, role, ownerID)
+		if err != nil {
+			return err
+		}
+		if err := u.grantRepo.CreateGrant(txCtx, g); err != nil {
+			return err
+		}
+		created = g
+		return nil
+	})
+	if err != nil {
+		return nil, u.shareError("add collaborator", ownerID, r, err)
+	}
+
+	u.logger.Info("collaborator added (usecase)",
+		zap.String("owner_id", created.OwnerID),
+		zap.Int32("resource_type", int32(r.Type)),
+		zap.Int64("resource_id", r.ID),
+		zap.String("grantee_id", granteeID),
+		zap.Int32("role", int32(role)),
+		zap.String("granted_by", ownerID),
+	)
+	return created, nil
+}
+
+// ChangeCollaboratorRole は granteeID の役割を変える。所有者と RoleOwner で共有された人だけができる。
+func (u *usecase) ChangeCollaboratorRole(ctx context.Context, ownerID string, r domain_todo.Resource, granteeID string, role domain_todo.Role) (*domain_todo.Grant, error) {
+	if err := u.validateShare(ownerID, r); err != nil {
+		return nil, err
+	}
+	if err := role.Validate(); err != nil {
+		return nil, ErrInvalidRole
+	}
+	if err := domain_todo.ValidateOwnerID(granteeID); err != nil {
+		return nil, ErrInvalidGrantee
+	}
+
+	var updated *domain_todo.Grant
+
+	err := u.tx.WithinTx(ctx, func(txCtx context.Context) error {
+		if _, err := u.sharedResource(txCtx, ownerID, r, domain_todo.RoleOwner); err != nil {
+			return err
+		}
+		var err error
+		updated, err = u.grantRepo.UpdateGrantRole(txCtx, r, granteeID, role)
+		return err
+	})
+	if err != nil {
+		return nil, u.shareError("change collaborator role", ownerID, r, err)
+	}
+
+	u.logger.Info("collaborator role changed (usecase)",
+		zap.String("owner_id", updated.OwnerID),
+		zap.Int32("resource_type", int32(r.Type)),
+		zap.Int64("resource_id", r.ID),
+		zap.String("grantee_id", granteeID),
+		zap.Int32("role", int32(role)),
+		zap.String("changed_by", ownerID),
+	)
+	return updated, nil
+}
+
+// RemoveCollaborator は granteeID への共有をやめる。所有者と RoleOwner で共有された人のほか、
+// 共有された本人も（自分の分だけは）やめられる。
+func (u *usecase) RemoveCollaborator(ctx context.Context, ownerID string, r domain_todo.Resource, granteeID string) error {
+	if err := u.validateShare(ownerID, r); err != nil {
+		return err
+	}
+	if err := domain_todo.ValidateOwnerID(granteeID); err != nil {
+		return ErrInvalidGrantee
+	}
+
+	need := domain_todo.RoleOwner
+	if granteeID == ownerID {
+		need = domain_todo.RoleViewer
+	}
+
+	err := u.tx.WithinTx(ctx, func(txCtx context.Context) error {
+		if _, err := u.sharedResource(txCtx, ownerID, r, need); err != nil {
+			return err
+		}
+		ok, err := u.grantRepo.DeleteGrant(txCtx, r, granteeID)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ErrGrantNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return u.shareError("remove collaborator", ownerID, r, err)
+	}
+
+	u.logger.Info("collaborator removed (usecase)",
+		zap.Int32("resource_type", int32(r.Type)),
+		zap.Int64("resource_id", r.ID),
+		zap.String("grantee_id", granteeID),
+		zap.String("removed_by", ownerID),
+	)
+	return nil
+}
+
+// ListCollaborators は r の共有を返す。r が見える人（所有者と共有された人）なら誰でも見られる。
+func (u *usecase) ListCollaborators(ctx context.Context, ownerID string, r domain_todo.Resource) ([]*domain_todo.Grant, error) {
+	if err := u.validateShare(ownerID, r); err != nil {
+		return nil, err
+	}
+
+	if _, err := u.sharedResource(ctx, ownerID, r, domain_todo.RoleViewer); err != nil {
+		return nil, u.shareError("list collaborators", ownerID, r, err)
+	}
+	grants, err := u.grantRepo.ListGrants(ctx, r)
+	if err != nil {
+		return nil, u.shareError("list collaborators", ownerID, r, err)
+	}
+	return grants, nil
+}
+
+// ListSharedWithMe は ownerID に共有されているもの（Todo・リスト）を新しい順に返す。
+func (u *usecase) ListSharedWithMe(ctx context.Context, ownerID string) ([]*domain_todo.Grant, error) {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return nil, ErrEmptyOwner
+	}
+	if u.grantRepo == nil {
+		return nil, errNoGrantRepository
+	}
+
+	grants, err := u.grantRepo.ListGrantsForGrantee(ctx, ownerID)
+	if err != nil {
+		u.logger.Error("failed to list shared with me",
+			zap.String("owner_id", ownerID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("list shared with me: %w", err)
+	}
+	return grants, nil
+}
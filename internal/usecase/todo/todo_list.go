@@ -0,0 +1,243 @@
+package todo_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"go.uber.org/zap"
+)
+
+// TodoListUpdateParams はリストの部分更新の入力。nil のフィールドは変更しない。
+type TodoListUpdateParams struct {
+	Name     *string
+	Archived *bool
+}
+
+// errNoTodoListRepository は WithTodoListRepository を渡さずに New したときのエラー（設定ミスなので Internal 扱い）
+var errNoTodoListRepository = errors.New("todo list repository is not configured")
+
+func (u *usecase) todoLists() (domain_todo.TodoListRepository, error) {
+	if u.listRepo == nil {
+		return nil, errNoTodoListRepository
+	}
+	return u.listRepo, nil
+}
+
+func (u *usecase) ListTodoLists(ctx context.Context, ownerID string, includeArchived bool) ([]*domain_todo.TodoList, error) {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return nil, ErrEmptyOwner
+	}
+	lists, err := u.todoLists()
+	if err != nil {
+		return nil, err
+	}
+
+	ls, err := lists.ListTodoLists(ctx, ownerID, includeArchived)
+	if err != nil {
+		u.logger.Error("failed to list todo lists",
+			zap.String("owner_id", ownerID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("list todo lists: %w", err)
+	}
+	return ls, nil
+}
+
+func (u *usecase) GetTodoList(ctx context.Context, ownerID string, id int64) (*domain_todo.TodoList, error) {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return nil, ErrEmptyOwner
+	}
+	if err := domain_todo.ValidateID(id); err != nil {
+		return nil, ErrInvalidID
+	}
+	lists, err := u.todoLists()
+	if err != nil {
+		return nil, err
+	}
+
+	listOwner, err := u.authorizeList(ctx, ownerID, id, domain_todo.RoleViewer)
+	var l *domain_todo.TodoList
+	if err == nil {
+		l, err = lists.GetTodoList(ctx, listOwner, id)
+	}
+	if errors.Is(err, domain_todo.ErrListNotFound) {
+		return nil, ErrListNotFound
+	}
+	if err != nil {
+		u.logger.Error("failed to get todo list",
+			zap.String("owner_id", ownerID),
+			zap.Int64("id", id),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("get todo list: %w", err)
+	}
+	return l, nil
+}
+
+func (u *usecase) CreateTodoList(ctx context.Context, ownerID, name string) (*domain_todo.TodoList, error) {
+	l, err := domain_todo.NewTodoList(ownerID, name)
+	if err != nil {
+		if errors.Is(err, domain_todo.ErrEmptyOwner) {
+			return nil, ErrEmptyOwner
+		}
+		return nil, err
+	}
+	lists, err := u.todoLists()
+	if err != nil {
+		return nil, err
+	}
+
+	var created *domain_todo.TodoList
+
+	// 書き込み系なので Tx を貼る
+	err = u.tx.WithinTx(ctx, func(txCtx context.Context) error {
+		var repoErr error
+		created, repoErr = lists.CreateTodoList(txCtx, l)
+		return repoErr
+	})
+	if err != nil {
+		u.logger.Error("failed to create todo list",
+			zap.String("owner_id", ownerID),
+			zap.String("name", l.Name),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("create todo list: %w", err)
+	}
+
+	u.logger.Info("todo list created (usecase)",
+		zap.Int64("id", created.ID),
+		zap.String("owner_id", ownerID),
+		zap.String("name", created.Name),
+	)
+	return created, nil
+}
+
+func (u *usecase) UpdateTodoList(ctx context.Context, ownerID string, id int64, p TodoListUpdateParams) (*domain_todo.TodoList, error) {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return nil, ErrEmptyOwner
+	}
+	if err := domain_todo.ValidateID(id); err != nil {
+		return nil, ErrInvalidID
+	}
+	lists, err := u.todoLists()
+	if err != nil {
+		return nil, err
+	}
+
+	var updated *domain_todo.TodoList
+
+	// 行をロックして読むので、同じリストへの Todo の追加・移動とは直列になる
+	err = u.tx.WithinTx(ctx, func(txCtx context.Context) error {
+		listOwner, err := u.authorizeList(txCtx, ownerID, id, domain_todo.RoleOwner)
+		if err != nil {
+			return err
+		}
+		l, err := lists.GetTodoListForUpdate(txCtx, listOwner, id)
+		if err != nil {
+			return err
+		}
+		if p.Name != nil {
+			if err := l.Rename(*p.Name); err != nil {
+				return err
+			}
+		}
+		if p.Archived != nil {
+			if *p.Archived {
+				l.Archive(time.Now())
+			} else {
+				l.Unarchive()
+			}
+		}
+
+		updated, err = lists.UpdateTodoList(txCtx, l)
+		return err
+	})
+	switch {
+	case errors.Is(err, domain_todo.ErrListNotFound),
+		errors.Is(err, domain_todo.ErrEmptyListName),
+		errors.Is(err, domain_todo.ErrListNameTooLong),
+		errors.Is(err, domain_todo.ErrPermissionDenied):
+		return nil, err
+	}
+	if err != nil {
+		u.logger.Error("failed to update todo list",
+			zap.String("owner_id", ownerID),
+			zap.Int64("id", id),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("update todo list: %w", err)
+	}
+
+	u.logger.Info("todo list updated (usecase)",
+		zap.Int64("id", updated.ID),
+		zap.String("name", updated.Name),
+		zap.Bool("archived", updated.IsArchived()),
+	)
+	return updated, nil
+}
+
+func (u *usecase) DeleteTodoList(ctx context.Context, ownerID string, id int64) error {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return ErrEmptyOwner
+	}
+	if err := domain_todo.ValidateID(id); err != nil {
+		return ErrInvalidID
+	}
+	lists, err := u.todoLists()
+	if err != nil {
+		return err
+	}
+
+	// ロックしてから数えるので、数えた後に Todo が移ってくることはない
+	err = u.tx.WithinTx(ctx, func(txCtx context.Context) error {
+		listOwner, err := u.authorizeList(txCtx, ownerID, id, domain_todo.RoleOwner)
+		if err != nil {
+			return err
+		}
+		l, err := lists.GetTodoListForUpdate(txCtx, listOwner, id)
+		if err != nil {
+			return err
+		}
+		n, err := lists.CountTodos(txCtx, listOwner, id)
+		if err != nil {
+			return err
+		}
+		if err := l.CheckDeletable(n); err != nil {
+			return err
+		}
+
+		deleted, err := lists.DeleteTodoList(txCtx, listOwner, id)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return domain_todo.ErrListNotFound
+		}
+		return u.deleteGrants(txCtx, domain_todo.ResourceList, id)
+	})
+	switch {
+	case errors.Is(err, domain_todo.ErrListNotFound):
+		return ErrListNotFound
+	case errors.Is(err, domain_todo.ErrListNotEmpty):
+		return ErrListNotEmpty
+	case errors.Is(err, domain_todo.ErrPermissionDenied):
+		return ErrPermissionDenied
+	}
+	if err != nil {
+		u.logger.Error("failed to delete todo list",
+			zap.String("owner_id", ownerID),
+			zap.Int64("id", id),
+			zap.Error(err),
+		)
+		return fmt.Errorf("delete todo list: %w", err)
+	}
+
+	u.logger.Info("todo list deleted (usecase)",
+		zap.String("owner_id", ownerID),
+		zap.Int64("id", id),
+	)
+	return nil
+}
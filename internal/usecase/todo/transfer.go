@@ -0,0 +1,178 @@
+package todo_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// インポート / エクスポート（Import / Export）。形式ごとの読み書きは transfer_format.go。
+// Import は先に全行を読んで検証し、問題のある行は飛ばして（ImportResult.RowErrors）残りを作成する。
+// 作成は ImportBatchSize 件ずつ別の Tx で行うので、途中の Tx が失敗しても、それより前に作成した分は残る。
+
+const (
+	MaxImportRows      = 10000 // 1 回のインポートで読める最大件数（空行・チェックリスト以外の行は数えない）
+	ImportBatchSize    = 100   // 1 つの Tx で作成する件数
+	MaxImportRowErrors = 100   // ImportResult.RowErrors に載せる最大件数（件数は ErrorCount で全部数える）
+	MaxImportPreview   = 100   // DryRun の ImportResult.Preview に載せる最大件数
+)
+
+var (
+	ErrInvalidImportFile = errors.New("invalid import file")
+	ErrImportTooLarge    = fmt.Errorf("import must have at most %d rows", MaxImportRows)
+)
+
+// ImportParams は Import の入力。
+type ImportParams struct {
+	Format TransferFormat
+	DryRun bool // true なら検証だけして、何も作成しない
+}
+
+// ImportRowError は作成しなかった行とその理由。
+type ImportRowError struct {
+	Line int // ファイルの行番号（1 始まり）
+	Err  error
+}
+
+// ImportResult は Import の結果。
+type ImportResult struct {
+	DryRun     bool
+	Created    int                 // 作成した件数（DryRun では作成する件数）
+	Preview    []*domain_todo.Todo // DryRun で作成する Todo（先頭 MaxImportPreview 件。ID・並び順は未採番）
+	RowErrors  []ImportRowError    // 先頭 MaxImportRowErrors 件
+	ErrorCount int
+}
+
+// Export は ownerID の Todo（ゴミ箱の中は除く）を並び順で f の形式にして w に書く。戻り値は書いた件数。
+// FormatICalendar では期限のある Todo だけを書く。
+func (u *usecase) Export(ctx context.Context, ownerID string, f TransferFormat, w io.Writer) (int, error) {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return 0, ErrEmptyOwner
+	}
+	rw, err := newRecordWriter(f, w)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	p := ListParams{PageSize: MaxPageSize}
+	for {
+		res, err := u.List(ctx, ownerID, p)
+		if err != nil {
+			return n, err
+		}
+		for _, t := range res.Todos {
+			// iCalendar はカレンダーに載せるためのものなので、期限の無い Todo は出さない
+			if f == FormatICalendar && t.DueAt.IsZero() {
+				continue
+			}
+			if err := rw.write(toTransferRecord(t)); err != nil {
+				return n, fmt.Errorf("export todos: %w", err)
+			}
+			n++
+		}
+		if res.NextPageToken == "" {
+			break
+		}
+		p.PageToken = res.NextPageToken
+	}
+	if err := rw.flush(); err != nil {
+		return n, fmt.Errorf("export todos: %w", err)
+	}
+
+	u.logger.Info("todos exported (usecase)",
+		zap.String("owner_id", ownerID),
+		zap.Int32("format", int32(f)),
+		zap.Int("count", n),
+	)
+	return n, nil
+}
+
+// Import は r を p.Format の形式として読み、1 行 1 件の Todo を末尾に作成する（リストはインボックス）。
+// ファイル自体が読めない（形式が違う・壊れている）場合は ErrInvalidImportFile、MaxImportRows を超える場合は
+// ErrImportTooLarge を返し、どちらも何も作成しない。
+// 作成の途中で失敗した場合は、それまでに作成した件数の入った結果とエラーを両方返す。
+func (u *usecase) Import(ctx context.Context, ownerID string, r io.Reader, p ImportParams) (*ImportResult, error) {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return nil, ErrEmptyOwner
+	}
+	rr, err := newRecordReader(p.Format, r)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ImportResult{DryRun: p.DryRun}
+	var todos []*domain_todo.Todo
+	for {
+		rec, line, err := rr.read()
+		if err == io.EOF {
+			break
+		}
+		var rowErr *rowError
+		switch {
+		case errors.As(err, &rowErr):
+			res.addRowError(rowErr.line, rowErr.err)
+			continue
+		case err != nil:
+			return nil, err
+		}
+		if len(todos)+res.ErrorCount >= MaxImportRows {
+			return nil, ErrImportTooLarge
+		}
+
+		t, err := rec.toTodo(ownerID)
+		if err != nil {
+			res.addRowError(line, err)
+			continue
+		}
+		todos = append(todos, t)
+	}
+
+	if p.DryRun {
+		res.Created = len(todos)
+		res.Preview = todos[:min(len(todos), MaxImportPreview)]
+		return res, nil
+	}
+
+	for start := 0; start < len(todos); start += ImportBatchSize {
+		chunk := todos[start:min(start+ImportBatchSize, len(todos))]
+		err := u.tx.WithinTx(ctx, func(txCtx context.Context) error {
+			_, err := u.insertAtEnd(txCtx, ownerID, chunk)
+			return err
+		})
+		if err != nil {
+			u.logger.Error("failed to import todos",
+				zap.String("owner_id", ownerID),
+				zap.Int("created", res.Created),
+				zap.Int("remaining", len(todos)-res.Created),
+				zap.Error(err),
+			)
+			return res, fmt.Errorf("import todos: %w", err)
+		}
+		res.Created += len(chunk)
+		todoCreatedCounter.Add(ctx, int64(len(chunk)),
+			metric.WithAttributes(attribute.String("source", "grpc")),
+		)
+	}
+
+	u.logger.Info("todos imported (usecase)",
+		zap.String("owner_id", ownerID),
+		zap.Int32("format", int32(p.Format)),
+		zap.Int("created", res.Created),
+		zap.Int("errors", res.ErrorCount),
+	)
+	return res, nil
+}
+
+func (res *ImportResult) addRowError(line int, err error) {
+	res.ErrorCount++
+	if len(res.RowErrors) < MaxImportRowErrors {
+		res.RowErrors = append(res.RowErrors, ImportRowError{Line: line, Err: err})
+	}
+}
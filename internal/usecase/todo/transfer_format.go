@@ -0,0 +1,352 @@
+package todo_usecase
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+)
+
+// インポート / エクスポートのファイル形式。
+// どの形式も 1 行（CSV は 1 レコード、iCalendar は VTODO 1 つ）が Todo 1 件で、扱う項目は transferRecord のもの
+// （リスト・ラベル・チェックリストは含めない）。Markdown はタイトルと完了状態だけ。
+// iCalendar の読み書きは transfer_ical.go。
+
+// TransferFormat はインポート / エクスポートのファイル形式。
+type TransferFormat int32
+
+const (
+	FormatUnspecified TransferFormat = iota
+	FormatJSONLines                  // 1 行 1 件の JSON（transferRecord）
+	FormatCSV                        // 1 行目がヘッダ（csvColumns のうち title は必須、順不同）。数式に見えるセルは "'" を付けて出す
+	FormatMarkdown                   // "- [ ] タイトル" / "- [x] タイトル" のチェックリスト
+	FormatICalendar                  // RFC 5545 の VCALENDAR（Todo は VTODO）。書き出すのは期限のある Todo だけ
+)
+
+var ErrInvalidFormat = errors.New("invalid transfer format")
+
+// transferRecord は 1 件分の項目。JSON Lines ではこのまま、CSV では同じ名前の列になる。
+type transferRecord struct {
+	Title      string `json:"title"`
+	Done       bool   `json:"done,omitempty"`
+	DueAt      string `json:"due_at,omitempty"`   // RFC 3339
+	Priority   string `json:"priority,omitempty"` // "low" / "medium" / "high"（空なら未設定）
+	Notes      string `json:"notes,omitempty"`
+	Recurrence string `json:"recurrence,omitempty"` // RRULE（"FREQ=WEEKLY;BYDAY=MO" など）
+
+	// iCalendar の UID / LAST-MODIFIED 用（書き出しのときだけ埋める。JSON / CSV には出さない）
+	id        int64
+	updatedAt time.Time
+}
+
+// csvColumns は CSV の列（エクスポートではこの順に出す）
+var csvColumns = []string{"title", "done", "due_at", "priority", "notes", "recurrence"}
+
+var priorityNames = map[domain_todo.Priority]string{
+	domain_todo.PriorityLow:    "low",
+	domain_todo.PriorityMedium: "medium",
+	domain_todo.PriorityHigh:   "high",
+}
+
+func toTransferRecord(t *domain_todo.Todo) transferRecord {
+	rec := transferRecord{
+		Title:      t.Title,
+		Done:       t.Done,
+		Priority:   priorityNames[t.Priority],
+		Notes:      t.Notes,
+		Recurrence: t.Recurrence.String(),
+		id:         t.ID,
+		updatedAt:  t.UpdatedAt,
+	}
+	if !t.DueAt.IsZero() {
+		rec.DueAt = t.DueAt.UTC().Format(time.RFC3339)
+	}
+	return rec
+}
+
+// toTodo はレコードを検証して、作成する Todo にする（検証は Create と同じ domain のルール）。
+// ただし期限は過ぎていてもよい（エクスポートした期限切れ・完了済みの Todo を戻せるように）。
+func (rec transferRecord) toTodo(ownerID string) (*domain_todo.Todo, error) {
+	p := CreateParams{Title: strings.TrimSpace(rec.Title), Notes: rec.Notes}
+	var due time.Time
+	if rec.DueAt != "" {
+		var err error
+		if due, err = time.Parse(time.RFC3339, rec.DueAt); err != nil {
+			return nil, fmt.Errorf("due_at must be RFC 3339: %q", rec.DueAt)
+		}
+	}
+	if rec.Priority != "" {
+		p.Priority = -1
+		for pr, name := range priorityNames {
+			if strings.EqualFold(rec.Priority, name) {
+				p.Priority = pr
+			}
+		}
+	}
+	if rec.Recurrence != "" {
+		r, err := domain_todo.ParseRecurrence(rec.Recurrence)
+		if err != nil {
+			return nil, err
+		}
+		p.Recurrence = r
+	}
+
+	t, err := newTodo(ownerID, p)
+	if err != nil {
+		return nil, err
+	}
+	t.RestoreDueAt(due)
+	if err := t.MarkDone(rec.Done); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ---- 書き出し ----
+
+type recordWriter interface {
+	write(rec transferRecord) error
+	flush() error
+}
+
+func newRecordWriter(f TransferFormat, w io.Writer) (recordWriter, error) {
+	switch f {
+	case FormatJSONLines:
+		return &jsonLinesWriter{enc: json.NewEncoder(w)}, nil
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(csvColumns); err != nil {
+			return nil, err
+		}
+		return &csvWriter{w: cw}, nil
+	case FormatMarkdown:
+		return &markdownWriter{w: bufio.NewWriter(w)}, nil
+	case FormatICalendar:
+		return newICalWriter(w), nil
+	default:
+		return nil, ErrInvalidFormat
+	}
+}
+
+type jsonLinesWriter struct{ enc *json.Encoder }
+
+func (w *jsonLinesWriter) write(rec transferRecord) error { return w.enc.Encode(rec) }
+func (w *jsonLinesWriter) flush() error                   { return nil }
+
+type csvWriter struct{ w *csv.Writer }
+
+func (w *csvWriter) write(rec transferRecord) error {
+	fields := []string{rec.Title, strconv.FormatBool(rec.Done), rec.DueAt, rec.Priority, rec.Notes, rec.Recurrence}
+	for i, f := range fields {
+		fields[i] = escapeCSVCell(f)
+	}
+	return w.w.Write(fields)
+}
+
+// csvFormulaPrefixes は表計算ソフトが数式として評価するセルの先頭文字。
+// "'" を含めるのは、元から "'" で始まる値も読み込みで元に戻せるようにするため。
+const csvFormulaPrefixes = "=+-@'"
+
+// escapeCSVCell は数式として評価されるセル（CSV injection）の先頭に "'" を付ける（読み込みでは unescapeCSVCell で外す）。
+func escapeCSVCell(s string) string {
+	if s != "" && strings.ContainsRune(csvFormulaPrefixes, rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// unescapeCSVCell は escapeCSVCell で付けた "'" を外す。手で書いた "'abc" のような値はそのまま。
+func unescapeCSVCell(s string) string {
+	if len(s) >= 2 && s[0] == '\'' && strings.ContainsRune(csvFormulaPrefixes, rune(s[1])) {
+		return s[1:]
+	}
+	return s
+}
+
+func (w *csvWriter) flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+type markdownWriter struct{ w *bufio.Writer }
+
+func (w *markdownWriter) write(rec transferRecord) error {
+	mark := " "
+	if rec.Done {
+		mark = "x"
+	}
+	// 改行が入っていると別の行として読まれるので、空白にしておく
+	title := strings.Join(strings.Fields(rec.Title), " ")
+	_, err := fmt.Fprintf(w.w, "- [%s] %s\n", mark, title)
+	return err
+}
+
+func (w *markdownWriter) flush() error { return w.w.Flush() }
+
+// ---- 読み込み ----
+
+// rowError は 1 行分の読み込み・検証のエラー（その行だけを飛ばして続ける）。
+type rowError struct {
+	line int
+	err  error
+}
+
+func (e *rowError) Error() string { return fmt.Sprintf("line %d: %v", e.line, e.err) }
+func (e *rowError) Unwrap() error { return e.err }
+
+// recordReader は 1 件ずつ読む。終わりは io.EOF、その行だけの問題は *rowError、それ以外は読み込みを続けられないエラー。
+type recordReader interface {
+	read() (rec transferRecord, line int, err error)
+}
+
+func newRecordReader(f TransferFormat, r io.Reader) (recordReader, error) {
+	switch f {
+	case FormatJSONLines:
+		return &jsonLinesReader{lines: newLineScanner(r)}, nil
+	case FormatCSV:
+		cr := csv.NewReader(r)
+		cr.ReuseRecord = true
+		return &csvReader{r: cr}, nil
+	case FormatMarkdown:
+		return &markdownReader{lines: newLineScanner(r)}, nil
+	case FormatICalendar:
+		return &icalReader{lines: newLineScanner(r)}, nil
+	default:
+		return nil, ErrInvalidFormat
+	}
+}
+
+// maxImportLineLength は 1 行の最大バイト数（メモの上限に余裕を持たせた値）
+const maxImportLineLength = 1 << 20
+
+// scanErr は行の読み込みのエラー。長すぎる行はファイルの問題として扱う。
+func scanErr(s *bufio.Scanner) error {
+	err := s.Err()
+	if errors.Is(err, bufio.ErrTooLong) {
+		return fmt.Errorf("%w: line is longer than %d bytes", ErrInvalidImportFile, maxImportLineLength)
+	}
+	return err
+}
+
+func newLineScanner(r io.Reader) *bufio.Scanner {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0, 64*1024), maxImportLineLength)
+	return s
+}
+
+type jsonLinesReader struct {
+	lines *bufio.Scanner
+	line  int
+}
+
+func (r *jsonLinesReader) read() (transferRecord, int, error) {
+	for r.lines.Scan() {
+		r.line++
+		b := r.lines.Bytes()
+		if len(strings.TrimSpace(string(b))) == 0 {
+			continue
+		}
+		var rec transferRecord
+		if err := json.Unmarshal(b, &rec); err != nil {
+			return rec, r.line, &rowError{line: r.line, err: errors.New("invalid JSON")}
+		}
+		return rec, r.line, nil
+	}
+	if err := scanErr(r.lines); err != nil {
+		return transferRecord{}, r.line, err
+	}
+	return transferRecord{}, r.line, io.EOF
+}
+
+type csvReader struct {
+	r       *csv.Reader
+	columns map[string]int // 列名 -> 位置（ヘッダを読んだら埋まる）
+	width   int            // ヘッダの列数
+}
+
+func (r *csvReader) read() (transferRecord, int, error) {
+	if r.columns == nil {
+		header, err := r.r.Read()
+		if err == io.EOF {
+			return transferRecord{}, 0, io.EOF
+		}
+		if errors.As(err, new(*csv.ParseError)) {
+			return transferRecord{}, 1, fmt.Errorf("%w: %v", ErrInvalidImportFile, err)
+		}
+		if err != nil {
+			return transferRecord{}, 0, err
+		}
+		r.columns, r.width = map[string]int{}, len(header)
+		for i, name := range header {
+			// Excel などが付ける BOM は列名に含めない
+			r.columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+		}
+		if _, ok := r.columns["title"]; !ok {
+			return transferRecord{}, 1, fmt.Errorf("%w: csv header must have a title column", ErrInvalidImportFile)
+		}
+	}
+
+	fields, err := r.r.Read()
+	var pe *csv.ParseError
+	switch {
+	case errors.As(err, &pe) && errors.Is(pe.Err, csv.ErrFieldCount):
+		return transferRecord{}, pe.StartLine, &rowError{line: pe.StartLine, err: fmt.Errorf("expected %d fields, got %d", r.width, len(fields))}
+	case pe != nil:
+		return transferRecord{}, pe.StartLine, fmt.Errorf("%w: %v", ErrInvalidImportFile, pe)
+	case err != nil:
+		return transferRecord{}, 0, err
+	}
+	line, _ := r.r.FieldPos(0)
+
+	get := func(name string) string {
+		if i, ok := r.columns[name]; ok {
+			return unescapeCSVCell(fields[i])
+		}
+		return ""
+	}
+	rec := transferRecord{
+		Title:      get("title"),
+		DueAt:      strings.TrimSpace(get("due_at")),
+		Priority:   strings.TrimSpace(get("priority")),
+		Notes:      get("notes"),
+		Recurrence: strings.TrimSpace(get("recurrence")),
+	}
+	if s := strings.TrimSpace(get("done")); s != "" {
+		if rec.Done, err = strconv.ParseBool(s); err != nil {
+			return rec, line, &rowError{line: line, err: fmt.Errorf("done must be true or false: %q", s)}
+		}
+	}
+	return rec, line, nil
+}
+
+// checklistLine は Markdown のチェックリストの 1 行（"- [x] タイトル"。"*" / "+" の箇条書きも受け付ける）
+var checklistLine = regexp.MustCompile(`^\s*[-*+]\s+\[([ xX])\]\s+(.*)$`)
+
+type markdownReader struct {
+	lines *bufio.Scanner
+	line  int
+}
+
+func (r *markdownReader) read() (transferRecord, int, error) {
+	for r.lines.Scan() {
+		r.line++
+		// 見出し・本文などチェックリスト以外の行は読み飛ばす
+		m := checklistLine.FindStringSubmatch(r.lines.Text())
+		if m == nil {
+			continue
+		}
+		return transferRecord{Title: m[2], Done: m[1] != " "}, r.line, nil
+	}
+	if err := scanErr(r.lines); err != nil {
+		return transferRecord{}, r.line, err
+	}
+	return transferRecord{}, r.line, io.EOF
+}
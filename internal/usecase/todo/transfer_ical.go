@@ -0,0 +1,355 @@
+package todo_usecase
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// iCalendar（RFC 5545）の読み書き。Todo 1 件が VTODO 1 つ。
+// 書き出す項目は UID / DTSTAMP / LAST-MODIFIED / SUMMARY / DESCRIPTION / STATUS / DUE / PRIORITY / RRULE。
+// 読み込みでは SUMMARY / DESCRIPTION / STATUS / DUE / PRIORITY / RRULE だけを見る
+// （UID は見ないので、書き出したものを読み込むと別の Todo として作成される）。
+// VTODO 以外の部品（VEVENT・VTIMEZONE など）と、VTODO の中の部品（VALARM など）は読み飛ばす。
+
+const (
+	icalProdID     = "-//hijjiri//grpc-echo todo//EN"
+	icalUIDDomain  = "todo.grpc-echo"
+	icalLineLimit  = 75 // 1 行の最大オクテット数（改行を除く。超える分は折り返す）
+	icalUTCLayout  = "20060102T150405Z"
+	icalTimeLayout = "20060102T150405" // 時差の無い（floating）、または TZID 付きの日時
+	icalDateLayout = "20060102"
+)
+
+// icalPriorities は Priority と PRIORITY（1 が最も高い）の対応。読み込みでは 1〜4 を high、5 を medium、6〜9 を low とする。
+var icalPriorities = map[string]int{"high": 1, "medium": 5, "low": 9}
+
+// ---- 書き出し ----
+
+type icalWriter struct {
+	w   *bufio.Writer
+	now time.Time // 更新日時の無いレコードの DTSTAMP
+	err error     // 最初の書き込みエラー
+}
+
+// newICalWriter は VCALENDAR の始まりを書く（終わりは flush で書く）。
+func newICalWriter(w io.Writer) *icalWriter {
+	iw := &icalWriter{w: bufio.NewWriter(w), now: time.Now()}
+	iw.line("BEGIN:VCALENDAR")
+	iw.line("VERSION:2.0")
+	iw.line("PRODID:" + icalProdID)
+	return iw
+}
+
+func (w *icalWriter) write(rec transferRecord) error {
+	stamp := w.now
+	if !rec.updatedAt.IsZero() {
+		stamp = rec.updatedAt
+	}
+
+	w.line("BEGIN:VTODO")
+	w.line(fmt.Sprintf("UID:%d@%s", rec.id, icalUIDDomain))
+	w.line("DTSTAMP:" + stamp.UTC().Format(icalUTCLayout))
+	if !rec.updatedAt.IsZero() {
+		w.line("LAST-MODIFIED:" + rec.updatedAt.UTC().Format(icalUTCLayout))
+	}
+	w.line("SUMMARY:" + icalTextEscaper.Replace(rec.Title))
+	if rec.Notes != "" {
+		w.line("DESCRIPTION:" + icalTextEscaper.Replace(rec.Notes))
+	}
+	if rec.Done {
+		w.line("STATUS:COMPLETED")
+	} else {
+		w.line("STATUS:NEEDS-ACTION")
+	}
+	if rec.DueAt != "" {
+		// toTransferRecord が RFC 3339 で入れたもの
+		due, err := time.Parse(time.RFC3339, rec.DueAt)
+		if err != nil {
+			return err
+		}
+		// RRULE は DTSTART を起点に展開されるので（RFC 5545 3.8.5.3）、繰り返すものは期限を起点にする
+		if rec.Recurrence != "" {
+			w.line("DTSTART:" + due.UTC().Format(icalUTCLayout))
+		}
+		w.line("DUE:" + due.UTC().Format(icalUTCLayout))
+	}
+	if p, ok := icalPriorities[rec.Priority]; ok {
+		w.line("PRIORITY:" + strconv.Itoa(p))
+	}
+	// 起点（DTSTART）の無い RRULE は出さない
+	if rec.Recurrence != "" && rec.DueAt != "" {
+		w.line("RRULE:" + rec.Recurrence)
+	}
+	w.line("END:VTODO")
+	return w.err
+}
+
+func (w *icalWriter) flush() error {
+	w.line("END:VCALENDAR")
+	if w.err != nil {
+		return w.err
+	}
+	return w.w.Flush()
+}
+
+// line は 1 行を CRLF 付きで書く。icalLineLimit を超える行は、マルチバイト文字の途中を避けて折り返す
+// （続きの行は空白で始まり、その空白も 1 オクテットに数える）。
+func (w *icalWriter) line(s string) {
+	if w.err != nil {
+		return
+	}
+	limit := icalLineLimit
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		w.w.WriteString(s[:cut])
+		w.w.WriteString("\r\n ")
+		s = s[cut:]
+		limit = icalLineLimit - 1
+	}
+	w.w.WriteString(s)
+	_, w.err = w.w.WriteString("\r\n")
+}
+
+// icalTextEscaper は TEXT 型の値のエスケープ（改行は "\n" にする）
+var icalTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+func unescapeICalText(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		if s[i] == 'n' || s[i] == 'N' {
+			b.WriteByte('\n')
+		} else {
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
+
+// ---- 読み込み ----
+
+type icalReader struct {
+	lines   *bufio.Scanner
+	line    int    // 読んだ行の数
+	next    string // 先読みした行（折り返しの続きかどうかを見るため）
+	hasNext bool
+	started bool // BEGIN:VCALENDAR を読んだ
+}
+
+// contentLine は折り返しを戻した 1 行と、その始まりの行番号を返す（空行は読み飛ばす）。
+func (r *icalReader) contentLine() (string, int, bool) {
+	var (
+		b     strings.Builder
+		start int
+	)
+	for {
+		if !r.hasNext {
+			if !r.lines.Scan() {
+				break
+			}
+			r.line++
+			r.next, r.hasNext = strings.TrimSuffix(r.lines.Text(), "\r"), true
+		}
+		s := r.next
+		if start == 0 {
+			r.hasNext = false
+			if s != "" {
+				start = r.line
+				b.WriteString(s)
+			}
+			continue
+		}
+		if s == "" || (s[0] != ' ' && s[0] != '\t') {
+			break
+		}
+		r.hasNext = false
+		b.WriteString(s[1:])
+	}
+	return b.String(), start, start != 0
+}
+
+func (r *icalReader) read() (transferRecord, int, error) {
+	var (
+		rec   transferRecord
+		start int   // BEGIN:VTODO の行（0 なら VTODO の外）
+		depth int   // VTODO の中の部品（VALARM など）の深さ
+		bad   error // VTODO の中で最初に見つけた問題（END:VTODO まで読んでから返す）
+	)
+	for {
+		content, line, ok := r.contentLine()
+		if !ok {
+			break
+		}
+		name, params, value, err := parseContentLine(content)
+		if err != nil {
+			if start == 0 {
+				return rec, line, fmt.Errorf("%w: line %d: %v", ErrInvalidImportFile, line, err)
+			}
+			if depth == 0 && bad == nil {
+				bad = err
+			}
+			continue
+		}
+		if !r.started {
+			if name != "BEGIN" || !strings.EqualFold(value, "VCALENDAR") {
+				return rec, line, fmt.Errorf("%w: not an iCalendar file (BEGIN:VCALENDAR is missing)", ErrInvalidImportFile)
+			}
+			r.started = true
+			continue
+		}
+
+		switch {
+		case start == 0:
+			if name == "BEGIN" && strings.EqualFold(value, "VTODO") {
+				start = line
+			}
+		case name == "BEGIN":
+			depth++
+		case name == "END" && depth > 0:
+			depth--
+		case name == "END":
+			if !strings.EqualFold(value, "VTODO") {
+				return rec, line, fmt.Errorf("%w: line %d: END:%s inside VTODO", ErrInvalidImportFile, line, value)
+			}
+			if bad != nil {
+				return rec, start, &rowError{line: start, err: bad}
+			}
+			return rec, start, nil
+		case depth == 0 && bad == nil:
+			bad = rec.setICalProperty(name, params, value)
+		}
+	}
+	if err := scanErr(r.lines); err != nil {
+		return transferRecord{}, r.line, err
+	}
+	if start != 0 {
+		return transferRecord{}, start, fmt.Errorf("%w: VTODO at line %d is not closed", ErrInvalidImportFile, start)
+	}
+	return transferRecord{}, r.line, io.EOF
+}
+
+// setICalProperty は VTODO の 1 つのプロパティを rec に入れる。使わないプロパティは無視する。
+func (rec *transferRecord) setICalProperty(name string, params map[string]string, value string) error {
+	switch name {
+	case "SUMMARY":
+		rec.Title = unescapeICalText(value)
+	case "DESCRIPTION":
+		rec.Notes = unescapeICalText(value)
+	case "STATUS":
+		rec.Done = strings.EqualFold(value, "COMPLETED")
+	case "DUE":
+		due, err := parseICalTime(value, params)
+		if err != nil {
+			return err
+		}
+		rec.DueAt = due.UTC().Format(time.RFC3339)
+	case "PRIORITY":
+		p, err := strconv.Atoi(value)
+		switch {
+		case err != nil || p < 0 || p > 9:
+			return fmt.Errorf("PRIORITY must be 0 to 9: %q", value)
+		case p == 0: // 未設定
+			rec.Priority = ""
+		case p < 5:
+			rec.Priority = "high"
+		case p == 5:
+			rec.Priority = "medium"
+		default:
+			rec.Priority = "low"
+		}
+	case "RRULE":
+		rec.Recurrence = value
+	}
+	return nil
+}
+
+// parseICalTime は DATE-TIME / DATE の値を読む。
+// 時差の無い日時は TZID があればそのタイムゾーン、無ければ UTC とし、日付だけのものはその日の 0 時（UTC）とする。
+func parseICalTime(value string, params map[string]string) (time.Time, error) {
+	if strings.EqualFold(params["VALUE"], "DATE") || len(value) == len(icalDateLayout) {
+		t, err := time.Parse(icalDateLayout, value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("DUE must be a date or date-time: %q", value)
+		}
+		return t, nil
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(icalUTCLayout, value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("DUE must be a date or date-time: %q", value)
+		}
+		return t, nil
+	}
+
+	loc := time.UTC
+	if tz := params["TZID"]; tz != "" {
+		var err error
+		if loc, err = time.LoadLocation(tz); err != nil {
+			return time.Time{}, fmt.Errorf("unknown TZID: %q", tz)
+		}
+	}
+	t, err := time.ParseInLocation(icalTimeLayout, value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("DUE must be a date or date-time: %q", value)
+	}
+	return t, nil
+}
+
+// parseContentLine は "NAME;PARAM=VALUE:値" を分ける（名前・パラメータ名は大文字にする）。
+// パラメータの値は '"' で囲まれていれば中の ';' / ':' を区切りとみなさない。
+func parseContentLine(s string) (name string, params map[string]string, value string, err error) {
+	errMalformed := fmt.Errorf("malformed line: %q", s)
+
+	i := strings.IndexAny(s, ";:")
+	if i <= 0 {
+		return "", nil, "", errMalformed
+	}
+	name, rest := strings.ToUpper(s[:i]), s[i:]
+	for len(rest) > 0 && rest[0] == ';' {
+		rest = rest[1:]
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 {
+			return "", nil, "", errMalformed
+		}
+		key := strings.ToUpper(rest[:eq])
+		rest = rest[eq+1:]
+
+		var val string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.IndexByte(rest[1:], '"')
+			if end < 0 {
+				return "", nil, "", errMalformed
+			}
+			val, rest = rest[1:1+end], rest[end+2:]
+		} else {
+			end := strings.IndexAny(rest, ";:")
+			if end < 0 {
+				return "", nil, "", errMalformed
+			}
+			val, rest = rest[:end], rest[end:]
+		}
+		if params == nil {
+			params = map[string]string{}
+		}
+		params[key] = val
+	}
+	if len(rest) == 0 || rest[0] != ':' {
+		return "", nil, "", errMalformed
+	}
+	return name, params, rest[1:], nil
+}
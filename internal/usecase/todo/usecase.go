@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"time"
 
 	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
 	"go.opentelemetry.io/otel"
@@ -49,19 +51,319 @@ type TxManager interface {
 
 // --------- 公開インターフェース ---------
 
+// Usecase の各メソッドは呼び出し元の identity（ownerID = JWT の sub）を受け取り、
+// その所有者の Todo だけを読み書きする。他人の Todo は ErrNotFound として扱う
+// （共有された Todo・リストは、役割の範囲で読み書きできる。share.go）。
 type Usecase interface {
-	Create(ctx context.Context, title string) (*domain_todo.Todo, error)
-	List(ctx context.Context) ([]*domain_todo.Todo, error)
-	Delete(ctx context.Context, id int64) error
-	Update(ctx context.Context, id int64, title string, done bool) (*domain_todo.Todo, error)
+	Create(ctx context.Context, ownerID string, p CreateParams) (*domain_todo.Todo, error)
+	Get(ctx context.Context, ownerID string, id int64) (*domain_todo.Todo, error)
+	List(ctx context.Context, ownerID string, p ListParams) (*ListResult, error)
+	Delete(ctx context.Context, ownerID string, id int64, expectedVersion int64) error
+	Update(ctx context.Context, ownerID string, id int64, p UpdateParams) (*domain_todo.Todo, error)
+
+	// ---- ゴミ箱（Delete は論理削除） ----
+	ListDeleted(ctx context.Context, ownerID string, p ListParams) (*ListResult, error)
+	Restore(ctx context.Context, ownerID string, id int64) (*domain_todo.Todo, error)
+	Purge(ctx context.Context, ownerID string, id int64) error
+
+	// ---- ラベル ----
+	ListLabels(ctx context.Context, ownerID string) ([]*domain_todo.Label, error)
+	CreateLabel(ctx context.Context, ownerID string, p LabelParams) (*domain_todo.Label, error)
+	UpdateLabel(ctx context.Context, ownerID string, id int64, p LabelUpdateParams) (*domain_todo.Label, error)
+	DeleteLabel(ctx context.Context, ownerID string, id int64) error
+
+	// AttachLabels / DetachLabels は Todo にラベルを付け外しする（Todo の version が進む）。
+	// 付いているものを付ける・付いていないものを外すのはエラーにしない。
+	AttachLabels(ctx context.Context, ownerID string, todoID int64, labelIDs []int64, expectedVersion int64) (*domain_todo.Todo, error)
+	DetachLabels(ctx context.Context, ownerID string, todoID int64, labelIDs []int64, expectedVersion int64) (*domain_todo.Todo, error)
+
+	// ---- リスト ----
+	ListTodoLists(ctx context.Context, ownerID string, includeArchived bool) ([]*domain_todo.TodoList, error)
+	GetTodoList(ctx context.Context, ownerID string, id int64) (*domain_todo.TodoList, error)
+	CreateTodoList(ctx context.Context, ownerID, name string) (*domain_todo.TodoList, error)
+	UpdateTodoList(ctx context.Context, ownerID string, id int64, p TodoListUpdateParams) (*domain_todo.TodoList, error)
+	// DeleteTodoList は Todo が残っているリストを削除しない（ErrListNotEmpty）。
+	DeleteTodoList(ctx context.Context, ownerID string, id int64) error
+
+	// MoveTodo は Todo を別のリストに移す、または並び順の中で別の Todo の前後に移す（MoveParams）。
+	// アーカイブ済みのリストには移せない。
+	MoveTodo(ctx context.Context, ownerID string, id int64, p MoveParams) (*domain_todo.Todo, error)
+
+	// ---- チェックリスト（いずれも Todo の version が進み、Todo 全体を返す） ----
+	AddChecklistItem(ctx context.Context, ownerID string, todoID int64, title string, expectedVersion int64) (*domain_todo.Todo, error)
+	ToggleChecklistItem(ctx context.Context, ownerID string, todoID, itemID int64, done bool, expectedVersion int64) (*domain_todo.Todo, error)
+	RemoveChecklistItem(ctx context.Context, ownerID string, todoID, itemID int64, expectedVersion int64) (*domain_todo.Todo, error)
+	// ReorderChecklistItems の itemIDs は全項目をちょうど 1 回ずつ含むこと。
+	ReorderChecklistItems(ctx context.Context, ownerID string, todoID int64, itemIDs []int64, expectedVersion int64) (*domain_todo.Todo, error)
+
+	// ---- 担当者（Todo の version が進み、Todo 全体を返す） ----
+	// AssignTodo は assigneeID（ユーザーとしていること）を担当者にする。UnassignTodo は担当者を外す。
+	// 担当の Todo の一覧は List の ListFilter.AssignedToMe で取る。
+	AssignTodo(ctx context.Context, ownerID string, id int64, assigneeID string, expectedVersion int64) (*domain_todo.Todo, error)
+	UnassignTodo(ctx context.Context, ownerID string, id int64, expectedVersion int64) (*domain_todo.Todo, error)
+
+	// ---- コメント（Todo の version は進まない） ----
+	// AddComment は Todo に ownerID のコメントを書く。EditComment は書いた本人だけ、
+	// DeleteComment は書いた本人と Todo の所有者（RoleOwner で共有された人を含む）ができる。
+	AddComment(ctx context.Context, ownerID string, todoID int64, body string) (*domain_todo.Comment, error)
+	EditComment(ctx context.Context, ownerID string, todoID, id int64, body string) (*domain_todo.Comment, error)
+	DeleteComment(ctx context.Context, ownerID string, todoID, id int64) error
+	// ListComments は Todo のコメントを古い順に返す（Todo が見える人なら誰でも）。
+	ListComments(ctx context.Context, ownerID string, todoID int64, p CommentListParams) (*CommentListResult, error)
+
+	// ---- 一括操作（MaxBatchSize 件まで、結果は入力と同じ順） ----
+	BatchCreate(ctx context.Context, ownerID string, items []CreateParams, mode BatchMode) ([]BatchResult, error)
+	BatchUpdate(ctx context.Context, ownerID string, items []BatchUpdateItem, mode BatchMode) ([]BatchResult, error)
+	BatchDelete(ctx context.Context, ownerID string, items []BatchDeleteItem, mode BatchMode) ([]BatchResult, error)
+
+	// ListTodoHistory は Todo の変更履歴を新しい順に返す（ゴミ箱の中・物理削除済みの Todo も見られる）。
+	ListTodoHistory(ctx context.Context, ownerID string, todoID int64, p HistoryListParams) (*HistoryListResult, error)
+
+	// Watch はコミットされた変更（作成・更新・削除）の購読を始める。resumeToken を渡すとその続きから流す。
+	// 続きを流せなければ最初からになる（Watcher.Resumed が false）。他人・不正なトークンは ErrInvalidResumeToken。
+	Watch(ctx context.Context, ownerID string, resumeToken string) (*Watcher, error)
+
+	// Search はタイトルとメモを全文検索し、関連度の高い順に一致箇所の抜粋付きで返す（ゴミ箱の中は含めない）。
+	Search(ctx context.Context, ownerID string, p SearchParams) (*SearchResult, error)
+
+	// Export は Todo（ゴミ箱の中は除く）を並び順で f の形式にして w に書く。戻り値は書いた件数。
+	Export(ctx context.Context, ownerID string, f TransferFormat, w io.Writer) (int, error)
+	// Import は 1 行 1 件の Todo を末尾に作成する（ImportBatchSize 件ずつの Tx）。検証できなかった行は飛ばして結果に載せる。
+	Import(ctx context.Context, ownerID string, r io.Reader, p ImportParams) (*ImportResult, error)
+
+	// ---- カレンダーのフィード（iCalendar。期限のある Todo だけ） ----
+	// IssueFeedToken はフィードトークンを発行する（前のトークンは使えなくなる）。トークンは発行したときにしか分からない。
+	IssueFeedToken(ctx context.Context, ownerID string) (string, error)
+	// RevokeFeedToken はフィードトークンを無効にする。発行していなければ ErrFeedTokenNotFound。
+	RevokeFeedToken(ctx context.Context, ownerID string) error
+	// CalendarFeed は Bearer トークンの代わりにフィードトークンで所有者を決めて、Export と同じく w に書く。
+	CalendarFeed(ctx context.Context, feedToken string, w io.Writer) (int, error)
+
+	// ---- 共有（r は Todo 1 件かリスト 1 つ） ----
+	// AddCollaborator は r を granteeID に role で共有する。ChangeCollaboratorRole / RemoveCollaborator と同じく、
+	// 所有者と RoleOwner で共有された人だけができる（共有された本人は自分の分の RemoveCollaborator もできる）。
+	AddCollaborator(ctx context.Context, ownerID string, r domain_todo.Resource, granteeID string, role domain_todo.Role) (*domain_todo.Grant, error)
+	ChangeCollaboratorRole(ctx context.Context, ownerID string, r domain_todo.Resource, granteeID string, role domain_todo.Role) (*domain_todo.Grant, error)
+	RemoveCollaborator(ctx context.Context, ownerID string, r domain_todo.Resource, granteeID string) error
+	// ListCollaborators は r の共有を返す（r が見える人なら誰でも）。
+	ListCollaborators(ctx context.Context, ownerID string, r domain_todo.Resource) ([]*domain_todo.Grant, error)
+	// ListSharedWithMe は ownerID に共有されているものを新しい順に返す。
+	ListSharedWithMe(ctx context.Context, ownerID string) ([]*domain_todo.Grant, error)
+
+	// PurgeExpired は owner を跨いで、retention より前にゴミ箱へ入った Todo を物理削除する。
+	// バックグラウンドの purger 用。戻り値は削除した件数。
+	PurgeExpired(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+// CreateParams は作成の入力。Title 以外は任意（ゼロ値なら未設定）。
+type CreateParams struct {
+	Title    string
+	DueAt    time.Time
+	Priority domain_todo.Priority
+	Notes    string
+	ListID   int64 // 0 ならインボックス
+
+	AutoComplete bool
+	Recurrence   domain_todo.Recurrence // ゼロ値なら繰り返さない
+}
+
+// MoveParams は MoveTodo の入力。
+// BeforeID / AfterID のどちらかを指定すると、その Todo の直前 / 直後に並べ、その Todo と同じリストに入れる
+// （このとき ListID は見ない）。どちらも 0 なら並び順はそのままで ListID のリストに移す（0 ならインボックス）。
+type MoveParams struct {
+	ListID   int64
+	BeforeID int64
+	AfterID  int64
+
+	// 0 以外なら、保存済みの version と一致するときだけ移動する（楽観ロック）
+	ExpectedVersion int64
 }
 
-// usecase は Read/Write 両方の Repository を持ち、TxManager と logger を注入する。
+// ListParams は一覧取得の入力（絞り込み・並び替え・ページング）。
+type ListParams struct {
+	Filter    domain_todo.ListFilter
+	OrderBy   string // "created_at desc" など。空なら position 昇順（手動の並び順）
+	PageSize  int    // 0 なら DefaultPageSize、MaxPageSize を超える値は MaxPageSize に丸める
+	PageToken string // 前回の ListResult.NextPageToken（空なら先頭から）。条件を変えたら使えない
+}
+
+// ListResult は一覧取得の結果。
+type ListResult struct {
+	Todos         []*domain_todo.Todo
+	NextPageToken string // 空なら最終ページ
+}
+
+// UpdateParams は部分更新の入力。nil のフィールドは変更しない（FieldMask に含まれないもの）。
+type UpdateParams struct {
+	Title    *string
+	Done     *bool
+	DueAt    *time.Time // ゼロ値を指定すると期限なしに戻す
+	Priority *domain_todo.Priority
+	Notes    *string
+	LabelIDs *[]int64 // 付いているラベルをこれで置き換える（空なら全て外す）
+
+	AutoComplete *bool
+	Recurrence   *domain_todo.Recurrence // ゼロ値を指定すると繰り返しをやめる
+
+	// 0 以外なら、保存済みの version と一致するときだけ更新する（楽観ロック）
+	ExpectedVersion int64
+}
+
+// applyTo は指定されたフィールドだけを t に反映する（ドメインのルールを通す）。
+func (p UpdateParams) applyTo(t *domain_todo.Todo) error {
+	if p.Title != nil {
+		if err := t.ChangeTitle(*p.Title); err != nil {
+			return err
+		}
+	}
+	// AutoComplete を先に反映する（有効にしただけで完了になることがある）
+	if p.AutoComplete != nil {
+		t.SetAutoComplete(*p.AutoComplete)
+	}
+	if p.Done != nil {
+		if err := t.MarkDone(*p.Done); err != nil {
+			return err
+		}
+	}
+	if p.DueAt != nil {
+		if err := t.ChangeDueAt(*p.DueAt); err != nil {
+			return err
+		}
+	}
+	if p.Priority != nil {
+		if err := t.ChangePriority(*p.Priority); err != nil {
+			return err
+		}
+	}
+	if p.Notes != nil {
+		if err := t.ChangeNotes(*p.Notes); err != nil {
+			return err
+		}
+	}
+	if p.Recurrence != nil {
+		if err := t.ChangeRecurrence(*p.Recurrence); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// purgeBatchSize は PurgeExpired が 1 Tx で消す最大件数（ロックを長く持たないため）
+const purgeBatchSize = 500
+
+// ページサイズの既定値と上限（サーバ側で強制する）
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 500
+)
+
+// usecase は Read/Write/Label/History の Repository を持ち、TxManager と logger を注入する。
 type usecase struct {
-	readRepo  domain_todo.ReadRepository
-	writeRepo domain_todo.WriteRepository
-	tx        TxManager
-	logger    *zap.Logger
+	readRepo      domain_todo.ReadRepository
+	writeRepo     domain_todo.WriteRepository
+	labelRepo     domain_todo.LabelRepository
+	historyRepo   domain_todo.HistoryRepository
+	listRepo      domain_todo.TodoListRepository  // nil ならリストは使えない（todoLists 参照）
+	broker        domain_todo.EventBroker         // nil なら Watch は使えない
+	searchIndex   domain_todo.SearchIndex         // nil なら Search は使えない
+	feedTokenRepo domain_todo.FeedTokenRepository // nil ならフィードトークンは使えない
+	grantRepo     domain_todo.GrantRepository     // nil なら共有は使えない（自分の Todo だけ）
+	userDirectory domain_todo.UserDirectory       // nil なら担当者は割り当てられない
+	commentRepo   domain_todo.CommentRepository   // nil ならコメントは使えない
+	tx            TxManager
+	logger        *zap.Logger
+	pageToken     pageTokenCodec
+	audit         auditContext
+}
+
+// Option は New の任意設定。
+type Option func(*options)
+
+type options struct {
+	pageTokenKey  []byte
+	listRepo      domain_todo.TodoListRepository
+	audit         auditContext
+	broker        domain_todo.EventBroker
+	searchIndex   domain_todo.SearchIndex
+	feedTokenRepo domain_todo.FeedTokenRepository
+	grantRepo     domain_todo.GrantRepository
+	userDirectory domain_todo.UserDirectory
+	commentRepo   domain_todo.CommentRepository
+}
+
+// WithPageTokenKey は page_token の署名鍵を設定する。
+// 複数レプリカで同じトークンを使えるよう、本番では全レプリカで同じ値を渡すこと。
+// 未設定の場合はプロセスごとのランダム鍵になる。
+func WithPageTokenKey(key []byte) Option {
+	return func(o *options) {
+		o.pageTokenKey = key
+	}
+}
+
+// WithTodoListRepository はリスト（TodoList 集約）の Repository を設定する。
+// 未設定の場合、リストの操作と ListID を指定した作成・移動は内部エラーになる。
+func WithTodoListRepository(repo domain_todo.TodoListRepository) Option {
+	return func(o *options) {
+		o.listRepo = repo
+	}
+}
+
+// WithAuditContext は変更履歴に残す操作者・リクエスト ID を ctx から取り出す関数を設定する
+// （gRPC では grpcadapter.UserIDFromContext / RequestIDFromContext）。
+// usecase から interface 層を参照しないよう、関数として外から渡す。
+// 未設定・値が無い場合、操作者は Todo の所有者、リクエスト ID は空になる。
+func WithAuditContext(actor, requestID func(ctx context.Context) (string, bool)) Option {
+	return func(o *options) {
+		o.audit = auditContext{actor: actor, requestID: requestID}
+	}
+}
+
+// WithEventBroker は変更を配信するブローカーを設定する。コミットした変更を Publish し、Watch で購読できるようになる。
+// 未設定の場合、変更は配信されず、Watch は内部エラーになる。
+func WithEventBroker(b domain_todo.EventBroker) Option {
+	return func(o *options) {
+		o.broker = b
+	}
+}
+
+// WithSearchIndex は全文検索の索引を設定する。コミットした変更を索引に反映し、Search で検索できるようになる。
+// 未設定の場合、Search は内部エラーになる。
+func WithSearchIndex(idx domain_todo.SearchIndex) Option {
+	return func(o *options) {
+		o.searchIndex = idx
+	}
+}
+
+// WithFeedTokenRepository はカレンダーのフィードトークンの Repository を設定する。
+// 未設定の場合、フィードトークンの発行・無効化とフィードトークンでの読み出しは内部エラーになる。
+func WithFeedTokenRepository(repo domain_todo.FeedTokenRepository) Option {
+	return func(o *options) {
+		o.feedTokenRepo = repo
+	}
+}
+
+// WithGrantRepository は共有（コラボレーター）の Repository を設定する。
+// 未設定の場合、共有の操作は内部エラーになり、他人の Todo・リストは今まで通り見えない。
+func WithGrantRepository(repo domain_todo.GrantRepository) Option {
+	return func(o *options) {
+		o.grantRepo = repo
+	}
+}
+
+// WithUserDirectory は担当者の存在確認に使うユーザーのディレクトリを設定する。
+// 未設定の場合、AssignTodo は内部エラーになる（UnassignTodo と担当での絞り込みは使える）。
+func WithUserDirectory(dir domain_todo.UserDirectory) Option {
+	return func(o *options) {
+		o.userDirectory = dir
+	}
+}
+
+// WithCommentRepository はコメントの Repository を設定する。
+// 未設定の場合、コメントの操作は内部エラーになる。
+func WithCommentRepository(repo domain_todo.CommentRepository) Option {
+	return func(o *options) {
+		o.commentRepo = repo
+	}
 }
 
 // nopTxManager は「Tx を貼らずにそのまま実行するだけ」の実装。
@@ -74,7 +376,7 @@ func (nopTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) e
 
 // New は Todo Usecase を構築する。
 // TxManager が nil の場合は nopTxManager を使う。
-func New(repo domain_todo.Repository, tx TxManager, logger *zap.Logger) Usecase {
+func New(repo domain_todo.Repository, tx TxManager, logger *zap.Logger, opts ...Option) Usecase {
 	if logger == nil {
 		logger = zap.NewNop()
 	}
@@ -82,11 +384,30 @@ func New(repo domain_todo.Repository, tx TxManager, logger *zap.Logger) Usecase
 		tx = nopTxManager{}
 	}
 
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.broker != nil || o.searchIndex != nil {
+		tx = &publishingTxManager{inner: tx, broker: o.broker, searchIndex: o.searchIndex, logger: logger}
+	}
+
 	return &usecase{
-		readRepo:  repo,
-		writeRepo: repo,
-		tx:        tx,
-		logger:    logger,
+		readRepo:      repo,
+		writeRepo:     repo,
+		labelRepo:     repo,
+		historyRepo:   repo,
+		listRepo:      o.listRepo,
+		broker:        o.broker,
+		searchIndex:   o.searchIndex,
+		feedTokenRepo: o.feedTokenRepo,
+		grantRepo:     o.grantRepo,
+		userDirectory: o.userDirectory,
+		commentRepo:   o.commentRepo,
+		tx:            tx,
+		logger:        logger,
+		pageToken:     newPageTokenCodec(o.pageTokenKey),
+		audit:         o.audit,
 	}
 }
 
@@ -95,33 +416,101 @@ func New(repo domain_todo.Repository, tx TxManager, logger *zap.Logger) Usecase
 var (
 	ErrEmptyTitle = domain_todo.ErrEmptyTitle
 	ErrInvalidID  = domain_todo.ErrInvalidID
-	ErrNotFound   = errors.New("todo not found")
+	ErrEmptyOwner = domain_todo.ErrEmptyOwner
+	ErrNotFound   = domain_todo.ErrNotFound
+
+	ErrVersionMismatch = domain_todo.ErrVersionMismatch
+
+	ErrInvalidPriority   = domain_todo.ErrInvalidPriority
+	ErrDueBeforeCreation = domain_todo.ErrDueBeforeCreation
+	ErrNotesTooLong      = domain_todo.ErrNotesTooLong
+
+	ErrEmptyLabelName     = domain_todo.ErrEmptyLabelName
+	ErrLabelNameTooLong   = domain_todo.ErrLabelNameTooLong
+	ErrInvalidLabelColor  = domain_todo.ErrInvalidLabelColor
+	ErrLabelNotFound      = domain_todo.ErrLabelNotFound
+	ErrLabelAlreadyExists = domain_todo.ErrLabelAlreadyExists
+	ErrTooManyLabels      = domain_todo.ErrTooManyLabels
+	ErrEmptyLabelIDs      = errors.New("label ids must not be empty")
+
+	ErrEmptyListName   = domain_todo.ErrEmptyListName
+	ErrListNameTooLong = domain_todo.ErrListNameTooLong
+	ErrListNotFound    = domain_todo.ErrListNotFound
+	ErrListArchived    = domain_todo.ErrListArchived
+	ErrListNotEmpty    = domain_todo.ErrListNotEmpty
+
+	ErrEmptyItemTitle      = domain_todo.ErrEmptyItemTitle
+	ErrItemTitleTooLong    = domain_todo.ErrItemTitleTooLong
+	ErrItemNotFound        = domain_todo.ErrItemNotFound
+	ErrTooManyItems        = domain_todo.ErrTooManyItems
+	ErrInvalidItemOrder    = domain_todo.ErrInvalidItemOrder
+	ErrChecklistIncomplete = domain_todo.ErrChecklistIncomplete
+
+	ErrInvalidMoveAnchor = domain_todo.ErrInvalidMoveAnchor
+
+	ErrInvalidRecurrence = domain_todo.ErrInvalidRecurrence
+
+	ErrInvalidOrderBy = domain_todo.ErrInvalidOrderBy
+	ErrInvalidFilter  = domain_todo.ErrInvalidFilter
+
+	ErrInvalidPageSize  = errors.New("page size must not be negative")
+	ErrInvalidPageToken = errors.New("invalid page token")
 )
 
 // --------- 実装 ---------
 
-func (u *usecase) Create(ctx context.Context, title string) (*domain_todo.Todo, error) {
+func (u *usecase) Create(ctx context.Context, ownerID string, p CreateParams) (*domain_todo.Todo, error) {
 	// ドメインのコンストラクタでバリデーション
-	t, err := domain_todo.NewTodo(title)
+	t, err := newTodo(ownerID, p)
 	if err != nil {
 		// ErrEmptyTitle のようなドメインエラーを usecase エラーにマッピングする場合はここで。
-		if errors.Is(err, domain_todo.ErrEmptyTitle) {
+		switch {
+		case errors.Is(err, domain_todo.ErrEmptyOwner):
+			return nil, ErrEmptyOwner
+		case errors.Is(err, domain_todo.ErrEmptyTitle):
 			return nil, ErrEmptyTitle
 		}
 		return nil, err
 	}
+	if p.ListID < 0 {
+		return nil, ErrInvalidID
+	}
 
 	var created *domain_todo.Todo
 
-	// 書き込み系なので Tx を貼る
+	// 書き込み系なので Tx を貼る（リストの確認・末尾の位置の決定も同じ Tx で行う）
 	err = u.tx.WithinTx(ctx, func(txCtx context.Context) error {
+		if p.ListID != 0 {
+			// 共有されたリストに作る Todo はリストの所有者のもの
+			listOwner, err := u.authorizeList(txCtx, ownerID, p.ListID, domain_todo.RoleEditor)
+			if err != nil {
+				return err
+			}
+			t.OwnerID = listOwner
+		}
+		if err := u.moveTo(txCtx, t, p.ListID); err != nil {
+			return err
+		}
+
+		last, err := u.writeRepo.LastPosition(txCtx, t.OwnerID)
+		if err != nil {
+			return err
+		}
+		t.Position = domain_todo.PositionAfter(last)
+
 		var repoErr error
-		created, repoErr = u.writeRepo.Create(txCtx, t)
-		return repoErr
+		if created, repoErr = u.writeRepo.Create(txCtx, t); repoErr != nil {
+			return repoErr
+		}
+		return u.recordHistory(txCtx, domain_todo.HistoryActionCreate, t.OwnerID, created.ID, nil, created.Snapshot())
 	})
+	if inputErr := inputError(err, createInputErrors); inputErr != nil {
+		return nil, inputErr
+	}
 	if err != nil {
 		u.logger.Error("failed to create todo",
-			zap.String("title", title),
+			zap.String("owner_id", ownerID),
+			zap.String("title", p.Title),
 			zap.Error(err),
 		)
 		return nil, fmt.Errorf("create todo: %w", err)
@@ -134,16 +523,137 @@ func (u *usecase) Create(ctx context.Context, title string) (*domain_todo.Todo,
 
 	u.logger.Info("todo created (usecase)",
 		zap.Int64("id", created.ID),
+		zap.String("owner_id", created.OwnerID),
 		zap.String("title", created.Title),
 	)
 
 	return created, nil
 }
 
-func (u *usecase) List(ctx context.Context) ([]*domain_todo.Todo, error) {
-	list, err := u.readRepo.List(ctx)
+// createInputErrors は Create が Tx の中で見つける入力値のエラー（リストの確認）。
+var createInputErrors = []error{ErrListNotFound, ErrListArchived, ErrPermissionDenied}
+
+// newTodo は NewTodo に任意項目を載せる。各項目のルールはドメイン側のメソッドで確認する。
+func newTodo(ownerID string, p CreateParams) (*domain_todo.Todo, error) {
+	t, err := domain_todo.NewTodo(ownerID, p.Title)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.ChangeDueAt(p.DueAt); err != nil {
+		return nil, err
+	}
+	if err := t.ChangePriority(p.Priority); err != nil {
+		return nil, err
+	}
+	if err := t.ChangeNotes(p.Notes); err != nil {
+		return nil, err
+	}
+	t.SetAutoComplete(p.AutoComplete)
+	if err := t.ChangeRecurrence(p.Recurrence); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (u *usecase) Get(ctx context.Context, ownerID string, id int64) (*domain_todo.Todo, error) {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return nil, ErrEmptyOwner
+	}
+	if err := domain_todo.ValidateID(id); err != nil {
+		return nil, ErrInvalidID
+	}
+
+	todoOwner, err := u.authorizeTodo(ctx, ownerID, id, domain_todo.RoleViewer)
+	var t *domain_todo.Todo
+	if err == nil {
+		t, err = u.readRepo.Get(ctx, todoOwner, id)
+	}
+	if errors.Is(err, domain_todo.ErrNotFound) {
+		return nil, ErrNotFound
+	}
 	if err != nil {
-		u.logger.Error("failed to list todos", zap.Error(err))
+		u.logger.Error("failed to get todo",
+			zap.String("owner_id", ownerID),
+			zap.Int64("id", id),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("get todo: %w", err)
+	}
+
+	return t, nil
+}
+
+func (u *usecase) List(ctx context.Context, ownerID string, p ListParams) (*ListResult, error) {
+	p.Filter.Deleted = false
+	return u.list(ctx, ownerID, p)
+}
+
+// ListDeleted はゴミ箱の一覧。条件・ページングは List と同じ。
+func (u *usecase) ListDeleted(ctx context.Context, ownerID string, p ListParams) (*ListResult, error) {
+	p.Filter.Deleted = true
+	return u.list(ctx, ownerID, p)
+}
+
+func (u *usecase) list(ctx context.Context, ownerID string, p ListParams) (*ListResult, error) {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return nil, ErrEmptyOwner
+	}
+
+	pageSize, err := normalizePageSize(p.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	orderBy, err := domain_todo.ParseOrderBy(p.OrderBy)
+	if err != nil {
+		return nil, ErrInvalidOrderBy
+	}
+	if err := p.Filter.Validate(); err != nil {
+		return nil, ErrInvalidFilter
+	}
+	if len(p.Filter.LabelIDs) > 0 {
+		// 指定順・重複の違いでページトークンが変わらないよう正規化しておく
+		p.Filter.LabelIDs, _ = domain_todo.NormalizeLabelIDs(p.Filter.LabelIDs)
+	}
+	fingerprint := queryFingerprint(p.Filter, orderBy)
+
+	q := domain_todo.ListQuery{
+		Filter:  p.Filter,
+		OrderBy: orderBy,
+		// 1 件多めに取って「次ページがあるか」を判定する
+		Limit: pageSize + 1,
+		Now:   time.Now(),
+	}
+	if p.PageToken != "" {
+		cur, err := u.pageToken.decode(p.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		// 他人のトークン・条件を変えた後のトークンは使えない
+		if cur.OwnerID != ownerID || cur.Query != fingerprint {
+			return nil, ErrInvalidPageToken
+		}
+		q.After = cur.listCursor()
+	}
+
+	// 共有されたリストを指定した一覧は、リストの所有者の Todo から探す（ページトークンは呼び出し元のもの）。
+	// 担当での絞り込みは、見えるかどうかも含めて呼び出し元で探す
+	repoOwner := ownerID
+	if p.Filter.ListID != nil && *p.Filter.ListID > 0 && !p.Filter.Deleted && !p.Filter.AssignedToMe {
+		repoOwner, err = u.authorizeList(ctx, ownerID, *p.Filter.ListID, domain_todo.RoleViewer)
+		if errors.Is(err, ErrListNotFound) {
+			return nil, err
+		}
+	}
+	var list []*domain_todo.Todo
+	if err == nil {
+		list, err = u.readRepo.List(ctx, repoOwner, q)
+	}
+	if err != nil {
+		u.logger.Error("failed to list todos",
+			zap.String("owner_id", ownerID),
+			zap.Error(err),
+		)
 		return nil, fmt.Errorf("list todos: %w", err)
 	}
 
@@ -152,82 +662,552 @@ func (u *usecase) List(ctx context.Context) ([]*domain_todo.Todo, error) {
 		metric.WithAttributes(attribute.String("source", "grpc")),
 	)
 
+	res := &ListResult{Todos: list}
+	if len(list) > pageSize {
+		res.Todos = list[:pageSize]
+		last := res.Todos[pageSize-1]
+		res.NextPageToken, err = u.pageToken.encode(newPageCursor(ownerID, fingerprint, orderBy.CursorOf(last)))
+		if err != nil {
+			return nil, fmt.Errorf("encode page token: %w", err)
+		}
+	}
+
 	u.logger.Info("todos listed (usecase)",
-		zap.Int("count", len(list)),
+		zap.String("owner_id", ownerID),
+		zap.Bool("deleted", p.Filter.Deleted),
+		zap.String("order_by", orderBy.String()),
+		zap.Int("count", len(res.Todos)),
+		zap.Bool("has_next", res.NextPageToken != ""),
 	)
 
-	return list, nil
+	return res, nil
 }
 
-func (u *usecase) Delete(ctx context.Context, id int64) error {
+// normalizePageSize は 0 をデフォルトに、上限超えを上限に丸める。負数はエラー。
+func normalizePageSize(size int) (int, error) {
+	switch {
+	case size < 0:
+		return 0, ErrInvalidPageSize
+	case size == 0:
+		return DefaultPageSize, nil
+	case size > MaxPageSize:
+		return MaxPageSize, nil
+	default:
+		return size, nil
+	}
+}
+
+func (u *usecase) Delete(ctx context.Context, ownerID string, id int64, expectedVersion int64) error {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return ErrEmptyOwner
+	}
 	if err := domain_todo.ValidateID(id); err != nil {
 		return ErrInvalidID
 	}
 
-	var deleted bool
-
 	// 書き込み系なので Tx を貼る
 	err := u.tx.WithinTx(ctx, func(txCtx context.Context) error {
-		var repoErr error
-		deleted, repoErr = u.writeRepo.Delete(txCtx, id)
-		return repoErr
+		return u.deleteInTx(txCtx, ownerID, id, expectedVersion)
 	})
+	if inputErr := inputError(err, deleteInputErrors); inputErr != nil {
+		return inputErr
+	}
 	if err != nil {
 		u.logger.Error("failed to delete todo",
+			zap.String("owner_id", ownerID),
 			zap.Int64("id", id),
 			zap.Error(err),
 		)
 		return fmt.Errorf("delete todo: %w", err)
 	}
 
+	u.logger.Info("todo deleted (usecase)",
+		zap.String("owner_id", ownerID),
+		zap.Int64("id", id),
+	)
+	return nil
+}
+
+// deleteInputErrors は Delete が入力値のエラーとしてそのまま返すもの。
+var deleteInputErrors = []error{ErrNotFound, ErrVersionMismatch, ErrPermissionDenied}
+
+// deleteInTx は Todo 1 件をゴミ箱へ移す（Delete / BatchDelete で共通）。WithinTx の中で呼ぶこと。
+// 履歴に削除前の状態を残すので、行をロックして読んでから（version 指定があれば比較してから）消す。
+func (u *usecase) deleteInTx(txCtx context.Context, ownerID string, id, expectedVersion int64) error {
+	ownerID, err := u.authorizeTodo(txCtx, ownerID, id, domain_todo.RoleOwner)
+	if err != nil {
+		return err
+	}
+	t, err := u.writeRepo.GetForUpdate(txCtx, ownerID, id)
+	if err != nil {
+		return err
+	}
+	if err := t.CheckVersion(expectedVersion); err != nil {
+		return err
+	}
+
+	deleted, err := u.writeRepo.Delete(txCtx, ownerID, id)
+	if err != nil {
+		return err
+	}
 	if !deleted {
-		return ErrNotFound
+		return domain_todo.ErrNotFound
 	}
+	return u.recordHistory(txCtx, domain_todo.HistoryActionDelete, ownerID, id, t.Snapshot(), nil)
+}
 
-	u.logger.Info("todo deleted (usecase)", zap.Int64("id", id))
-	return nil
+func (u *usecase) Update(ctx context.Context, ownerID string, id int64, p UpdateParams) (*domain_todo.Todo, error) {
+	if err := validateTodoRef(ownerID, id); err != nil {
+		return nil, err
+	}
+	// Tx を貼る前に弾けるものは弾く
+	p, err := p.normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	return u.modify(ctx, "update", domain_todo.RoleEditor, ownerID, id, p.ExpectedVersion, p.mutation())
+}
+
+// normalize は Tx を貼らずに確認できるものを確認し、ラベル ID を正規化した写しを返す。
+func (p UpdateParams) normalize() (UpdateParams, error) {
+	if p.Title != nil && *p.Title == "" {
+		return p, ErrEmptyTitle
+	}
+	if p.LabelIDs != nil {
+		ids, err := domain_todo.NormalizeLabelIDs(*p.LabelIDs)
+		if err != nil {
+			return p, err
+		}
+		p.LabelIDs = &ids
+	}
+	return p, nil
+}
+
+// mutation は p を反映する modify 用の変更を返す。
+func (p UpdateParams) mutation() mutation {
+	return func(_ context.Context, t *domain_todo.Todo) ([]int64, error) {
+		if err := p.applyTo(t); err != nil {
+			return nil, err
+		}
+		if p.LabelIDs != nil {
+			return *p.LabelIDs, nil
+		}
+		return nil, nil
+	}
 }
 
-func (u *usecase) Update(ctx context.Context, id int64, title string, done bool) (*domain_todo.Todo, error) {
+// MoveTodo はリストの確認（存在・アーカイブ）・位置の決定と Todo の保存を同じ Tx で行う。
+// 基準の Todo と隣の Todo は行ロックを取って読むので、同じ隙間への同時の移動は直列になる
+// （ロック順の違いでデッドロックした場合は TxManager が Tx ごとやり直す）。
+func (u *usecase) MoveTodo(ctx context.Context, ownerID string, id int64, p MoveParams) (*domain_todo.Todo, error) {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return nil, ErrEmptyOwner
+	}
 	if err := domain_todo.ValidateID(id); err != nil {
 		return nil, ErrInvalidID
 	}
-
-	t := &domain_todo.Todo{
-		ID:   id,
-		Done: done,
+	if p.ListID < 0 || p.BeforeID < 0 || p.AfterID < 0 {
+		return nil, ErrInvalidID
+	}
+	if (p.BeforeID != 0 && p.AfterID != 0) || p.BeforeID == id || p.AfterID == id {
+		return nil, ErrInvalidMoveAnchor
 	}
 
-	if err := t.ChangeTitle(title); err != nil {
-		if errors.Is(err, domain_todo.ErrEmptyTitle) {
-			return nil, ErrEmptyTitle
+	return u.modify(ctx, "move", domain_todo.RoleOwner, ownerID, id, p.ExpectedVersion, func(txCtx context.Context, t *domain_todo.Todo) ([]int64, error) {
+		if p.BeforeID == 0 && p.AfterID == 0 {
+			return nil, u.moveTo(txCtx, t, p.ListID)
 		}
+
+		anchorID, before := p.AfterID, false
+		if p.BeforeID != 0 {
+			anchorID, before = p.BeforeID, true
+		}
+		anchor, err := u.writeRepo.GetForUpdate(txCtx, t.OwnerID, anchorID)
+		if err != nil {
+			return nil, err
+		}
+		if err := u.moveTo(txCtx, t, anchor.ListID); err != nil {
+			return nil, err
+		}
+
+		t.Position, err = u.positionNextTo(txCtx, anchor, t.ID, before)
 		return nil, err
+	})
+}
+
+// positionNextTo は anchor の直前（before=true）または直後に置くときの位置を決める。
+// 隣との間に隙間が無ければ、所有者の Todo 全体を振り直してからもう一度だけ計算する。
+func (u *usecase) positionNextTo(txCtx context.Context, anchor *domain_todo.Todo, movingID int64, before bool) (int64, error) {
+	for rebalanced := false; ; rebalanced = true {
+		neighbor, found, err := u.writeRepo.NeighborPosition(txCtx, anchor, movingID, before)
+		if err != nil {
+			return 0, err
+		}
+		if !found {
+			if before {
+				return domain_todo.PositionBefore(anchor.Position), nil
+			}
+			return domain_todo.PositionAfter(anchor.Position), nil
+		}
+
+		lo, hi := anchor.Position, neighbor
+		if before {
+			lo, hi = neighbor, anchor.Position
+		}
+		if pos, ok := domain_todo.PositionBetween(lo, hi); ok {
+			return pos, nil
+		}
+		if rebalanced {
+			// 振り直した直後なら必ず隙間があるはず
+			return 0, fmt.Errorf("no room between positions %d and %d after rebalance", lo, hi)
+		}
+
+		if err := u.writeRepo.RebalancePositions(txCtx, anchor.OwnerID); err != nil {
+			return 0, err
+		}
+		// anchor の position も変わっているので読み直す
+		if anchor, err = u.writeRepo.GetForUpdate(txCtx, anchor.OwnerID, anchor.ID); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// moveTo は t を listID のリストに移す（0 ならインボックス）。
+// リストの行は FOR UPDATE で読むので、同時にアーカイブ・削除されることはない。WithinTx の中で呼ぶこと。
+func (u *usecase) moveTo(txCtx context.Context, t *domain_todo.Todo, listID int64) error {
+	if listID == 0 {
+		return t.MoveTo(nil)
 	}
 
+	lists, err := u.todoLists()
+	if err != nil {
+		return err
+	}
+	l, err := lists.GetTodoListForUpdate(txCtx, t.OwnerID, listID)
+	if err != nil {
+		return err
+	}
+	return t.MoveTo(l)
+}
+
+// mutation は modify が Tx 内でロックした行に対して行う変更。txCtx は同じ Tx で他の行を読むときに使う。
+// 戻り値の labelIDs が nil でなければ、付いているラベルをそれで置き換える。
+type mutation func(txCtx context.Context, t *domain_todo.Todo) (labelIDs []int64, err error)
+
+// modify は Todo 1 件の read-modify-write を 1 つの Tx で行う（Update / ラベルの付け外しで共通）。
+// 呼び出し元が need 以上の役割を持つことを確かめ、現在の行をロックして読み、version を確認してから mutate を適用して保存する。
+// ラベルだけを変えた場合も Todo の行を保存し直して version を進める（ETag が変わるように）。
+// 返すのはリクエストの写しではなく、同じ Tx 内で読み直した「実際に保存された行」。
+func (u *usecase) modify(ctx context.Context, op string, need domain_todo.Role, ownerID string, id, expectedVersion int64, mutate mutation) (*domain_todo.Todo, error) {
 	var updated *domain_todo.Todo
 
-	// 書き込み系なので Tx を貼る
 	err := u.tx.WithinTx(ctx, func(txCtx context.Context) error {
-		var repoErr error
-		updated, repoErr = u.writeRepo.Update(txCtx, t)
-		return repoErr
+		var err error
+		updated, err = u.modifyInTx(txCtx, need, ownerID, id, expectedVersion, mutate)
+		return err
 	})
+	if inputErr := inputError(err, modifyInputErrors); inputErr != nil {
+		// 入力値のエラーはそのまま返す（ログは不要）
+		return nil, inputErr
+	}
 	if err != nil {
-		u.logger.Error("failed to update todo",
+		u.logger.Error("failed to "+op+" todo",
+			zap.String("owner_id", ownerID),
 			zap.Int64("id", id),
-			zap.String("title", title),
-			zap.Bool("done", done),
 			zap.Error(err),
 		)
-		return nil, fmt.Errorf("update todo: %w", err)
+		return nil, fmt.Errorf("%s todo: %w", op, err)
 	}
 
 	u.logger.Info("todo updated (usecase)",
+		zap.String("op", op),
 		zap.Int64("id", updated.ID),
 		zap.String("title", updated.Title),
 		zap.Bool("done", updated.Done),
+		zap.Int64s("label_ids", updated.LabelIDs()),
+		zap.Int64("list_id", updated.ListID),
+		zap.Int64("position", updated.Position),
+		zap.Int64("version", updated.Version),
 	)
 
 	return updated, nil
 }
+
+// modifyInputErrors は modify が入力値のエラーとしてそのまま返すもの。
+var modifyInputErrors = []error{
+	ErrNotFound,
+	ErrEmptyTitle,
+	ErrVersionMismatch,
+	ErrListNotFound,
+	ErrListArchived,
+	ErrInvalidPriority,
+	ErrDueBeforeCreation,
+	ErrNotesTooLong,
+	ErrInvalidRecurrence,
+	ErrLabelNotFound,
+	ErrTooManyLabels,
+	ErrEmptyItemTitle,
+	ErrItemTitleTooLong,
+	ErrItemNotFound,
+	ErrTooManyItems,
+	ErrInvalidItemOrder,
+	ErrChecklistIncomplete,
+	ErrPermissionDenied,
+	ErrInvalidAssignee,
+	ErrAssigneeNotFound,
+}
+
+// inputError は err が known のどれかに当たれば、その（usecase レベルの）エラーを返す。当たらなければ nil。
+func inputError(err error, known []error) error {
+	if err == nil {
+		return nil
+	}
+	for _, e := range known {
+		if errors.Is(err, e) {
+			return e
+		}
+	}
+	return nil
+}
+
+// modifyInTx は modify の Tx の中身（BatchUpdate と共通）。WithinTx の中で呼ぶこと。
+func (u *usecase) modifyInTx(txCtx context.Context, need domain_todo.Role, ownerID string, id, expectedVersion int64, mutate mutation) (*domain_todo.Todo, error) {
+	ownerID, err := u.authorizeTodo(txCtx, ownerID, id, need)
+	if err != nil {
+		return nil, err
+	}
+	t, err := u.writeRepo.GetForUpdate(txCtx, ownerID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.CheckVersion(expectedVersion); err != nil {
+		return nil, err
+	}
+
+	before := t.Snapshot()
+	wasDone := t.Done
+	labelIDs, err := mutate(txCtx, t)
+	if err != nil {
+		return nil, err
+	}
+	if labelIDs != nil {
+		if err := u.labelRepo.SetTodoLabels(txCtx, ownerID, id, labelIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	// 繰り返しの Todo が完了になったら（チェックリストの自動完了を含む）、次の回を同じ Tx で作る
+	if !wasDone && t.Done && t.IsRecurring() {
+		if labelIDs == nil {
+			labelIDs = t.LabelIDs()
+		}
+		if err := u.createNextOccurrence(txCtx, t, labelIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := u.writeRepo.Update(txCtx, t); err != nil {
+		return nil, err
+	}
+
+	updated, err := u.readRepo.Get(txCtx, ownerID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.recordHistory(txCtx, domain_todo.HistoryActionUpdate, ownerID, id, before, updated.Snapshot()); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// createNextOccurrence は完了にした繰り返しの Todo t から次の回を作り、t のルールを外す。
+// 次の回は末尾に並べ、ラベル（labelIDs）とチェックリスト（未完了に戻したもの）も引き継ぐ。WithinTx の中で呼ぶこと。
+func (u *usecase) createNextOccurrence(txCtx context.Context, t *domain_todo.Todo, labelIDs []int64) error {
+	next, ok := t.NextOccurrence(time.Now())
+	t.StopRecurring()
+	if !ok {
+		// UNTIL を過ぎた
+		return nil
+	}
+
+	last, err := u.writeRepo.LastPosition(txCtx, t.OwnerID)
+	if err != nil {
+		return err
+	}
+	next.Position = domain_todo.PositionAfter(last)
+
+	created, err := u.writeRepo.Create(txCtx, next)
+	if err != nil {
+		return err
+	}
+	if len(labelIDs) > 0 {
+		if err := u.labelRepo.SetTodoLabels(txCtx, t.OwnerID, created.ID, labelIDs); err != nil {
+			return err
+		}
+	}
+	if len(created.Items) > 0 {
+		if err := u.writeRepo.SaveItems(txCtx, created); err != nil {
+			return err
+		}
+	}
+	if err := u.recordHistory(txCtx, domain_todo.HistoryActionCreate, t.OwnerID, created.ID, nil, created.Snapshot()); err != nil {
+		return err
+	}
+
+	u.logger.Info("next occurrence created (usecase)",
+		zap.Int64("id", created.ID),
+		zap.Int64("previous_id", t.ID),
+		zap.Time("due_at", created.DueAt),
+		zap.String("recurrence", created.Recurrence.String()),
+	)
+	return nil
+}
+
+func (u *usecase) Restore(ctx context.Context, ownerID string, id int64) (*domain_todo.Todo, error) {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return nil, ErrEmptyOwner
+	}
+	if err := domain_todo.ValidateID(id); err != nil {
+		return nil, ErrInvalidID
+	}
+
+	var restored *domain_todo.Todo
+
+	// 書き込み系なので Tx を貼る（戻した行を同じ Tx で読み直して返す）
+	err := u.tx.WithinTx(ctx, func(txCtx context.Context) error {
+		todoOwner, err := u.authorizeTodo(txCtx, ownerID, id, domain_todo.RoleOwner)
+		if err != nil {
+			return err
+		}
+		ok, err := u.writeRepo.Restore(txCtx, todoOwner, id)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return domain_todo.ErrNotFound
+		}
+
+		if restored, err = u.readRepo.Get(txCtx, todoOwner, id); err != nil {
+			return err
+		}
+		return u.recordHistory(txCtx, domain_todo.HistoryActionRestore, todoOwner, id, nil, restored.Snapshot())
+	})
+	switch {
+	case errors.Is(err, domain_todo.ErrNotFound):
+		return nil, ErrNotFound
+	case errors.Is(err, ErrPermissionDenied):
+		return nil, ErrPermissionDenied
+	}
+	if err != nil {
+		u.logger.Error("failed to restore todo",
+			zap.String("owner_id", ownerID),
+			zap.Int64("id", id),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("restore todo: %w", err)
+	}
+
+	u.logger.Info("todo restored (usecase)",
+		zap.String("owner_id", ownerID),
+		zap.Int64("id", id),
+	)
+	return restored, nil
+}
+
+// purgeInputErrors は Purge が入力値のエラーとしてそのまま返すもの。
+var purgeInputErrors = []error{ErrNotFound, ErrPermissionDenied}
+
+func (u *usecase) Purge(ctx context.Context, ownerID string, id int64) error {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return ErrEmptyOwner
+	}
+	if err := domain_todo.ValidateID(id); err != nil {
+		return ErrInvalidID
+	}
+
+	var purged bool
+
+	// 書き込み系なので Tx を貼る
+	err := u.tx.WithinTx(ctx, func(txCtx context.Context) error {
+		todoOwner, err := u.authorizeTodo(txCtx, ownerID, id, domain_todo.RoleOwner)
+		if err != nil {
+			return err
+		}
+		if purged, err = u.writeRepo.Purge(txCtx, todoOwner, id); err != nil || !purged {
+			return err
+		}
+		if err := u.deleteGrants(txCtx, domain_todo.ResourceTodo, id); err != nil {
+			return err
+		}
+		return u.recordHistory(txCtx, domain_todo.HistoryActionPurge, todoOwner, id, nil, nil)
+	})
+	if inputErr := inputError(err, purgeInputErrors); inputErr != nil {
+		return inputErr
+	}
+	if err != nil {
+		u.logger.Error("failed to purge todo",
+			zap.String("owner_id", ownerID),
+			zap.Int64("id", id),
+			zap.Error(err),
+		)
+		return fmt.Errorf("purge todo: %w", err)
+	}
+
+	// ゴミ箱に無い（未削除・存在しない・他人の Todo）場合は NotFound
+	if !purged {
+		return ErrNotFound
+	}
+
+	u.logger.Info("todo purged (usecase)",
+		zap.String("owner_id", ownerID),
+		zap.Int64("id", id),
+	)
+	return nil
+}
+
+func (u *usecase) PurgeExpired(ctx context.Context, retention time.Duration) (int64, error) {
+	before := time.Now().Add(-retention)
+
+	// 1 Tx あたりの件数を抑えて、残りが無くなるまで繰り返す
+	var total int64
+	for {
+		var n int64
+		err := u.tx.WithinTx(ctx, func(txCtx context.Context) error {
+			purged, err := u.writeRepo.PurgeDeletedBefore(txCtx, before, purgeBatchSize)
+			if err != nil {
+				return err
+			}
+			n = int64(len(purged))
+			ids := make([]int64, 0, len(purged))
+			for _, t := range purged {
+				if err := u.recordHistoryAs(txCtx, domain_todo.SystemActor, domain_todo.HistoryActionPurge, t.OwnerID, t.ID, nil, nil); err != nil {
+					return err
+				}
+				ids = append(ids, t.ID)
+			}
+			return u.deleteGrants(txCtx, domain_todo.ResourceTodo, ids...)
+		})
+		if err != nil {
+			u.logger.Error("failed to purge expired todos",
+				zap.Time("before", before),
+				zap.Int64("purged_so_far", total),
+				zap.Error(err),
+			)
+			return total, fmt.Errorf("purge expired todos: %w", err)
+		}
+
+		total += n
+		if n < purgeBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		u.logger.Info("expired todos purged (usecase)",
+			zap.Time("before", before),
+			zap.Int64("count", total),
+		)
+	}
+	return total, nil
+}
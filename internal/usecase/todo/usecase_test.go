@@ -1,8 +1,18 @@
 package todo_usecase
 
 import (
+	"cmp"
 	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
+	"unicode/utf8"
 
 	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
 	"go.uber.org/zap"
@@ -12,9 +22,29 @@ import (
 type mockRepo struct {
 	// 挙動を制御するためのフィールド
 	createFn func(ctx context.Context, t *domain_todo.Todo) (*domain_todo.Todo, error)
-	listFn   func(ctx context.Context) ([]*domain_todo.Todo, error)
-	deleteFn func(ctx context.Context, id int64) (bool, error)
+	getFn    func(ctx context.Context, ownerID string, id int64) (*domain_todo.Todo, error)
+	listFn   func(ctx context.Context, ownerID string, q domain_todo.ListQuery) ([]*domain_todo.Todo, error)
+	deleteFn func(ctx context.Context, ownerID string, id int64) (bool, error)
 	updateFn func(ctx context.Context, t *domain_todo.Todo) (*domain_todo.Todo, error)
+
+	restoreFn            func(ctx context.Context, ownerID string, id int64) (bool, error)
+	purgeFn              func(ctx context.Context, ownerID string, id int64) (bool, error)
+	purgeDeletedBeforeFn func(ctx context.Context, before time.Time, limit int) ([]*domain_todo.Todo, error)
+	saveItemsFn          func(ctx context.Context, t *domain_todo.Todo) error
+
+	lastPositionFn       func(ctx context.Context, ownerID string) (int64, error)
+	neighborPositionFn   func(ctx context.Context, anchor *domain_todo.Todo, excludeID int64, before bool) (int64, bool, error)
+	rebalancePositionsFn func(ctx context.Context, ownerID string) error
+
+	createLabelFn   func(ctx context.Context, l *domain_todo.Label) (*domain_todo.Label, error)
+	getLabelFn      func(ctx context.Context, ownerID string, id int64) (*domain_todo.Label, error)
+	updateLabelFn   func(ctx context.Context, l *domain_todo.Label) (*domain_todo.Label, error)
+	setTodoLabelsFn func(ctx context.Context, ownerID string, todoID int64, labelIDs []int64) error
+
+	appendHistoryFn func(ctx context.Context, e *domain_todo.HistoryEntry) error
+	listHistoryFn   func(ctx context.Context, ownerID string, todoID int64, q domain_todo.HistoryQuery) ([]*domain_todo.HistoryEntry, error)
+
+	createManyFn func(ctx context.Context, todos []*domain_todo.Todo) ([]*domain_todo.Todo, error)
 }
 
 func (m *mockRepo) Create(ctx context.Context, t *domain_todo.Todo) (*domain_todo.Todo, error) {
@@ -24,16 +54,41 @@ func (m *mockRepo) Create(ctx context.Context, t *domain_todo.Todo) (*domain_tod
 	return t, nil
 }
 
-func (m *mockRepo) List(ctx context.Context) ([]*domain_todo.Todo, error) {
+// CreateMany は createManyFn が無ければ 1 件ずつ Create に回す
+func (m *mockRepo) CreateMany(ctx context.Context, todos []*domain_todo.Todo) ([]*domain_todo.Todo, error) {
+	if m.createManyFn != nil {
+		return m.createManyFn(ctx, todos)
+	}
+	for _, t := range todos {
+		if _, err := m.Create(ctx, t); err != nil {
+			return nil, err
+		}
+	}
+	return todos, nil
+}
+
+func (m *mockRepo) Get(ctx context.Context, ownerID string, id int64) (*domain_todo.Todo, error) {
+	if m.getFn != nil {
+		return m.getFn(ctx, ownerID, id)
+	}
+	return &domain_todo.Todo{ID: id, OwnerID: ownerID}, nil
+}
+
+// GetForUpdate はロックの有無以外 Get と同じなので getFn を共用する
+func (m *mockRepo) GetForUpdate(ctx context.Context, ownerID string, id int64) (*domain_todo.Todo, error) {
+	return m.Get(ctx, ownerID, id)
+}
+
+func (m *mockRepo) List(ctx context.Context, ownerID string, q domain_todo.ListQuery) ([]*domain_todo.Todo, error) {
 	if m.listFn != nil {
-		return m.listFn(ctx)
+		return m.listFn(ctx, ownerID, q)
 	}
 	return []*domain_todo.Todo{}, nil
 }
 
-func (m *mockRepo) Delete(ctx context.Context, id int64) (bool, error) {
+func (m *mockRepo) Delete(ctx context.Context, ownerID string, id int64) (bool, error) {
 	if m.deleteFn != nil {
-		return m.deleteFn(ctx, id)
+		return m.deleteFn(ctx, ownerID, id)
 	}
 	return true, nil
 }
@@ -45,6 +100,105 @@ func (m *mockRepo) Update(ctx context.Context, t *domain_todo.Todo) (*domain_tod
 	return t, nil
 }
 
+func (m *mockRepo) Restore(ctx context.Context, ownerID string, id int64) (bool, error) {
+	if m.restoreFn != nil {
+		return m.restoreFn(ctx, ownerID, id)
+	}
+	return true, nil
+}
+
+func (m *mockRepo) Purge(ctx context.Context, ownerID string, id int64) (bool, error) {
+	if m.purgeFn != nil {
+		return m.purgeFn(ctx, ownerID, id)
+	}
+	return true, nil
+}
+
+func (m *mockRepo) PurgeDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*domain_todo.Todo, error) {
+	if m.purgeDeletedBeforeFn != nil {
+		return m.purgeDeletedBeforeFn(ctx, before, limit)
+	}
+	return nil, nil
+}
+
+func (m *mockRepo) SaveItems(ctx context.Context, t *domain_todo.Todo) error {
+	if m.saveItemsFn != nil {
+		return m.saveItemsFn(ctx, t)
+	}
+	return nil
+}
+
+func (m *mockRepo) LastPosition(ctx context.Context, ownerID string) (int64, error) {
+	if m.lastPositionFn != nil {
+		return m.lastPositionFn(ctx, ownerID)
+	}
+	return 0, nil
+}
+
+func (m *mockRepo) NeighborPosition(ctx context.Context, anchor *domain_todo.Todo, excludeID int64, before bool) (int64, bool, error) {
+	if m.neighborPositionFn != nil {
+		return m.neighborPositionFn(ctx, anchor, excludeID, before)
+	}
+	return 0, false, nil
+}
+
+func (m *mockRepo) RebalancePositions(ctx context.Context, ownerID string) error {
+	if m.rebalancePositionsFn != nil {
+		return m.rebalancePositionsFn(ctx, ownerID)
+	}
+	return nil
+}
+
+func (m *mockRepo) ListLabels(ctx context.Context, ownerID string) ([]*domain_todo.Label, error) {
+	return nil, nil
+}
+
+func (m *mockRepo) GetLabel(ctx context.Context, ownerID string, id int64) (*domain_todo.Label, error) {
+	if m.getLabelFn != nil {
+		return m.getLabelFn(ctx, ownerID, id)
+	}
+	return &domain_todo.Label{ID: id, OwnerID: ownerID, Name: "label"}, nil
+}
+
+func (m *mockRepo) CreateLabel(ctx context.Context, l *domain_todo.Label) (*domain_todo.Label, error) {
+	if m.createLabelFn != nil {
+		return m.createLabelFn(ctx, l)
+	}
+	return l, nil
+}
+
+func (m *mockRepo) UpdateLabel(ctx context.Context, l *domain_todo.Label) (*domain_todo.Label, error) {
+	if m.updateLabelFn != nil {
+		return m.updateLabelFn(ctx, l)
+	}
+	return l, nil
+}
+
+func (m *mockRepo) DeleteLabel(ctx context.Context, ownerID string, id int64) (bool, error) {
+	return true, nil
+}
+
+func (m *mockRepo) SetTodoLabels(ctx context.Context, ownerID string, todoID int64, labelIDs []int64) error {
+	if m.setTodoLabelsFn != nil {
+		return m.setTodoLabelsFn(ctx, ownerID, todoID, labelIDs)
+	}
+	return nil
+}
+
+func (m *mockRepo) AppendHistory(ctx context.Context, e *domain_todo.HistoryEntry) error {
+	if m.appendHistoryFn != nil {
+		return m.appendHistoryFn(ctx, e)
+	}
+	return nil
+}
+
+func (m *mockRepo) ListHistory(ctx context.Context, ownerID string, todoID int64, q domain_todo.HistoryQuery) ([]*domain_todo.HistoryEntry, error) {
+	if m.listHistoryFn != nil {
+		return m.listHistoryFn(ctx, ownerID, todoID, q)
+	}
+	return nil, nil
+}
+
 func TestUsecase_Create_Success(t *testing.T) {
 	t.Parallel()
 
@@ -58,7 +212,7 @@ func TestUsecase_Create_Success(t *testing.T) {
 
 	uc := New(repo, nil, zap.NewNop())
 
-	got, err := uc.Create(context.Background(), "テストタイトル")
+	got, err := uc.Create(context.Background(), "user-1", CreateParams{Title: "テストタイトル"})
 	if err != nil {
 		t.Fatalf("Create returned error: %v", err)
 	}
@@ -66,6 +220,9 @@ func TestUsecase_Create_Success(t *testing.T) {
 	if got.ID != 1 {
 		t.Errorf("expected ID=1, got %d", got.ID)
 	}
+	if got.OwnerID != "user-1" {
+		t.Errorf("expected OwnerID=%q, got %q", "user-1", got.OwnerID)
+	}
 	if got.Title != "テストタイトル" {
 		t.Errorf("expected Title=%q, got %q", "テストタイトル", got.Title)
 	}
@@ -80,7 +237,7 @@ func TestUsecase_Create_EmptyTitle(t *testing.T) {
 	repo := &mockRepo{}
 	uc := New(repo, nil, zap.NewNop())
 
-	_, err := uc.Create(context.Background(), "")
+	_, err := uc.Create(context.Background(), "user-1", CreateParams{})
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -89,11 +246,80 @@ func TestUsecase_Create_EmptyTitle(t *testing.T) {
 	}
 }
 
+func TestUsecase_Create_EmptyOwner(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockRepo{
+		createFn: func(ctx context.Context, td *domain_todo.Todo) (*domain_todo.Todo, error) {
+			t.Error("repository must not be called without owner")
+			return td, nil
+		},
+	}
+	uc := New(repo, nil, zap.NewNop())
+
+	_, err := uc.Create(context.Background(), "", CreateParams{Title: "タイトル"})
+	if err != ErrEmptyOwner {
+		t.Errorf("expected ErrEmptyOwner, got %v", err)
+	}
+}
+
+func TestUsecase_Get_Success(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockRepo{
+		getFn: func(ctx context.Context, ownerID string, id int64) (*domain_todo.Todo, error) {
+			if ownerID != "user-1" || id != 5 {
+				t.Errorf("unexpected args: ownerID=%q id=%d", ownerID, id)
+			}
+			return &domain_todo.Todo{ID: id, OwnerID: ownerID, Title: "A"}, nil
+		},
+	}
+	uc := New(repo, nil, zap.NewNop())
+
+	got, err := uc.Get(context.Background(), "user-1", 5)
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if got.ID != 5 || got.Title != "A" {
+		t.Errorf("unexpected todo: %#v", got)
+	}
+}
+
+func TestUsecase_Get_NotFound(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockRepo{
+		getFn: func(ctx context.Context, ownerID string, id int64) (*domain_todo.Todo, error) {
+			return nil, domain_todo.ErrNotFound
+		},
+	}
+	uc := New(repo, nil, zap.NewNop())
+
+	_, err := uc.Get(context.Background(), "user-1", 5)
+	if err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestUsecase_Get_InvalidID(t *testing.T) {
+	t.Parallel()
+
+	uc := New(&mockRepo{}, nil, zap.NewNop())
+
+	_, err := uc.Get(context.Background(), "user-1", 0)
+	if err != ErrInvalidID {
+		t.Errorf("expected ErrInvalidID, got %v", err)
+	}
+}
+
 func TestUsecase_List_Success(t *testing.T) {
 	t.Parallel()
 
 	repo := &mockRepo{
-		listFn: func(ctx context.Context) ([]*domain_todo.Todo, error) {
+		listFn: func(ctx context.Context, ownerID string, q domain_todo.ListQuery) ([]*domain_todo.Todo, error) {
+			if ownerID != "user-1" {
+				t.Errorf("expected ownerID=user-1, got %q", ownerID)
+			}
 			return []*domain_todo.Todo{
 				{ID: 1, Title: "A", Done: false},
 				{ID: 2, Title: "B", Done: true},
@@ -103,24 +329,172 @@ func TestUsecase_List_Success(t *testing.T) {
 
 	uc := New(repo, nil, zap.NewNop())
 
-	list, err := uc.List(context.Background())
+	res, err := uc.List(context.Background(), "user-1", ListParams{})
 	if err != nil {
 		t.Fatalf("List returned error: %v", err)
 	}
 
+	list := res.Todos
 	if len(list) != 2 {
 		t.Fatalf("expected 2 todos, got %d", len(list))
 	}
 	if list[0].Title != "A" || list[1].Title != "B" {
 		t.Errorf("unexpected titles: %#v", list)
 	}
+	if res.NextPageToken != "" {
+		t.Errorf("expected no next page token, got %q", res.NextPageToken)
+	}
+}
+
+// pagedRepo は ID 1..n の Todo を持つ疑似 Repository（keyset ページングを再現する）
+func pagedRepo(n int64) *mockRepo {
+	return &mockRepo{
+		listFn: func(ctx context.Context, ownerID string, q domain_todo.ListQuery) ([]*domain_todo.Todo, error) {
+			var after int64
+			if q.After != nil {
+				after = q.After.ID
+			}
+
+			var out []*domain_todo.Todo
+			for id := after + 1; id <= n; id++ {
+				if q.Limit > 0 && len(out) == q.Limit {
+					break
+				}
+				out = append(out, &domain_todo.Todo{ID: id, OwnerID: ownerID})
+			}
+			return out, nil
+		},
+	}
+}
+
+func TestUsecase_List_Pagination(t *testing.T) {
+	t.Parallel()
+
+	uc := New(pagedRepo(5), nil, zap.NewNop(), WithPageTokenKey([]byte("test-key")))
+
+	var (
+		ids   []int64
+		token string
+	)
+	for pages := 0; ; pages++ {
+		if pages > 5 {
+			t.Fatal("too many pages")
+		}
+		res, err := uc.List(context.Background(), "user-1", ListParams{PageSize: 2, PageToken: token})
+		if err != nil {
+			t.Fatalf("List returned error: %v", err)
+		}
+		if len(res.Todos) > 2 {
+			t.Fatalf("page too large: %d", len(res.Todos))
+		}
+		for _, td := range res.Todos {
+			ids = append(ids, td.ID)
+		}
+		if res.NextPageToken == "" {
+			break
+		}
+		token = res.NextPageToken
+	}
+
+	if len(ids) != 5 {
+		t.Fatalf("expected 5 todos in total, got %v", ids)
+	}
+	for i, id := range ids {
+		if id != int64(i+1) {
+			t.Errorf("unexpected order: %v", ids)
+			break
+		}
+	}
+}
+
+func TestUsecase_List_PageSize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		pageSize  int
+		wantLimit int
+		wantErr   error
+	}{
+		{name: "default", pageSize: 0, wantLimit: DefaultPageSize + 1},
+		{name: "as is", pageSize: 10, wantLimit: 11},
+		{name: "clamped to max", pageSize: MaxPageSize * 10, wantLimit: MaxPageSize + 1},
+		{name: "negative", pageSize: -1, wantErr: ErrInvalidPageSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotLimit int
+			repo := &mockRepo{
+				listFn: func(ctx context.Context, ownerID string, q domain_todo.ListQuery) ([]*domain_todo.Todo, error) {
+					gotLimit = q.Limit
+					return nil, nil
+				},
+			}
+			uc := New(repo, nil, zap.NewNop())
+
+			_, err := uc.List(context.Background(), "user-1", ListParams{PageSize: tt.pageSize})
+			if err != tt.wantErr {
+				t.Fatalf("expected err=%v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && gotLimit != tt.wantLimit {
+				t.Errorf("expected limit=%d, got %d", tt.wantLimit, gotLimit)
+			}
+		})
+	}
+}
+
+func TestUsecase_List_InvalidPageToken(t *testing.T) {
+	t.Parallel()
+
+	uc := New(pagedRepo(5), nil, zap.NewNop(), WithPageTokenKey([]byte("test-key")))
+
+	res, err := uc.List(context.Background(), "user-1", ListParams{PageSize: 1})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	token := res.NextPageToken
+
+	// 1 文字書き換えたトークン
+	tampered := []byte(token)
+	tampered[0] ^= 1
+
+	// 別の鍵で署名されたトークン
+	other := New(pagedRepo(5), nil, zap.NewNop(), WithPageTokenKey([]byte("other-key")))
+	otherRes, err := other.List(context.Background(), "user-1", ListParams{PageSize: 1})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		ownerID string
+		token   string
+	}{
+		{name: "garbage", ownerID: "user-1", token: "not-a-token"},
+		{name: "tampered", ownerID: "user-1", token: string(tampered)},
+		{name: "other key", ownerID: "user-1", token: otherRes.NextPageToken},
+		{name: "other owner", ownerID: "user-2", token: token},
+	}
+
+	for _, tt := range tests {
+		_, err := uc.List(context.Background(), tt.ownerID, ListParams{PageToken: tt.token})
+		if err != ErrInvalidPageToken {
+			t.Errorf("%s: expected ErrInvalidPageToken, got %v", tt.name, err)
+		}
+	}
 }
 
 func TestUsecase_Delete_Success(t *testing.T) {
 	t.Parallel()
 
 	repo := &mockRepo{
-		deleteFn: func(ctx context.Context, id int64) (bool, error) {
+		deleteFn: func(ctx context.Context, ownerID string, id int64) (bool, error) {
+			if ownerID != "user-1" {
+				t.Errorf("expected ownerID=user-1, got %q", ownerID)
+			}
 			if id != 1 {
 				t.Errorf("expected id=1, got %d", id)
 			}
@@ -130,7 +504,7 @@ func TestUsecase_Delete_Success(t *testing.T) {
 
 	uc := New(repo, nil, zap.NewNop())
 
-	if err := uc.Delete(context.Background(), 1); err != nil {
+	if err := uc.Delete(context.Background(), "user-1", 1, 0); err != nil {
 		t.Fatalf("Delete returned error: %v", err)
 	}
 }
@@ -141,7 +515,7 @@ func TestUsecase_Delete_InvalidID(t *testing.T) {
 	repo := &mockRepo{}
 	uc := New(repo, nil, zap.NewNop())
 
-	err := uc.Delete(context.Background(), 0)
+	err := uc.Delete(context.Background(), "user-1", 0, 0)
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -154,13 +528,13 @@ func TestUsecase_Delete_NotFound(t *testing.T) {
 	t.Parallel()
 
 	repo := &mockRepo{
-		deleteFn: func(ctx context.Context, id int64) (bool, error) {
-			return false, nil // 削除対象なし
+		deleteFn: func(ctx context.Context, ownerID string, id int64) (bool, error) {
+			return false, nil // 削除対象なし（他人の Todo も含む）
 		},
 	}
 	uc := New(repo, nil, zap.NewNop())
 
-	err := uc.Delete(context.Background(), 123)
+	err := uc.Delete(context.Background(), "user-2", 123, 0)
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -169,26 +543,3192 @@ func TestUsecase_Delete_NotFound(t *testing.T) {
 	}
 }
 
+// storingRepo は 1 件だけ保持し、Update で書き換え・Get で読み戻せる疑似 Repository
+func storingRepo(initial *domain_todo.Todo) *mockRepo {
+	stored := *initial
+	return &mockRepo{
+		getFn: func(ctx context.Context, ownerID string, id int64) (*domain_todo.Todo, error) {
+			if id != stored.ID || ownerID != stored.OwnerID {
+				return nil, domain_todo.ErrNotFound
+			}
+			cp := stored
+			return &cp, nil
+		},
+		updateFn: func(ctx context.Context, td *domain_todo.Todo) (*domain_todo.Todo, error) {
+			stored = *td
+			stored.Version++
+			return td, nil
+		},
+	}
+}
+
 func TestUsecase_Update_Success(t *testing.T) {
 	t.Parallel()
 
+	repo := storingRepo(&domain_todo.Todo{ID: 3, OwnerID: "user-1", Title: "元のタイトル"})
+	update := repo.updateFn
+	repo.updateFn = func(ctx context.Context, td *domain_todo.Todo) (*domain_todo.Todo, error) {
+		if td.ID != 3 {
+			t.Errorf("expected id=3, got %d", td.ID)
+		}
+		if td.OwnerID != "user-1" {
+			t.Errorf("expected ownerID=user-1, got %q", td.OwnerID)
+		}
+		return update(ctx, td)
+	}
+
+	uc := New(repo, nil, zap.NewNop())
+
+	title, done := "更新タイトル", true
+	got, err := uc.Update(context.Background(), "user-1", 3, UpdateParams{Title: &title, Done: &done})
+	if err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+
+	if got.ID != 3 || got.Title != "更新タイトル" || !got.Done {
+		t.Errorf("unexpected updated todo: %#v", got)
+	}
+}
+
+func TestUsecase_Update_OtherOwner_NotFound(t *testing.T) {
+	t.Parallel()
+
 	repo := &mockRepo{
+		getFn: func(ctx context.Context, ownerID string, id int64) (*domain_todo.Todo, error) {
+			// 他人の Todo は存在しないものとして扱われる
+			return nil, domain_todo.ErrNotFound
+		},
 		updateFn: func(ctx context.Context, td *domain_todo.Todo) (*domain_todo.Todo, error) {
-			if td.ID != 3 {
-				t.Errorf("expected id=3, got %d", td.ID)
-			}
+			t.Error("Update must not be called for missing todo")
 			return td, nil
 		},
 	}
+	uc := New(repo, nil, zap.NewNop())
+
+	title := "更新タイトル"
+	_, err := uc.Update(context.Background(), "user-2", 3, UpdateParams{Title: &title})
+	if err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestUsecase_Update_Partial(t *testing.T) {
+	t.Parallel()
 
+	repo := storingRepo(&domain_todo.Todo{ID: 3, OwnerID: "user-1", Title: "元のタイトル", Done: false})
 	uc := New(repo, nil, zap.NewNop())
 
-	got, err := uc.Update(context.Background(), 3, "更新タイトル", true)
+	// done だけを指定した場合、title は保存済みの値のまま
+	done := true
+	got, err := uc.Update(context.Background(), "user-1", 3, UpdateParams{Done: &done})
 	if err != nil {
 		t.Fatalf("Update returned error: %v", err)
 	}
-
-	if got.ID != 3 || got.Title != "更新タイトル" || !got.Done {
+	if got.Title != "元のタイトル" || !got.Done {
 		t.Errorf("unexpected updated todo: %#v", got)
 	}
 }
+
+func TestUsecase_Update_EmptyTitle(t *testing.T) {
+	t.Parallel()
+
+	uc := New(&mockRepo{}, nil, zap.NewNop())
+
+	title := ""
+	_, err := uc.Update(context.Background(), "user-1", 3, UpdateParams{Title: &title})
+	if err != ErrEmptyTitle {
+		t.Errorf("expected ErrEmptyTitle, got %v", err)
+	}
+}
+
+func TestUsecase_List_FilterAndOrder(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	var got domain_todo.ListQuery
+	repo := &mockRepo{
+		listFn: func(ctx context.Context, ownerID string, q domain_todo.ListQuery) ([]*domain_todo.Todo, error) {
+			got = q
+			return []*domain_todo.Todo{
+				{ID: 9, CreatedAt: created},
+				{ID: 8, CreatedAt: created.Add(-time.Hour)},
+			}, nil
+		},
+	}
+	uc := New(repo, nil, zap.NewNop(), WithPageTokenKey([]byte("test-key")))
+
+	done := false
+	params := ListParams{
+		Filter:   domain_todo.ListFilter{Done: &done, TitleContains: "foo"},
+		OrderBy:  "created_at DESC",
+		PageSize: 1,
+	}
+	res, err := uc.List(context.Background(), "user-1", params)
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+
+	if got.OrderBy != (domain_todo.OrderBy{Field: domain_todo.OrderByCreatedAt, Desc: true}) {
+		t.Errorf("unexpected order: %+v", got.OrderBy)
+	}
+	if got.Filter.Done == nil || *got.Filter.Done || got.Filter.TitleContains != "foo" {
+		t.Errorf("unexpected filter: %+v", got.Filter)
+	}
+	if res.NextPageToken == "" {
+		t.Fatal("expected next page token")
+	}
+
+	// 次ページは直前ページ最後の行（ID=9）の created_at から続く
+	params.PageToken = res.NextPageToken
+	if _, err := uc.List(context.Background(), "user-1", params); err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if got.After == nil || got.After.ID != 9 || !got.After.Time.Equal(created) {
+		t.Errorf("unexpected cursor: %+v", got.After)
+	}
+
+	// 条件を変えたら同じトークンは使えない
+	params.Filter.TitleContains = "bar"
+	if _, err := uc.List(context.Background(), "user-1", params); err != ErrInvalidPageToken {
+		t.Errorf("expected ErrInvalidPageToken, got %v", err)
+	}
+}
+
+func TestUsecase_List_InvalidQuery(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	tests := []struct {
+		name    string
+		params  ListParams
+		wantErr error
+	}{
+		{name: "unknown field", params: ListParams{OrderBy: "owner_id"}, wantErr: ErrInvalidOrderBy},
+		{name: "unknown direction", params: ListParams{OrderBy: "title up"}, wantErr: ErrInvalidOrderBy},
+		{name: "injection", params: ListParams{OrderBy: "id; DROP TABLE todos"}, wantErr: ErrInvalidOrderBy},
+		{
+			name: "reversed range",
+			params: ListParams{Filter: domain_todo.ListFilter{
+				CreatedAfter:  now,
+				CreatedBefore: now.Add(-time.Hour),
+			}},
+			wantErr: ErrInvalidFilter,
+		},
+		{
+			name:    "negative due window",
+			params:  ListParams{Filter: domain_todo.ListFilter{DueWithinDays: -1}},
+			wantErr: ErrInvalidFilter,
+		},
+		{
+			name:    "overdue and due within",
+			params:  ListParams{Filter: domain_todo.ListFilter{Overdue: true, DueWithinDays: 7}},
+			wantErr: ErrInvalidFilter,
+		},
+		{
+			name:    "overdue and done",
+			params:  ListParams{Filter: domain_todo.ListFilter{Overdue: true, Done: &[]bool{true}[0]}},
+			wantErr: ErrInvalidFilter,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &mockRepo{
+				listFn: func(ctx context.Context, ownerID string, q domain_todo.ListQuery) ([]*domain_todo.Todo, error) {
+					t.Error("repository must not be called for invalid query")
+					return nil, nil
+				},
+			}
+			uc := New(repo, nil, zap.NewNop())
+
+			if _, err := uc.List(context.Background(), "user-1", tt.params); err != tt.wantErr {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_Update_VersionMismatch(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockRepo{
+		getFn: func(ctx context.Context, ownerID string, id int64) (*domain_todo.Todo, error) {
+			return &domain_todo.Todo{ID: id, OwnerID: ownerID, Title: "A", Version: 3}, nil
+		},
+		updateFn: func(ctx context.Context, td *domain_todo.Todo) (*domain_todo.Todo, error) {
+			t.Error("Update must not be called on version mismatch")
+			return td, nil
+		},
+	}
+	uc := New(repo, nil, zap.NewNop())
+
+	done := true
+	_, err := uc.Update(context.Background(), "user-1", 1, UpdateParams{Done: &done, ExpectedVersion: 2})
+	if err != ErrVersionMismatch {
+		t.Errorf("expected ErrVersionMismatch, got %v", err)
+	}
+}
+
+func TestUsecase_Delete_VersionMismatch(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockRepo{
+		getFn: func(ctx context.Context, ownerID string, id int64) (*domain_todo.Todo, error) {
+			return &domain_todo.Todo{ID: id, OwnerID: ownerID, Version: 3}, nil
+		},
+		deleteFn: func(ctx context.Context, ownerID string, id int64) (bool, error) {
+			t.Error("Delete must not be called on version mismatch")
+			return true, nil
+		},
+	}
+	uc := New(repo, nil, zap.NewNop())
+
+	if err := uc.Delete(context.Background(), "user-1", 1, 2); err != ErrVersionMismatch {
+		t.Errorf("expected ErrVersionMismatch, got %v", err)
+	}
+}
+
+func TestUsecase_Update_ReturnsStoredRow(t *testing.T) {
+	t.Parallel()
+
+	repo := storingRepo(&domain_todo.Todo{ID: 3, OwnerID: "user-1", Title: "元のタイトル", Version: 1})
+	update := repo.updateFn
+	repo.updateFn = func(ctx context.Context, td *domain_todo.Todo) (*domain_todo.Todo, error) {
+		if _, err := update(ctx, td); err != nil {
+			return nil, err
+		}
+		// Repository の戻り値は使われないこと（読み直した行が返る）
+		return &domain_todo.Todo{ID: td.ID, Title: "echo"}, nil
+	}
+	uc := New(repo, nil, zap.NewNop())
+
+	done := true
+	got, err := uc.Update(context.Background(), "user-1", 3, UpdateParams{Done: &done})
+	if err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+	if got.Title != "元のタイトル" || !got.Done || got.Version != 2 {
+		t.Errorf("expected stored row, got %#v", got)
+	}
+}
+
+func TestUsecase_Update_NotFoundOnWrite(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockRepo{
+		updateFn: func(ctx context.Context, td *domain_todo.Todo) (*domain_todo.Todo, error) {
+			return nil, domain_todo.ErrNotFound
+		},
+	}
+	uc := New(repo, nil, zap.NewNop())
+
+	done := true
+	if _, err := uc.Update(context.Background(), "user-1", 404, UpdateParams{Done: &done}); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestUsecase_List_ExcludesDeleted(t *testing.T) {
+	t.Parallel()
+
+	var gotDeleted []bool
+	repo := &mockRepo{
+		listFn: func(ctx context.Context, ownerID string, q domain_todo.ListQuery) ([]*domain_todo.Todo, error) {
+			gotDeleted = append(gotDeleted, q.Filter.Deleted)
+			return nil, nil
+		},
+	}
+	uc := New(repo, nil, zap.NewNop())
+
+	// List 側で Deleted を渡されても、ゴミ箱は混ざらないこと
+	p := ListParams{Filter: domain_todo.ListFilter{Deleted: true}}
+	if _, err := uc.List(context.Background(), "user-1", p); err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if _, err := uc.ListDeleted(context.Background(), "user-1", ListParams{}); err != nil {
+		t.Fatalf("ListDeleted returned error: %v", err)
+	}
+
+	if len(gotDeleted) != 2 || gotDeleted[0] || !gotDeleted[1] {
+		t.Errorf("expected Deleted=[false true], got %v", gotDeleted)
+	}
+}
+
+func TestUsecase_ListDeleted_PageTokenNotSharedWithList(t *testing.T) {
+	t.Parallel()
+
+	uc := New(pagedRepo(3), nil, zap.NewNop())
+
+	res, err := uc.ListDeleted(context.Background(), "user-1", ListParams{PageSize: 1})
+	if err != nil {
+		t.Fatalf("ListDeleted returned error: %v", err)
+	}
+	if res.NextPageToken == "" {
+		t.Fatal("expected next page token")
+	}
+
+	// ゴミ箱のトークンで通常の一覧は辿れない
+	if _, err := uc.List(context.Background(), "user-1", ListParams{PageSize: 1, PageToken: res.NextPageToken}); err != ErrInvalidPageToken {
+		t.Errorf("expected ErrInvalidPageToken, got %v", err)
+	}
+}
+
+func TestUsecase_Restore_Success(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockRepo{
+		restoreFn: func(ctx context.Context, ownerID string, id int64) (bool, error) {
+			if ownerID != "user-1" || id != 5 {
+				t.Errorf("unexpected restore args: %q %d", ownerID, id)
+			}
+			return true, nil
+		},
+		getFn: func(ctx context.Context, ownerID string, id int64) (*domain_todo.Todo, error) {
+			return &domain_todo.Todo{ID: id, OwnerID: ownerID, Title: "戻した", Version: 3}, nil
+		},
+	}
+	uc := New(repo, nil, zap.NewNop())
+
+	got, err := uc.Restore(context.Background(), "user-1", 5)
+	if err != nil {
+		t.Fatalf("Restore returned error: %v", err)
+	}
+	if got.ID != 5 || got.Title != "戻した" || got.Version != 3 {
+		t.Errorf("unexpected todo: %#v", got)
+	}
+}
+
+func TestUsecase_Restore_NotFound(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockRepo{
+		restoreFn: func(ctx context.Context, ownerID string, id int64) (bool, error) {
+			return false, nil // ゴミ箱に無い（未削除・他人の Todo も含む）
+		},
+	}
+	uc := New(repo, nil, zap.NewNop())
+
+	if _, err := uc.Restore(context.Background(), "user-1", 5); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if _, err := uc.Restore(context.Background(), "user-1", 0); err != ErrInvalidID {
+		t.Errorf("expected ErrInvalidID, got %v", err)
+	}
+}
+
+func TestUsecase_Purge(t *testing.T) {
+	t.Parallel()
+
+	purged := map[int64]bool{7: true}
+	repo := &mockRepo{
+		purgeFn: func(ctx context.Context, ownerID string, id int64) (bool, error) {
+			if id == 9 {
+				return false, fmt.Errorf("purge todo: %w", domain_todo.ErrNotFound)
+			}
+			return purged[id], nil
+		},
+	}
+	uc := New(repo, nil, zap.NewNop())
+
+	if err := uc.Purge(context.Background(), "user-1", 7); err != nil {
+		t.Errorf("Purge returned error: %v", err)
+	}
+	if err := uc.Purge(context.Background(), "user-1", 8); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	// リポジトリが包んで返した NotFound も、包み直さずに ErrNotFound として返す
+	if err := uc.Purge(context.Background(), "user-1", 9); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestUsecase_PurgeExpired_Batches(t *testing.T) {
+	t.Parallel()
+
+	remaining := int64(purgeBatchSize*2 + 10)
+	calls := 0
+	repo := &mockRepo{
+		purgeDeletedBeforeFn: func(ctx context.Context, before time.Time, limit int) ([]*domain_todo.Todo, error) {
+			calls++
+			if before.After(time.Now().Add(-time.Hour)) {
+				t.Errorf("expected before <= now-retention, got %v", before)
+			}
+			n := min(remaining, int64(limit))
+			remaining -= n
+			purged := make([]*domain_todo.Todo, n)
+			for i := range purged {
+				purged[i] = &domain_todo.Todo{ID: remaining + int64(i) + 1, OwnerID: "user-1"}
+			}
+			return purged, nil
+		},
+	}
+	entries := historyRecorder(repo)
+	uc := New(repo, &txCounter{}, zap.NewNop())
+
+	n, err := uc.PurgeExpired(context.Background(), time.Hour)
+	if err != nil {
+		t.Fatalf("PurgeExpired returned error: %v", err)
+	}
+	if n != purgeBatchSize*2+10 || calls != 3 {
+		t.Errorf("expected %d purged in 3 calls, got %d in %d", purgeBatchSize*2+10, n, calls)
+	}
+
+	// 消した Todo ごとに、操作者を system とした物理削除の履歴が残る
+	if len(*entries) != int(n) {
+		t.Fatalf("expected %d history entries, got %d", n, len(*entries))
+	}
+	for _, e := range *entries {
+		if e.Action != domain_todo.HistoryActionPurge || e.OwnerID != "user-1" || e.Actor != domain_todo.SystemActor || e.Before != nil || e.After != nil {
+			t.Errorf("unexpected history entry %+v", e)
+			break
+		}
+	}
+}
+
+func TestUsecase_Create_WithDetails(t *testing.T) {
+	t.Parallel()
+
+	due := time.Now().Add(48 * time.Hour)
+	repo := &mockRepo{
+		createFn: func(ctx context.Context, td *domain_todo.Todo) (*domain_todo.Todo, error) {
+			if !td.DueAt.Equal(due) || td.Priority != domain_todo.PriorityHigh || td.Notes != "メモ" {
+				t.Errorf("unexpected todo passed to repo: %#v", td)
+			}
+			td.ID = 1
+			return td, nil
+		},
+	}
+	uc := New(repo, nil, zap.NewNop())
+
+	_, err := uc.Create(context.Background(), "user-1", CreateParams{
+		Title:    "期限つき",
+		DueAt:    due,
+		Priority: domain_todo.PriorityHigh,
+		Notes:    "メモ",
+	})
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+}
+
+func TestUsecase_Create_InvalidDetails(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		params  CreateParams
+		wantErr error
+	}{
+		{
+			name:    "priority out of range",
+			params:  CreateParams{Title: "t", Priority: domain_todo.PriorityHigh + 1},
+			wantErr: ErrInvalidPriority,
+		},
+		{
+			name:    "negative priority",
+			params:  CreateParams{Title: "t", Priority: -1},
+			wantErr: ErrInvalidPriority,
+		},
+		{
+			name:    "due before creation",
+			params:  CreateParams{Title: "t", DueAt: time.Now().Add(-time.Hour)},
+			wantErr: ErrDueBeforeCreation,
+		},
+		{
+			name:    "notes too long",
+			params:  CreateParams{Title: "t", Notes: strings.Repeat("あ", domain_todo.MaxNotesLength+1)},
+			wantErr: ErrNotesTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &mockRepo{
+				createFn: func(ctx context.Context, td *domain_todo.Todo) (*domain_todo.Todo, error) {
+					t.Error("repository must not be called for invalid input")
+					return td, nil
+				},
+			}
+			uc := New(repo, nil, zap.NewNop())
+
+			if _, err := uc.Create(context.Background(), "user-1", tt.params); err != tt.wantErr {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_Update_Details(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 4, 1, 9, 0, 0, 0, time.UTC)
+	repo := storingRepo(&domain_todo.Todo{
+		ID: 3, OwnerID: "user-1", Title: "t", CreatedAt: created,
+		DueAt: created.Add(24 * time.Hour), Notes: "そのまま",
+	})
+	uc := New(repo, nil, zap.NewNop())
+
+	// 作成日時より前の期限は不可（現在時刻ではなく created_at と比べる）
+	before := created.Add(-time.Minute)
+	if _, err := uc.Update(context.Background(), "user-1", 3, UpdateParams{DueAt: &before}); err != ErrDueBeforeCreation {
+		t.Errorf("expected ErrDueBeforeCreation, got %v", err)
+	}
+
+	// 過去でも created_at 以降なら設定できる（期限切れの Todo になる）
+	due := created.Add(time.Hour)
+	priority := domain_todo.PriorityLow
+	got, err := uc.Update(context.Background(), "user-1", 3, UpdateParams{DueAt: &due, Priority: &priority})
+	if err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+	if !got.DueAt.Equal(due) || got.Priority != domain_todo.PriorityLow || got.Notes != "そのまま" {
+		t.Errorf("unexpected todo: %#v", got)
+	}
+	if !got.IsOverdue(time.Now()) {
+		t.Error("expected todo to be overdue")
+	}
+
+	// ゼロ値で期限を外す
+	var clear time.Time
+	got, err = uc.Update(context.Background(), "user-1", 3, UpdateParams{DueAt: &clear})
+	if err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+	if !got.DueAt.IsZero() || got.IsOverdue(time.Now()) {
+		t.Errorf("expected due date cleared, got %v", got.DueAt)
+	}
+}
+
+func TestUsecase_List_PassesNowForDueFilters(t *testing.T) {
+	t.Parallel()
+
+	before := time.Now()
+	repo := &mockRepo{
+		listFn: func(ctx context.Context, ownerID string, q domain_todo.ListQuery) ([]*domain_todo.Todo, error) {
+			if q.Now.Before(before) || q.Now.After(time.Now()) {
+				t.Errorf("expected Now to be the current time, got %v", q.Now)
+			}
+			if q.Filter.DueWithinDays != 3 {
+				t.Errorf("expected DueWithinDays=3, got %d", q.Filter.DueWithinDays)
+			}
+			return nil, nil
+		},
+	}
+	uc := New(repo, nil, zap.NewNop())
+
+	p := ListParams{Filter: domain_todo.ListFilter{DueWithinDays: 3}}
+	if _, err := uc.List(context.Background(), "user-1", p); err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+}
+
+// labeledRepo は storingRepo に「ラベルの置き換え」を足したもの。
+// SetTodoLabels で受け取った ID を、次の Get で Labels として返す。
+func labeledRepo(initial *domain_todo.Todo) (*mockRepo, *[][]int64) {
+	repo := storingRepo(initial)
+	var calls [][]int64
+	labels := slices.Clone(initial.Labels)
+
+	get := repo.getFn
+	repo.getFn = func(ctx context.Context, ownerID string, id int64) (*domain_todo.Todo, error) {
+		t, err := get(ctx, ownerID, id)
+		if err != nil {
+			return nil, err
+		}
+		t.Labels = slices.Clone(labels)
+		return t, nil
+	}
+	repo.setTodoLabelsFn = func(ctx context.Context, ownerID string, todoID int64, labelIDs []int64) error {
+		calls = append(calls, labelIDs)
+		labels = nil
+		for _, id := range labelIDs {
+			labels = append(labels, domain_todo.Label{ID: id, OwnerID: ownerID})
+		}
+		return nil
+	}
+	return repo, &calls
+}
+
+func TestUsecase_AttachLabels(t *testing.T) {
+	t.Parallel()
+
+	repo, calls := labeledRepo(&domain_todo.Todo{
+		ID: 1, OwnerID: "user-1", Title: "t", Version: 1,
+		Labels: []domain_todo.Label{{ID: 5}},
+	})
+	uc := New(repo, nil, zap.NewNop())
+
+	// 重複・既に付いているものは無視して、和集合で置き換える
+	got, err := uc.AttachLabels(context.Background(), "user-1", 1, []int64{3, 5, 3}, 0)
+	if err != nil {
+		t.Fatalf("AttachLabels returned error: %v", err)
+	}
+	if want := []int64{3, 5}; !slices.Equal((*calls)[0], want) || !slices.Equal(got.LabelIDs(), want) {
+		t.Errorf("expected labels %v, got set=%v todo=%v", want, (*calls)[0], got.LabelIDs())
+	}
+	// ラベルだけの変更でも version は進む
+	if got.Version != 2 {
+		t.Errorf("expected version=2, got %d", got.Version)
+	}
+}
+
+func TestUsecase_DetachLabels(t *testing.T) {
+	t.Parallel()
+
+	repo, calls := labeledRepo(&domain_todo.Todo{
+		ID: 1, OwnerID: "user-1", Title: "t",
+		Labels: []domain_todo.Label{{ID: 2}, {ID: 4}},
+	})
+	uc := New(repo, nil, zap.NewNop())
+
+	if _, err := uc.DetachLabels(context.Background(), "user-1", 1, []int64{4, 9}, 0); err != nil {
+		t.Fatalf("DetachLabels returned error: %v", err)
+	}
+	got, err := uc.DetachLabels(context.Background(), "user-1", 1, []int64{2}, 0)
+	if err != nil {
+		t.Fatalf("DetachLabels returned error: %v", err)
+	}
+
+	// 全部外れたときも「変更なし（nil）」ではなく空で置き換えること
+	if len(*calls) != 2 || !slices.Equal((*calls)[0], []int64{2}) || (*calls)[1] == nil || len((*calls)[1]) != 0 {
+		t.Errorf("unexpected SetTodoLabels calls: %#v", *calls)
+	}
+	if len(got.Labels) != 0 {
+		t.Errorf("expected no labels, got %v", got.LabelIDs())
+	}
+}
+
+func TestUsecase_ChangeLabels_Invalid(t *testing.T) {
+	t.Parallel()
+
+	var current []domain_todo.Label
+	for i := int64(1); i <= domain_todo.MaxLabelsPerTodo; i++ {
+		current = append(current, domain_todo.Label{ID: i})
+	}
+	repo, calls := labeledRepo(&domain_todo.Todo{ID: 1, OwnerID: "user-1", Title: "t", Labels: current})
+	uc := New(repo, nil, zap.NewNop())
+	ctx := context.Background()
+
+	if _, err := uc.AttachLabels(ctx, "user-1", 1, nil, 0); err != ErrEmptyLabelIDs {
+		t.Errorf("expected ErrEmptyLabelIDs, got %v", err)
+	}
+	if _, err := uc.AttachLabels(ctx, "user-1", 1, []int64{0}, 0); err != ErrInvalidID {
+		t.Errorf("expected ErrInvalidID, got %v", err)
+	}
+	// 上限は付け足した後の件数で判定する
+	if _, err := uc.AttachLabels(ctx, "user-1", 1, []int64{100}, 0); err != ErrTooManyLabels {
+		t.Errorf("expected ErrTooManyLabels, got %v", err)
+	}
+	if len(*calls) != 0 {
+		t.Errorf("SetTodoLabels must not be called, got %v", *calls)
+	}
+
+	// 他人のラベル・存在しないラベルは Repository が弾く
+	repo.setTodoLabelsFn = func(ctx context.Context, ownerID string, todoID int64, labelIDs []int64) error {
+		return domain_todo.ErrLabelNotFound
+	}
+	if _, err := uc.DetachLabels(ctx, "user-1", 1, []int64{1}, 0); err != ErrLabelNotFound {
+		t.Errorf("expected ErrLabelNotFound, got %v", err)
+	}
+}
+
+func TestUsecase_Update_ReplacesLabels(t *testing.T) {
+	t.Parallel()
+
+	repo, calls := labeledRepo(&domain_todo.Todo{ID: 1, OwnerID: "user-1", Title: "t"})
+	uc := New(repo, nil, zap.NewNop())
+
+	title := "新しいタイトル"
+	ids := []int64{7, 3, 7}
+	got, err := uc.Update(context.Background(), "user-1", 1, UpdateParams{Title: &title, LabelIDs: &ids})
+	if err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+	if got.Title != title || !slices.Equal(got.LabelIDs(), []int64{3, 7}) {
+		t.Errorf("unexpected todo: %#v", got)
+	}
+
+	// LabelIDs 未指定ならラベルには触らない
+	if _, err := uc.Update(context.Background(), "user-1", 1, UpdateParams{Title: &title}); err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+	if len(*calls) != 1 {
+		t.Errorf("expected 1 SetTodoLabels call, got %d", len(*calls))
+	}
+}
+
+func TestUsecase_List_NormalizesLabelFilter(t *testing.T) {
+	t.Parallel()
+
+	var got []int64
+	repo := pagedRepo(3)
+	list := repo.listFn
+	repo.listFn = func(ctx context.Context, ownerID string, q domain_todo.ListQuery) ([]*domain_todo.Todo, error) {
+		got = q.Filter.LabelIDs
+		return list(ctx, ownerID, q)
+	}
+	uc := New(repo, nil, zap.NewNop())
+
+	p := ListParams{PageSize: 1, Filter: domain_todo.ListFilter{LabelIDs: []int64{9, 2, 9}, LabelMatch: domain_todo.LabelMatchAll}}
+	res, err := uc.List(context.Background(), "user-1", p)
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if !slices.Equal(got, []int64{2, 9}) {
+		t.Errorf("expected normalized label ids, got %v", got)
+	}
+
+	// 指定順が違うだけなら同じ条件としてトークンを使える
+	p.Filter.LabelIDs = []int64{2, 9}
+	p.PageToken = res.NextPageToken
+	if _, err := uc.List(context.Background(), "user-1", p); err != nil {
+		t.Errorf("expected token to be accepted, got %v", err)
+	}
+
+	// any / all を変えたら使えない
+	p.Filter.LabelMatch = domain_todo.LabelMatchAny
+	if _, err := uc.List(context.Background(), "user-1", p); err != ErrInvalidPageToken {
+		t.Errorf("expected ErrInvalidPageToken, got %v", err)
+	}
+}
+
+func TestUsecase_CreateLabel(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockRepo{
+		createLabelFn: func(ctx context.Context, l *domain_todo.Label) (*domain_todo.Label, error) {
+			if l.Name == "dup" {
+				return nil, domain_todo.ErrLabelAlreadyExists
+			}
+			l.ID = 1
+			return l, nil
+		},
+	}
+	uc := New(repo, nil, zap.NewNop())
+	ctx := context.Background()
+
+	got, err := uc.CreateLabel(ctx, "user-1", LabelParams{Name: "  bug ", Color: "#FF0000"})
+	if err != nil {
+		t.Fatalf("CreateLabel returned error: %v", err)
+	}
+	if got.Name != "bug" || got.Color != "#ff0000" {
+		t.Errorf("expected normalized label, got %#v", got)
+	}
+
+	tests := []struct {
+		params  LabelParams
+		wantErr error
+	}{
+		{LabelParams{Name: " "}, ErrEmptyLabelName},
+		{LabelParams{Name: strings.Repeat("x", domain_todo.MaxLabelNameLength+1)}, ErrLabelNameTooLong},
+		{LabelParams{Name: "red", Color: "red"}, ErrInvalidLabelColor},
+		{LabelParams{Name: "dup"}, ErrLabelAlreadyExists},
+	}
+	for _, tt := range tests {
+		if _, err := uc.CreateLabel(ctx, "user-1", tt.params); err != tt.wantErr {
+			t.Errorf("%+v: expected %v, got %v", tt.params, tt.wantErr, err)
+		}
+	}
+}
+
+func TestUsecase_UpdateLabel_Partial(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockRepo{
+		getLabelFn: func(ctx context.Context, ownerID string, id int64) (*domain_todo.Label, error) {
+			if id != 1 {
+				return nil, domain_todo.ErrLabelNotFound
+			}
+			return &domain_todo.Label{ID: id, OwnerID: ownerID, Name: "bug", Color: "#ff0000"}, nil
+		},
+	}
+	uc := New(repo, nil, zap.NewNop())
+
+	name := "defect"
+	got, err := uc.UpdateLabel(context.Background(), "user-1", 1, LabelUpdateParams{Name: &name})
+	if err != nil {
+		t.Fatalf("UpdateLabel returned error: %v", err)
+	}
+	if got.Name != "defect" || got.Color != "#ff0000" {
+		t.Errorf("unexpected label: %#v", got)
+	}
+
+	if _, err := uc.UpdateLabel(context.Background(), "user-1", 2, LabelUpdateParams{Name: &name}); err != ErrLabelNotFound {
+		t.Errorf("expected ErrLabelNotFound, got %v", err)
+	}
+}
+
+// mockListRepo はリスト用のモック。lists に入っているものだけが存在する。
+type mockListRepo struct {
+	lists  map[int64]*domain_todo.TodoList
+	counts map[int64]int64
+
+	deleted []int64
+}
+
+func (m *mockListRepo) ListTodoLists(ctx context.Context, ownerID string, includeArchived bool) ([]*domain_todo.TodoList, error) {
+	var out []*domain_todo.TodoList
+	for _, l := range m.lists {
+		if l.OwnerID == ownerID && (includeArchived || !l.IsArchived()) {
+			out = append(out, l)
+		}
+	}
+	return out, nil
+}
+
+func (m *mockListRepo) GetTodoList(ctx context.Context, ownerID string, id int64) (*domain_todo.TodoList, error) {
+	l, ok := m.lists[id]
+	if !ok || l.OwnerID != ownerID {
+		return nil, domain_todo.ErrListNotFound
+	}
+	cp := *l
+	return &cp, nil
+}
+
+func (m *mockListRepo) GetTodoListForUpdate(ctx context.Context, ownerID string, id int64) (*domain_todo.TodoList, error) {
+	return m.GetTodoList(ctx, ownerID, id)
+}
+
+func (m *mockListRepo) CreateTodoList(ctx context.Context, l *domain_todo.TodoList) (*domain_todo.TodoList, error) {
+	l.ID = int64(len(m.lists) + 1)
+	m.lists[l.ID] = l
+	return l, nil
+}
+
+func (m *mockListRepo) UpdateTodoList(ctx context.Context, l *domain_todo.TodoList) (*domain_todo.TodoList, error) {
+	m.lists[l.ID] = l
+	return l, nil
+}
+
+func (m *mockListRepo) DeleteTodoList(ctx context.Context, ownerID string, id int64) (bool, error) {
+	if _, ok := m.lists[id]; !ok {
+		return false, nil
+	}
+	delete(m.lists, id)
+	m.deleted = append(m.deleted, id)
+	return true, nil
+}
+
+func (m *mockListRepo) CountTodos(ctx context.Context, ownerID string, listID int64) (int64, error) {
+	return m.counts[listID], nil
+}
+
+func newMockListRepo() *mockListRepo {
+	return &mockListRepo{
+		lists: map[int64]*domain_todo.TodoList{
+			1: {ID: 1, OwnerID: "user-1", Name: "仕事"},
+			2: {ID: 2, OwnerID: "user-1", Name: "昔の", ArchivedAt: time.Now()},
+			3: {ID: 3, OwnerID: "user-2", Name: "他人の"},
+		},
+		counts: map[int64]int64{},
+	}
+}
+
+func TestUsecase_Create_IntoList(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockRepo{}
+	uc := New(repo, nil, zap.NewNop(), WithTodoListRepository(newMockListRepo()))
+
+	got, err := uc.Create(context.Background(), "user-1", CreateParams{Title: "t", ListID: 1})
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	if got.ListID != 1 {
+		t.Errorf("expected ListID=1, got %d", got.ListID)
+	}
+
+	tests := []struct {
+		name   string
+		listID int64
+		want   error
+	}{
+		{"archived", 2, ErrListArchived},
+		{"other owner", 3, ErrListNotFound},
+		{"missing", 9, ErrListNotFound},
+		{"negative", -1, ErrInvalidID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := uc.Create(context.Background(), "user-1", CreateParams{Title: "t", ListID: tt.listID}); err != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestUsecase_MoveTodo(t *testing.T) {
+	t.Parallel()
+
+	repo := storingRepo(&domain_todo.Todo{ID: 1, OwnerID: "user-1", Title: "t", Version: 1})
+	uc := New(repo, nil, zap.NewNop(), WithTodoListRepository(newMockListRepo()))
+
+	got, err := uc.MoveTodo(context.Background(), "user-1", 1, MoveParams{ListID: 1, ExpectedVersion: 1})
+	if err != nil {
+		t.Fatalf("MoveTodo returned error: %v", err)
+	}
+	if got.ListID != 1 || got.Version != 2 {
+		t.Errorf("expected list_id=1 version=2, got list_id=%d version=%d", got.ListID, got.Version)
+	}
+
+	// アーカイブ済み・存在しないリストには移せない（元のリストのまま）
+	if _, err := uc.MoveTodo(context.Background(), "user-1", 1, MoveParams{ListID: 2}); err != ErrListArchived {
+		t.Errorf("expected ErrListArchived, got %v", err)
+	}
+	if _, err := uc.MoveTodo(context.Background(), "user-1", 1, MoveParams{ListID: 9}); err != ErrListNotFound {
+		t.Errorf("expected ErrListNotFound, got %v", err)
+	}
+
+	// 0 ならインボックスに戻す
+	got, err = uc.MoveTodo(context.Background(), "user-1", 1, MoveParams{})
+	if err != nil {
+		t.Fatalf("MoveTodo returned error: %v", err)
+	}
+	if got.ListID != 0 {
+		t.Errorf("expected list_id=0, got %d", got.ListID)
+	}
+}
+
+func TestUsecase_DeleteTodoList(t *testing.T) {
+	t.Parallel()
+
+	lists := newMockListRepo()
+	lists.counts[1] = 2
+	uc := New(&mockRepo{}, nil, zap.NewNop(), WithTodoListRepository(lists))
+
+	// 中身があるリストは消さない
+	if err := uc.DeleteTodoList(context.Background(), "user-1", 1); err != ErrListNotEmpty {
+		t.Errorf("expected ErrListNotEmpty, got %v", err)
+	}
+	if err := uc.DeleteTodoList(context.Background(), "user-1", 3); err != ErrListNotFound {
+		t.Errorf("expected ErrListNotFound, got %v", err)
+	}
+
+	lists.counts[1] = 0
+	if err := uc.DeleteTodoList(context.Background(), "user-1", 1); err != nil {
+		t.Fatalf("DeleteTodoList returned error: %v", err)
+	}
+	if !slices.Equal(lists.deleted, []int64{1}) {
+		t.Errorf("unexpected deleted lists: %v", lists.deleted)
+	}
+}
+
+func TestUsecase_UpdateTodoList_Archive(t *testing.T) {
+	t.Parallel()
+
+	uc := New(&mockRepo{}, nil, zap.NewNop(), WithTodoListRepository(newMockListRepo()))
+
+	archived := true
+	got, err := uc.UpdateTodoList(context.Background(), "user-1", 1, TodoListUpdateParams{Archived: &archived})
+	if err != nil {
+		t.Fatalf("UpdateTodoList returned error: %v", err)
+	}
+	if !got.IsArchived() || got.Name != "仕事" {
+		t.Errorf("unexpected list: %#v", got)
+	}
+
+	lists, err := uc.ListTodoLists(context.Background(), "user-1", false)
+	if err != nil {
+		t.Fatalf("ListTodoLists returned error: %v", err)
+	}
+	if len(lists) != 0 {
+		t.Errorf("expected no active lists, got %d", len(lists))
+	}
+}
+
+// checklistRepo は storingRepo に「チェックリストの保存」を足したもの。
+// SaveItems で ID が 0 の項目に採番し、次の Get で Items として返す。
+func checklistRepo(initial *domain_todo.Todo) (*mockRepo, *int) {
+	repo := storingRepo(initial)
+	items := slices.Clone(initial.Items)
+	nextID := int64(len(items) + 1)
+	var saves int
+
+	get := repo.getFn
+	repo.getFn = func(ctx context.Context, ownerID string, id int64) (*domain_todo.Todo, error) {
+		t, err := get(ctx, ownerID, id)
+		if err != nil {
+			return nil, err
+		}
+		t.Items = slices.Clone(items)
+		return t, nil
+	}
+	repo.saveItemsFn = func(ctx context.Context, t *domain_todo.Todo) error {
+		saves++
+		items = slices.Clone(t.Items)
+		for i := range items {
+			if items[i].ID == 0 {
+				items[i].ID = nextID
+				nextID++
+			}
+		}
+		return nil
+	}
+	return repo, &saves
+}
+
+func TestUsecase_Checklist_DoneRule(t *testing.T) {
+	t.Parallel()
+
+	repo, saves := checklistRepo(&domain_todo.Todo{ID: 1, OwnerID: "user-1", Title: "t", Version: 1})
+	uc := New(repo, nil, zap.NewNop())
+	ctx := context.Background()
+
+	if _, err := uc.AddChecklistItem(ctx, "user-1", 1, "  手順1 ", 0); err != nil {
+		t.Fatalf("AddChecklistItem returned error: %v", err)
+	}
+	got, err := uc.AddChecklistItem(ctx, "user-1", 1, "手順2", 0)
+	if err != nil {
+		t.Fatalf("AddChecklistItem returned error: %v", err)
+	}
+	if len(got.Items) != 2 || got.Items[0].Title != "手順1" || got.Items[1].Position != 1 {
+		t.Fatalf("unexpected items: %#v", got.Items)
+	}
+	if got.Version != 3 || *saves != 2 {
+		t.Errorf("expected version=3 saves=2, got version=%d saves=%d", got.Version, *saves)
+	}
+
+	// 未完了の項目があるうちは完了にできない
+	done := true
+	if _, err := uc.Update(ctx, "user-1", 1, UpdateParams{Done: &done}); err != ErrChecklistIncomplete {
+		t.Errorf("expected ErrChecklistIncomplete, got %v", err)
+	}
+
+	for _, it := range got.Items {
+		if _, err := uc.ToggleChecklistItem(ctx, "user-1", 1, it.ID, true, 0); err != nil {
+			t.Fatalf("ToggleChecklistItem returned error: %v", err)
+		}
+	}
+	got, err = uc.Update(ctx, "user-1", 1, UpdateParams{Done: &done})
+	if err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+	if !got.Done {
+		t.Error("expected todo to be done")
+	}
+
+	// 完了済みの Todo に項目を足すと未完了に戻る
+	got, err = uc.AddChecklistItem(ctx, "user-1", 1, "手順3", 0)
+	if err != nil {
+		t.Fatalf("AddChecklistItem returned error: %v", err)
+	}
+	if got.Done {
+		t.Error("expected todo to be reopened")
+	}
+}
+
+func TestUsecase_Checklist_AutoComplete(t *testing.T) {
+	t.Parallel()
+
+	repo, _ := checklistRepo(&domain_todo.Todo{
+		ID: 1, OwnerID: "user-1", Title: "t", AutoComplete: true,
+		Items: []domain_todo.ChecklistItem{{ID: 1, Title: "a", Done: true}, {ID: 2, Title: "b", Position: 1}},
+	})
+	uc := New(repo, nil, zap.NewNop())
+
+	got, err := uc.ToggleChecklistItem(context.Background(), "user-1", 1, 2, true, 0)
+	if err != nil {
+		t.Fatalf("ToggleChecklistItem returned error: %v", err)
+	}
+	if !got.Done {
+		t.Error("expected todo to be auto-completed")
+	}
+
+	got, err = uc.ToggleChecklistItem(context.Background(), "user-1", 1, 1, false, 0)
+	if err != nil {
+		t.Fatalf("ToggleChecklistItem returned error: %v", err)
+	}
+	if got.Done {
+		t.Error("expected todo to be reopened when an item is unchecked")
+	}
+}
+
+func TestUsecase_Checklist_ReorderAndRemove(t *testing.T) {
+	t.Parallel()
+
+	repo, _ := checklistRepo(&domain_todo.Todo{
+		ID: 1, OwnerID: "user-1", Title: "t",
+		Items: []domain_todo.ChecklistItem{{ID: 1, Title: "a"}, {ID: 2, Title: "b", Position: 1}, {ID: 3, Title: "c", Position: 2}},
+	})
+	uc := New(repo, nil, zap.NewNop())
+	ctx := context.Background()
+
+	for _, ids := range [][]int64{{3, 1}, {3, 1, 1}, {3, 1, 9}} {
+		if _, err := uc.ReorderChecklistItems(ctx, "user-1", 1, ids, 0); err != ErrInvalidItemOrder {
+			t.Errorf("ids=%v: expected ErrInvalidItemOrder, got %v", ids, err)
+		}
+	}
+
+	got, err := uc.ReorderChecklistItems(ctx, "user-1", 1, []int64{3, 1, 2}, 0)
+	if err != nil {
+		t.Fatalf("ReorderChecklistItems returned error: %v", err)
+	}
+	got, err = uc.RemoveChecklistItem(ctx, "user-1", 1, 1, got.Version)
+	if err != nil {
+		t.Fatalf("RemoveChecklistItem returned error: %v", err)
+	}
+
+	var ids []int64
+	for i, it := range got.Items {
+		if it.Position != i {
+			t.Errorf("item %d: expected position=%d, got %d", it.ID, i, it.Position)
+		}
+		ids = append(ids, it.ID)
+	}
+	if !slices.Equal(ids, []int64{3, 2}) {
+		t.Errorf("expected items [3 2], got %v", ids)
+	}
+
+	if _, err := uc.RemoveChecklistItem(ctx, "user-1", 1, 1, 0); err != ErrItemNotFound {
+		t.Errorf("expected ErrItemNotFound, got %v", err)
+	}
+}
+
+// positionedRepo は複数の Todo を position 付きで持つモック。
+// NeighborPosition / RebalancePositions を (position, id) 順で素直に実装する。
+func positionedRepo(todos ...*domain_todo.Todo) (*mockRepo, func() []int64) {
+	stored := make(map[int64]domain_todo.Todo, len(todos))
+	for _, t := range todos {
+		stored[t.ID] = *t
+	}
+	sorted := func() []domain_todo.Todo {
+		out := make([]domain_todo.Todo, 0, len(stored))
+		for _, t := range stored {
+			out = append(out, t)
+		}
+		slices.SortFunc(out, func(a, b domain_todo.Todo) int {
+			if a.Position != b.Position {
+				return cmp.Compare(a.Position, b.Position)
+			}
+			return cmp.Compare(a.ID, b.ID)
+		})
+		return out
+	}
+
+	repo := &mockRepo{
+		getFn: func(ctx context.Context, ownerID string, id int64) (*domain_todo.Todo, error) {
+			t, ok := stored[id]
+			if !ok || t.OwnerID != ownerID {
+				return nil, domain_todo.ErrNotFound
+			}
+			return &t, nil
+		},
+		updateFn: func(ctx context.Context, td *domain_todo.Todo) (*domain_todo.Todo, error) {
+			td.Version++
+			stored[td.ID] = *td
+			return td, nil
+		},
+		neighborPositionFn: func(ctx context.Context, anchor *domain_todo.Todo, excludeID int64, before bool) (int64, bool, error) {
+			all := sorted()
+			i := slices.IndexFunc(all, func(t domain_todo.Todo) bool { return t.ID == anchor.ID })
+			step := 1
+			if before {
+				step = -1
+			}
+			for j := i + step; j >= 0 && j < len(all); j += step {
+				if all[j].ID != excludeID {
+					return all[j].Position, true, nil
+				}
+			}
+			return 0, false, nil
+		},
+		rebalancePositionsFn: func(ctx context.Context, ownerID string) error {
+			for i, t := range sorted() {
+				t.Position = int64(i+1) * domain_todo.PositionGap
+				stored[t.ID] = t
+			}
+			return nil
+		},
+	}
+	order := func() []int64 {
+		var ids []int64
+		for _, t := range sorted() {
+			ids = append(ids, t.ID)
+		}
+		return ids
+	}
+	return repo, order
+}
+
+func TestUsecase_Create_AppendsPosition(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockRepo{
+		lastPositionFn: func(ctx context.Context, ownerID string) (int64, error) {
+			return 3 * domain_todo.PositionGap, nil
+		},
+	}
+	uc := New(repo, nil, zap.NewNop())
+
+	got, err := uc.Create(context.Background(), "user-1", CreateParams{Title: "t"})
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	if want := 4 * domain_todo.PositionGap; got.Position != want {
+		t.Errorf("expected position=%d, got %d", want, got.Position)
+	}
+}
+
+func TestUsecase_MoveTodo_Reorder(t *testing.T) {
+	t.Parallel()
+
+	gap := domain_todo.PositionGap
+	repo, order := positionedRepo(
+		&domain_todo.Todo{ID: 1, OwnerID: "user-1", Title: "a", Position: gap},
+		&domain_todo.Todo{ID: 2, OwnerID: "user-1", Title: "b", Position: 2 * gap},
+		&domain_todo.Todo{ID: 3, OwnerID: "user-1", Title: "c", Position: 3 * gap},
+	)
+	uc := New(repo, nil, zap.NewNop())
+	ctx := context.Background()
+
+	// 間に入れる・先頭に入れる・末尾に入れる
+	if _, err := uc.MoveTodo(ctx, "user-1", 3, MoveParams{AfterID: 1}); err != nil {
+		t.Fatalf("MoveTodo returned error: %v", err)
+	}
+	if want := []int64{1, 3, 2}; !slices.Equal(order(), want) {
+		t.Errorf("expected order %v, got %v", want, order())
+	}
+	if _, err := uc.MoveTodo(ctx, "user-1", 2, MoveParams{BeforeID: 1}); err != nil {
+		t.Fatalf("MoveTodo returned error: %v", err)
+	}
+	if _, err := uc.MoveTodo(ctx, "user-1", 1, MoveParams{AfterID: 3}); err != nil {
+		t.Fatalf("MoveTodo returned error: %v", err)
+	}
+	if want := []int64{2, 3, 1}; !slices.Equal(order(), want) {
+		t.Errorf("expected order %v, got %v", want, order())
+	}
+}
+
+func TestUsecase_MoveTodo_RebalancesWhenGapRunsOut(t *testing.T) {
+	t.Parallel()
+
+	repo, order := positionedRepo(
+		&domain_todo.Todo{ID: 1, OwnerID: "user-1", Title: "a", Position: 10},
+		&domain_todo.Todo{ID: 2, OwnerID: "user-1", Title: "b", Position: 11},
+		&domain_todo.Todo{ID: 3, OwnerID: "user-1", Title: "c", Position: 11},
+	)
+	var rebalanced int
+	rebalance := repo.rebalancePositionsFn
+	repo.rebalancePositionsFn = func(ctx context.Context, ownerID string) error {
+		rebalanced++
+		return rebalance(ctx, ownerID)
+	}
+	uc := New(repo, nil, zap.NewNop())
+
+	got, err := uc.MoveTodo(context.Background(), "user-1", 3, MoveParams{BeforeID: 2})
+	if err != nil {
+		t.Fatalf("MoveTodo returned error: %v", err)
+	}
+	if rebalanced != 1 {
+		t.Errorf("expected 1 rebalance, got %d", rebalanced)
+	}
+	if want := []int64{1, 3, 2}; !slices.Equal(order(), want) {
+		t.Errorf("expected order %v, got %v", want, order())
+	}
+	if got.Position <= domain_todo.PositionGap || got.Position >= 2*domain_todo.PositionGap {
+		t.Errorf("expected position between rebalanced neighbours, got %d", got.Position)
+	}
+}
+
+func TestUsecase_MoveTodo_InvalidAnchor(t *testing.T) {
+	t.Parallel()
+
+	uc := New(&mockRepo{}, nil, zap.NewNop())
+
+	tests := []struct {
+		name string
+		p    MoveParams
+		want error
+	}{
+		{"both", MoveParams{BeforeID: 2, AfterID: 3}, ErrInvalidMoveAnchor},
+		{"self", MoveParams{AfterID: 1}, ErrInvalidMoveAnchor},
+		{"negative", MoveParams{BeforeID: -1}, ErrInvalidID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := uc.MoveTodo(context.Background(), "user-1", 1, tt.p); err != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+// txCounter は WithinTx の呼び出し回数を数え、Tx の中の ctx に印を付ける TxManager
+type txCounter struct{ n int }
+
+type inTxKey struct{}
+
+func (c *txCounter) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	c.n++
+	return fn(context.WithValue(ctx, inTxKey{}, true))
+}
+
+func TestUsecase_Update_CreatesNextOccurrence(t *testing.T) {
+	t.Parallel()
+
+	due := time.Now().Add(48 * time.Hour).Truncate(time.Second)
+	repo, _ := checklistRepo(&domain_todo.Todo{
+		ID:         1,
+		OwnerID:    "user-1",
+		ListID:     3,
+		Title:      "ゴミ出し",
+		DueAt:      due,
+		Priority:   domain_todo.PriorityHigh,
+		Labels:     []domain_todo.Label{{ID: 5, Name: "家事"}},
+		Items:      []domain_todo.ChecklistItem{{ID: 1, Title: "まとめる", Done: true}},
+		Version:    1,
+		Recurrence: domain_todo.Recurrence{Freq: domain_todo.FrequencyWeekly},
+	})
+
+	var created []*domain_todo.Todo
+	repo.createFn = func(ctx context.Context, td *domain_todo.Todo) (*domain_todo.Todo, error) {
+		if ctx.Value(inTxKey{}) == nil {
+			t.Error("expected next occurrence to be created inside the tx")
+		}
+		cp := *td
+		cp.ID = int64(100 + len(created))
+		created = append(created, &cp)
+		return &cp, nil
+	}
+	repo.lastPositionFn = func(ctx context.Context, ownerID string) (int64, error) {
+		return 7 * domain_todo.PositionGap, nil
+	}
+	labelsSet := map[int64][]int64{}
+	repo.setTodoLabelsFn = func(ctx context.Context, ownerID string, todoID int64, labelIDs []int64) error {
+		labelsSet[todoID] = labelIDs
+		return nil
+	}
+	var savedItems []domain_todo.ChecklistItem
+	repo.saveItemsFn = func(ctx context.Context, td *domain_todo.Todo) error {
+		if td.ID == 100 {
+			savedItems = td.Items
+		}
+		return nil
+	}
+	tx := &txCounter{}
+	uc := New(repo, tx, zap.NewNop())
+	ctx := context.Background()
+
+	done := true
+	got, err := uc.Update(ctx, "user-1", 1, UpdateParams{Done: &done})
+	if err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+	if tx.n != 1 {
+		t.Errorf("expected 1 tx, got %d", tx.n)
+	}
+	if !got.Done || got.IsRecurring() {
+		t.Errorf("expected completed todo without recurrence, got %+v", got)
+	}
+
+	if len(created) != 1 {
+		t.Fatalf("expected 1 next occurrence, got %d", len(created))
+	}
+	next := created[0]
+	if want := due.AddDate(0, 0, 7); !next.DueAt.Equal(want) {
+		t.Errorf("expected due_at=%v, got %v", want, next.DueAt)
+	}
+	if next.Done || next.Title != "ゴミ出し" || next.ListID != 3 || next.Priority != domain_todo.PriorityHigh {
+		t.Errorf("expected copied undone todo, got %+v", next)
+	}
+	if want := 8 * domain_todo.PositionGap; next.Position != want {
+		t.Errorf("expected position=%d, got %d", want, next.Position)
+	}
+	if !next.IsRecurring() {
+		t.Error("expected recurrence to move to the next occurrence")
+	}
+	if !slices.Equal(labelsSet[100], []int64{5}) {
+		t.Errorf("expected labels [5] on next occurrence, got %v", labelsSet[100])
+	}
+	if len(savedItems) != 1 || savedItems[0].Done || savedItems[0].Title != "まとめる" {
+		t.Errorf("expected reset checklist on next occurrence, got %+v", savedItems)
+	}
+
+	// 完了を戻してまた完了にしても、次の回は増えない
+	undone := false
+	if _, err := uc.Update(ctx, "user-1", 1, UpdateParams{Done: &undone}); err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+	if _, err := uc.Update(ctx, "user-1", 1, UpdateParams{Done: &done}); err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+	if len(created) != 1 {
+		t.Errorf("expected no more occurrences, got %d", len(created))
+	}
+}
+
+func TestUsecase_Update_InvalidRecurrence(t *testing.T) {
+	t.Parallel()
+
+	uc := New(storingRepo(&domain_todo.Todo{ID: 1, OwnerID: "user-1", Title: "t"}), nil, zap.NewNop())
+
+	r := domain_todo.Recurrence{Freq: domain_todo.FrequencyMonthly, ByMonthDay: 40}
+	if _, err := uc.Update(context.Background(), "user-1", 1, UpdateParams{Recurrence: &r}); err != ErrInvalidRecurrence {
+		t.Errorf("expected ErrInvalidRecurrence, got %v", err)
+	}
+	if _, err := uc.Create(context.Background(), "user-1", CreateParams{Title: "t", Recurrence: r}); err != ErrInvalidRecurrence {
+		t.Errorf("expected ErrInvalidRecurrence, got %v", err)
+	}
+}
+
+// historyRecorder は AppendHistory された履歴を貯めるモックの設定
+func historyRecorder(repo *mockRepo) *[]*domain_todo.HistoryEntry {
+	var entries []*domain_todo.HistoryEntry
+	repo.appendHistoryFn = func(ctx context.Context, e *domain_todo.HistoryEntry) error {
+		if ctx.Value(inTxKey{}) == nil {
+			return errors.New("history must be written inside the tx")
+		}
+		e.ID = int64(len(entries) + 1)
+		entries = append(entries, e)
+		return nil
+	}
+	return &entries
+}
+
+type auditKey struct{}
+
+func TestUsecase_History_RecordsChanges(t *testing.T) {
+	t.Parallel()
+
+	repo, _ := checklistRepo(&domain_todo.Todo{ID: 1, OwnerID: "user-1", Title: "元", Version: 1})
+	repo.createFn = func(ctx context.Context, td *domain_todo.Todo) (*domain_todo.Todo, error) {
+		td.ID = 1
+		return td, nil
+	}
+	entries := historyRecorder(repo)
+	fromCtx := func(key string) func(ctx context.Context) (string, bool) {
+		return func(ctx context.Context) (string, bool) {
+			v, ok := ctx.Value(auditKey{}).(map[string]string)
+			return v[key], ok
+		}
+	}
+	uc := New(repo, &txCounter{}, zap.NewNop(), WithAuditContext(fromCtx("actor"), fromCtx("rid")))
+	ctx := context.WithValue(context.Background(), auditKey{}, map[string]string{"actor": "admin", "rid": "req-1"})
+
+	if _, err := uc.Create(ctx, "user-1", CreateParams{Title: "元"}); err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	title := "新"
+	if _, err := uc.Update(ctx, "user-1", 1, UpdateParams{Title: &title}); err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+	if _, err := uc.AddChecklistItem(ctx, "user-1", 1, "手順", 0); err != nil {
+		t.Fatalf("AddChecklistItem returned error: %v", err)
+	}
+	if err := uc.Delete(ctx, "user-1", 1, 0); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+
+	got := *entries
+	wantActions := []domain_todo.HistoryAction{
+		domain_todo.HistoryActionCreate,
+		domain_todo.HistoryActionUpdate,
+		domain_todo.HistoryActionUpdate,
+		domain_todo.HistoryActionDelete,
+	}
+	if len(got) != len(wantActions) {
+		t.Fatalf("expected %d entries, got %d", len(wantActions), len(got))
+	}
+	for i, e := range got {
+		if e.Action != wantActions[i] || e.TodoID != 1 || e.OwnerID != "user-1" || e.Actor != "admin" || e.RequestID != "req-1" {
+			t.Errorf("entry %d: unexpected %+v", i, e)
+		}
+	}
+
+	if got[0].Before != nil || got[0].After == nil || got[0].After.Title != "元" {
+		t.Errorf("create: unexpected snapshots %+v / %+v", got[0].Before, got[0].After)
+	}
+	if got[1].Before.Title != "元" || got[1].After.Title != "新" {
+		t.Errorf("update: expected title 元 -> 新, got %q -> %q", got[1].Before.Title, got[1].After.Title)
+	}
+	// 変更前のスナップショットは、その後の変更で書き換わらない
+	if len(got[2].Before.Items) != 0 || len(got[2].After.Items) != 1 {
+		t.Errorf("checklist: expected items 0 -> 1, got %d -> %d", len(got[2].Before.Items), len(got[2].After.Items))
+	}
+	if got[3].Before == nil || got[3].Before.Title != "新" || got[3].After != nil {
+		t.Errorf("delete: unexpected snapshots %+v / %+v", got[3].Before, got[3].After)
+	}
+}
+
+func TestUsecase_History_DefaultsActorToOwner(t *testing.T) {
+	t.Parallel()
+
+	repo := storingRepo(&domain_todo.Todo{ID: 1, OwnerID: "user-1", Title: "t"})
+	entries := historyRecorder(repo)
+	uc := New(repo, &txCounter{}, zap.NewNop())
+
+	done := true
+	if _, err := uc.Update(context.Background(), "user-1", 1, UpdateParams{Done: &done}); err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+	if len(*entries) != 1 || (*entries)[0].Actor != "user-1" || (*entries)[0].RequestID != "" {
+		t.Errorf("expected actor=user-1 without request id, got %+v", *entries)
+	}
+}
+
+func TestUsecase_History_FailureAbortsChange(t *testing.T) {
+	t.Parallel()
+
+	repo := &mockRepo{
+		appendHistoryFn: func(ctx context.Context, e *domain_todo.HistoryEntry) error {
+			return errors.New("disk full")
+		},
+	}
+	uc := New(repo, nil, zap.NewNop())
+
+	if err := uc.Delete(context.Background(), "user-1", 1, 0); err == nil || errors.Is(err, ErrNotFound) {
+		t.Errorf("expected internal error, got %v", err)
+	}
+	if _, err := uc.Create(context.Background(), "user-1", CreateParams{Title: "t"}); err == nil {
+		t.Error("expected error, got nil")
+	}
+}
+
+func TestUsecase_ListTodoHistory_Paging(t *testing.T) {
+	t.Parallel()
+
+	// ID 1〜5 の履歴を新しい順に返すモック
+	repo := &mockRepo{
+		listHistoryFn: func(ctx context.Context, ownerID string, todoID int64, q domain_todo.HistoryQuery) ([]*domain_todo.HistoryEntry, error) {
+			var out []*domain_todo.HistoryEntry
+			for id := int64(5); id >= 1 && len(out) < q.Limit; id-- {
+				if q.BeforeID == 0 || id < q.BeforeID {
+					out = append(out, &domain_todo.HistoryEntry{ID: id, OwnerID: ownerID, TodoID: todoID})
+				}
+			}
+			return out, nil
+		},
+	}
+	uc := New(repo, nil, zap.NewNop(), WithPageTokenKey([]byte("test-key")))
+	ctx := context.Background()
+
+	var ids []int64
+	token := ""
+	for range 3 {
+		res, err := uc.ListTodoHistory(ctx, "user-1", 7, HistoryListParams{PageSize: 2, PageToken: token})
+		if err != nil {
+			t.Fatalf("ListTodoHistory returned error: %v", err)
+		}
+		for _, e := range res.Entries {
+			ids = append(ids, e.ID)
+		}
+		token = res.NextPageToken
+		if token == "" {
+			break
+		}
+	}
+	if want := []int64{5, 4, 3, 2, 1}; !slices.Equal(ids, want) {
+		t.Errorf("expected %v, got %v", want, ids)
+	}
+
+	// 他の Todo・他人のトークンは使えない
+	res, err := uc.ListTodoHistory(ctx, "user-1", 7, HistoryListParams{PageSize: 2})
+	if err != nil {
+		t.Fatalf("ListTodoHistory returned error: %v", err)
+	}
+	if _, err := uc.ListTodoHistory(ctx, "user-1", 8, HistoryListParams{PageToken: res.NextPageToken}); err != ErrInvalidPageToken {
+		t.Errorf("expected ErrInvalidPageToken for another todo, got %v", err)
+	}
+	if _, err := uc.ListTodoHistory(ctx, "user-2", 7, HistoryListParams{PageToken: res.NextPageToken}); err != ErrInvalidPageToken {
+		t.Errorf("expected ErrInvalidPageToken for another owner, got %v", err)
+	}
+	if _, err := uc.ListTodoHistory(ctx, "user-1", 0, HistoryListParams{}); err != ErrInvalidID {
+		t.Errorf("expected ErrInvalidID, got %v", err)
+	}
+}
+
+func TestUsecase_BatchCreate(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	repo := &mockRepo{
+		lastPositionFn: func(ctx context.Context, ownerID string) (int64, error) {
+			return domain_todo.PositionGap, nil
+		},
+		createManyFn: func(ctx context.Context, todos []*domain_todo.Todo) ([]*domain_todo.Todo, error) {
+			calls++
+			for i, td := range todos {
+				td.ID = int64(10 + i)
+			}
+			return todos, nil
+		},
+	}
+	entries := historyRecorder(repo)
+	uc := New(repo, &txCounter{}, zap.NewNop())
+
+	items := []CreateParams{{Title: "a"}, {Title: ""}, {Title: "c"}}
+	got, err := uc.BatchCreate(context.Background(), "user-1", items, BatchBestEffort)
+	if err != nil {
+		t.Fatalf("BatchCreate returned error: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected a single multi-row insert, got %d calls", calls)
+	}
+	if len(got) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(got))
+	}
+	if got[1].Err != ErrEmptyTitle || got[1].Todo != nil {
+		t.Errorf("item 1: expected ErrEmptyTitle, got %+v", got[1])
+	}
+	// 並び順は入力の順で末尾に続く
+	for i, want := range map[int]struct{ id, pos int64 }{0: {10, 2 * domain_todo.PositionGap}, 2: {11, 3 * domain_todo.PositionGap}} {
+		if got[i].Err != nil || got[i].Todo.ID != want.id || got[i].Todo.Position != want.pos {
+			t.Errorf("item %d: expected id=%d position=%d, got %+v", i, want.id, want.pos, got[i])
+		}
+	}
+	if len(*entries) != 2 {
+		t.Errorf("expected 2 history entries, got %d", len(*entries))
+	}
+
+	// ALL_OR_NOTHING では 1 件でも不正なら何も作らない
+	_, err = uc.BatchCreate(context.Background(), "user-1", items, BatchAllOrNothing)
+	var itemErr *BatchItemError
+	if !errors.As(err, &itemErr) || itemErr.Index != 1 || !errors.Is(err, ErrEmptyTitle) {
+		t.Errorf("expected item 1 ErrEmptyTitle, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected no insert, got %d calls", calls)
+	}
+}
+
+func TestUsecase_BatchUpdate(t *testing.T) {
+	t.Parallel()
+
+	title := "新"
+	items := []BatchUpdateItem{
+		{ID: 1, Params: UpdateParams{Title: &title}},
+		{ID: 2, Params: UpdateParams{Title: &title}}, // 存在しない
+	}
+
+	t.Run("best effort", func(t *testing.T) {
+		t.Parallel()
+
+		repo := storingRepo(&domain_todo.Todo{ID: 1, OwnerID: "user-1", Title: "元"})
+		tx := &txCounter{}
+		uc := New(repo, tx, zap.NewNop())
+
+		got, err := uc.BatchUpdate(context.Background(), "user-1", items, BatchBestEffort)
+		if err != nil {
+			t.Fatalf("BatchUpdate returned error: %v", err)
+		}
+		if got[0].Err != nil || got[0].Todo.Title != "新" {
+			t.Errorf("item 0: expected updated, got %+v", got[0])
+		}
+		if got[1].Err != ErrNotFound {
+			t.Errorf("item 1: expected ErrNotFound, got %v", got[1].Err)
+		}
+		if tx.n != 2 {
+			t.Errorf("expected one tx per item, got %d", tx.n)
+		}
+	})
+
+	t.Run("all or nothing", func(t *testing.T) {
+		t.Parallel()
+
+		repo := storingRepo(&domain_todo.Todo{ID: 1, OwnerID: "user-1", Title: "元"})
+		tx := &txCounter{}
+		uc := New(repo, tx, zap.NewNop())
+
+		_, err := uc.BatchUpdate(context.Background(), "user-1", items, BatchAllOrNothing)
+		var itemErr *BatchItemError
+		if !errors.As(err, &itemErr) || itemErr.Index != 1 || !errors.Is(err, ErrNotFound) {
+			t.Errorf("expected item 1 ErrNotFound, got %v", err)
+		}
+		if tx.n != 1 {
+			t.Errorf("expected a single tx, got %d", tx.n)
+		}
+	})
+}
+
+func TestUsecase_BatchDelete_BestEffort(t *testing.T) {
+	t.Parallel()
+
+	repo := storingRepo(&domain_todo.Todo{ID: 1, OwnerID: "user-1", Title: "t", Version: 2})
+	repo.deleteFn = func(ctx context.Context, ownerID string, id int64) (bool, error) {
+		return true, nil
+	}
+	uc := New(repo, nil, zap.NewNop())
+
+	items := []BatchDeleteItem{{ID: 1, ExpectedVersion: 1}, {ID: 0}, {ID: 1, ExpectedVersion: 2}}
+	got, err := uc.BatchDelete(context.Background(), "user-1", items, BatchBestEffort)
+	if err != nil {
+		t.Fatalf("BatchDelete returned error: %v", err)
+	}
+	want := []error{ErrVersionMismatch, ErrInvalidID, nil}
+	for i, r := range got {
+		if r.Err != want[i] {
+			t.Errorf("item %d: expected %v, got %v", i, want[i], r.Err)
+		}
+	}
+}
+
+func TestUsecase_Batch_Size(t *testing.T) {
+	t.Parallel()
+
+	uc := New(&mockRepo{}, nil, zap.NewNop())
+
+	if _, err := uc.BatchDelete(context.Background(), "user-1", nil, BatchBestEffort); err != ErrEmptyBatch {
+		t.Errorf("expected ErrEmptyBatch, got %v", err)
+	}
+	items := make([]BatchDeleteItem, MaxBatchSize+1)
+	if _, err := uc.BatchDelete(context.Background(), "user-1", items, BatchBestEffort); err != ErrBatchTooLarge {
+		t.Errorf("expected ErrBatchTooLarge, got %v", err)
+	}
+}
+
+// fakeBroker は Publish されたイベントを記録し、Subscribe には start の位置から始まる購読を返す EventBroker
+type fakeBroker struct {
+	published []domain_todo.Event
+	start     domain_todo.EventCursor
+	after     []*domain_todo.EventCursor // Subscribe に渡された after
+	expired   bool                       // true なら after 付きの Subscribe は ErrEventsExpired
+}
+
+func (b *fakeBroker) Publish(events ...domain_todo.Event) {
+	for _, ev := range events {
+		b.start.Seq++
+		ev.Seq = b.start.Seq
+		b.published = append(b.published, ev)
+	}
+}
+
+func (b *fakeBroker) Subscribe(ownerID string, after *domain_todo.EventCursor) (domain_todo.EventSubscription, error) {
+	b.after = append(b.after, after)
+	if after != nil && b.expired {
+		return nil, domain_todo.ErrEventsExpired
+	}
+	return &fakeSubscription{ch: make(chan domain_todo.Event, 8), start: b.start}, nil
+}
+
+type fakeSubscription struct {
+	ch    chan domain_todo.Event
+	start domain_todo.EventCursor
+}
+
+func (s *fakeSubscription) Events() <-chan domain_todo.Event { return s.ch }
+func (s *fakeSubscription) Err() error                       { return nil }
+func (s *fakeSubscription) Start() domain_todo.EventCursor   { return s.start }
+func (s *fakeSubscription) Close()                           {}
+
+// retryingTx はデッドロックでのやり直しを真似て、fn を 2 回呼ぶ（1 回目の結果は捨てる）TxManager
+type retryingTx struct{}
+
+func (retryingTx) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	_ = fn(ctx)
+	return fn(ctx)
+}
+
+func TestUsecase_Events_PublishedAfterCommit(t *testing.T) {
+	t.Parallel()
+
+	broker := &fakeBroker{}
+	repo := storingRepo(&domain_todo.Todo{ID: 1, OwnerID: "user-1", Title: "元"})
+	repo.deleteFn = func(ctx context.Context, ownerID string, id int64) (bool, error) {
+		return true, nil
+	}
+	uc := New(repo, retryingTx{}, zap.NewNop(), WithEventBroker(broker))
+
+	title := "新"
+	if _, err := uc.Update(context.Background(), "user-1", 1, UpdateParams{Title: &title}); err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+	if err := uc.Delete(context.Background(), "user-1", 1, 0); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+
+	// やり直した 1 回目の分は流さない
+	if len(broker.published) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(broker.published))
+	}
+	if ev := broker.published[0]; ev.Type != domain_todo.EventTypeUpdated || ev.OwnerID != "user-1" || ev.Todo.Title != "新" {
+		t.Errorf("unexpected update event: %#v", ev)
+	}
+	if ev := broker.published[1]; ev.Type != domain_todo.EventTypeDeleted || ev.Todo.ID != 1 {
+		t.Errorf("unexpected delete event: %#v", ev)
+	}
+
+	// ロールバックした変更は流さない
+	repo.appendHistoryFn = func(ctx context.Context, e *domain_todo.HistoryEntry) error {
+		return errors.New("disk full")
+	}
+	if _, err := uc.Create(context.Background(), "user-1", CreateParams{Title: "t"}); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if len(broker.published) != 2 {
+		t.Errorf("expected no event for rolled back change, got %d events", len(broker.published))
+	}
+}
+
+func TestUsecase_Watch_ResumeToken(t *testing.T) {
+	t.Parallel()
+
+	broker := &fakeBroker{start: domain_todo.EventCursor{Epoch: "e1", Seq: 5}}
+	uc := New(&mockRepo{}, nil, zap.NewNop(), WithEventBroker(broker))
+	ctx := context.Background()
+
+	w, err := uc.Watch(ctx, "user-1", "")
+	if err != nil {
+		t.Fatalf("Watch returned error: %v", err)
+	}
+	if w.Resumed() {
+		t.Error("expected a fresh watch without resume token")
+	}
+	w.sub.(*fakeSubscription).ch <- domain_todo.Event{Seq: 7, Type: domain_todo.EventTypeCreated, OwnerID: "user-1"}
+	ev, err := w.Next(ctx)
+	if err != nil || ev.ResumeToken != w.ResumeToken() {
+		t.Fatalf("unexpected event: %#v err=%v", ev, err)
+	}
+
+	// 受け取ったところから再開する
+	w, err = uc.Watch(ctx, "user-1", ev.ResumeToken)
+	if err != nil || !w.Resumed() {
+		t.Fatalf("expected resumed watch, got resumed=%v err=%v", w != nil && w.Resumed(), err)
+	}
+	if got := broker.after[1]; got == nil || *got != (domain_todo.EventCursor{Epoch: "e1", Seq: 7}) {
+		t.Errorf("expected to resume after e1/7, got %v", got)
+	}
+
+	// 他人・書き換えたトークン、page_token は使えない
+	page, _ := uc.(*usecase).pageToken.encode(pageCursor{OwnerID: "user-1", AfterID: 7})
+	for _, token := range []string{ev.ResumeToken + "x", page} {
+		if _, err := uc.Watch(ctx, "user-1", token); !errors.Is(err, ErrInvalidResumeToken) {
+			t.Errorf("token %q: expected ErrInvalidResumeToken, got %v", token, err)
+		}
+	}
+	if _, err := uc.Watch(ctx, "user-2", ev.ResumeToken); !errors.Is(err, ErrInvalidResumeToken) {
+		t.Errorf("expected ErrInvalidResumeToken for other owner, got %v", err)
+	}
+
+	// 続きが無ければ最初から
+	broker.expired = true
+	w, err = uc.Watch(ctx, "user-1", ev.ResumeToken)
+	if err != nil || w.Resumed() {
+		t.Errorf("expected fresh watch after expiry, got resumed=%v err=%v", w != nil && w.Resumed(), err)
+	}
+}
+
+func TestUsecase_Watch_RequiresBroker(t *testing.T) {
+	t.Parallel()
+
+	uc := New(&mockRepo{}, nil, zap.NewNop())
+	if _, err := uc.Watch(context.Background(), "user-1", ""); err == nil {
+		t.Error("expected error without broker, got nil")
+	}
+}
+
+// fakeSearchIndex は Apply されたイベントを記録し、Search では hits を Offset / Limit で切って返す SearchIndex
+type fakeSearchIndex struct {
+	applied []domain_todo.Event
+	hits    []domain_todo.SearchHit
+	queries []domain_todo.SearchQuery
+}
+
+func (x *fakeSearchIndex) Apply(ctx context.Context, events ...domain_todo.Event) error {
+	x.applied = append(x.applied, events...)
+	return nil
+}
+
+func (x *fakeSearchIndex) Search(ctx context.Context, ownerID string, q domain_todo.SearchQuery) ([]domain_todo.SearchHit, error) {
+	x.queries = append(x.queries, q)
+	hits := x.hits[min(q.Offset, len(x.hits)):]
+	return hits[:min(q.Limit, len(hits))], nil
+}
+
+func TestUsecase_Search_Paging(t *testing.T) {
+	t.Parallel()
+
+	idx := &fakeSearchIndex{}
+	for i := int64(5); i > 0; i-- {
+		idx.hits = append(idx.hits, domain_todo.SearchHit{Todo: &domain_todo.Todo{ID: i, Title: "牛乳 " + strconv.FormatInt(i, 10)}, Score: float64(i)})
+	}
+	uc := New(&mockRepo{}, nil, zap.NewNop(), WithSearchIndex(idx))
+	ctx := context.Background()
+
+	var ids []int64
+	token := ""
+	for page := 0; ; page++ {
+		res, err := uc.Search(ctx, "user-1", SearchParams{Query: "牛乳", PageSize: 2, PageToken: token})
+		if err != nil {
+			t.Fatalf("page %d: Search returned error: %v", page, err)
+		}
+		for _, m := range res.Matches {
+			ids = append(ids, m.Todo.ID)
+			if len(m.Highlights) != 1 || m.Highlights[0].Field != "title" {
+				t.Errorf("unexpected highlights: %#v", m.Highlights)
+			}
+		}
+		if res.NextPageToken == "" {
+			break
+		}
+		token = res.NextPageToken
+	}
+	if !slices.Equal(ids, []int64{5, 4, 3, 2, 1}) {
+		t.Errorf("expected [5 4 3 2 1], got %v", ids)
+	}
+	if !slices.Equal(idx.queries[0].Terms, []string{"牛乳"}) {
+		t.Errorf("unexpected terms: %q", idx.queries[0].Terms)
+	}
+
+	// クエリを変えたらトークンは使えない
+	if _, err := uc.Search(ctx, "user-1", SearchParams{Query: "パン", PageToken: token}); !errors.Is(err, ErrInvalidPageToken) {
+		t.Errorf("expected ErrInvalidPageToken, got %v", err)
+	}
+	if _, err := uc.Search(ctx, "user-1", SearchParams{Query: " "}); !errors.Is(err, ErrInvalidSearchQuery) {
+		t.Errorf("expected ErrInvalidSearchQuery, got %v", err)
+	}
+}
+
+func TestUsecase_Search_IndexFollowsCommittedWrites(t *testing.T) {
+	t.Parallel()
+
+	idx := &fakeSearchIndex{}
+	repo := &mockRepo{}
+	uc := New(repo, nil, zap.NewNop(), WithSearchIndex(idx))
+
+	if _, err := uc.Create(context.Background(), "user-1", CreateParams{Title: "牛乳"}); err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	if len(idx.applied) != 1 || idx.applied[0].Type != domain_todo.EventTypeCreated || idx.applied[0].Todo.Title != "牛乳" {
+		t.Errorf("unexpected applied events: %#v", idx.applied)
+	}
+
+	repo.appendHistoryFn = func(ctx context.Context, e *domain_todo.HistoryEntry) error {
+		return errors.New("disk full")
+	}
+	if _, err := uc.Create(context.Background(), "user-1", CreateParams{Title: "パン"}); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if len(idx.applied) != 1 {
+		t.Errorf("expected rolled back change not to be indexed, got %d events", len(idx.applied))
+	}
+}
+
+func TestUsecase_Import_Formats(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		format    TransferFormat
+		input     string
+		wantDone  []bool
+		wantLines []int // RowErrors の行番号
+	}{
+		{
+			name:   "json lines",
+			format: FormatJSONLines,
+			input: `{"title":"牛乳","done":true}` + "\n" +
+				"\n" +
+				`{"title":""}` + "\n" +
+				`{"title":"パン","priority":"urgent"}` + "\n" +
+				`not json` + "\n" +
+				`{"title":"卵","priority":"HIGH","recurrence":"FREQ=WEEKLY"}`,
+			wantDone:  []bool{true, false},
+			wantLines: []int{3, 4, 5},
+		},
+		{
+			name:   "csv",
+			format: FormatCSV,
+			input: "\ufeffTitle,done,priority\n" +
+				"牛乳,true,\n" +
+				"パン,maybe,low\n" +
+				"卵\n" +
+				"\"複数\n行\",false,medium\n",
+			wantDone:  []bool{true, false},
+			wantLines: []int{3, 4},
+		},
+		{
+			name:   "markdown",
+			format: FormatMarkdown,
+			input: "# 買い物\n" +
+				"- [x] 牛乳\n" +
+				"* [ ]   \n" +
+				"メモ\n" +
+				"  - [ ] パン\n",
+			wantDone:  []bool{true, false},
+			wantLines: []int{3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &mockRepo{
+				createManyFn: func(ctx context.Context, todos []*domain_todo.Todo) ([]*domain_todo.Todo, error) {
+					return nil, errors.New("dry run must not write")
+				},
+			}
+			uc := New(repo, &txCounter{}, zap.NewNop())
+
+			got, err := uc.Import(context.Background(), "user-1", strings.NewReader(tt.input), ImportParams{Format: tt.format, DryRun: true})
+			if err != nil {
+				t.Fatalf("Import returned error: %v", err)
+			}
+			if !got.DryRun || got.Created != len(tt.wantDone) || len(got.Preview) != len(tt.wantDone) {
+				t.Fatalf("expected %d todos to be created, got %+v", len(tt.wantDone), got)
+			}
+			for i, done := range tt.wantDone {
+				if got.Preview[i].Done != done || got.Preview[i].OwnerID != "user-1" {
+					t.Errorf("preview %d: unexpected todo %+v", i, got.Preview[i])
+				}
+			}
+			var lines []int
+			for _, e := range got.RowErrors {
+				lines = append(lines, e.Line)
+			}
+			if !slices.Equal(lines, tt.wantLines) || got.ErrorCount != len(tt.wantLines) {
+				t.Errorf("expected row errors at %v, got %v (count %d)", tt.wantLines, got.RowErrors, got.ErrorCount)
+			}
+		})
+	}
+}
+
+func TestUsecase_Import_BoundedTransactions(t *testing.T) {
+	t.Parallel()
+
+	var inserts []int
+	last := int64(0)
+	repo := &mockRepo{
+		lastPositionFn: func(ctx context.Context, ownerID string) (int64, error) {
+			return last, nil
+		},
+		createManyFn: func(ctx context.Context, todos []*domain_todo.Todo) ([]*domain_todo.Todo, error) {
+			if ctx.Value(inTxKey{}) == nil {
+				return nil, errors.New("import must write inside a tx")
+			}
+			inserts = append(inserts, len(todos))
+			last = todos[len(todos)-1].Position
+			return todos, nil
+		},
+	}
+	entries := historyRecorder(repo)
+	tx := &txCounter{}
+	uc := New(repo, tx, zap.NewNop())
+
+	var b strings.Builder
+	n := 2*ImportBatchSize + 50
+	for i := range n {
+		b.WriteString("- [ ] todo " + strconv.Itoa(i) + "\n")
+	}
+	got, err := uc.Import(context.Background(), "user-1", strings.NewReader(b.String()), ImportParams{Format: FormatMarkdown})
+	if err != nil {
+		t.Fatalf("Import returned error: %v", err)
+	}
+	if got.Created != n || got.Preview != nil {
+		t.Errorf("expected %d todos to be created, got %+v", n, got)
+	}
+	if tx.n != 3 || !slices.Equal(inserts, []int{ImportBatchSize, ImportBatchSize, 50}) {
+		t.Errorf("expected 3 txs of at most %d todos, got %d txs %v", ImportBatchSize, tx.n, inserts)
+	}
+	if len(*entries) != n {
+		t.Errorf("expected %d history entries, got %d", n, len(*entries))
+	}
+	// 並び順は Tx を跨いでも入力の順で末尾に続く
+	if last != int64(n)*domain_todo.PositionGap {
+		t.Errorf("expected last position %d, got %d", int64(n)*domain_todo.PositionGap, last)
+	}
+
+	// 途中の Tx が失敗したら、それまでに作成した件数を返す
+	repo.createManyFn = func(ctx context.Context, todos []*domain_todo.Todo) ([]*domain_todo.Todo, error) {
+		if len(inserts) == 4 {
+			return nil, errors.New("connection lost")
+		}
+		inserts = append(inserts, len(todos))
+		return todos, nil
+	}
+	got, err = uc.Import(context.Background(), "user-1", strings.NewReader(b.String()), ImportParams{Format: FormatMarkdown})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if got == nil || got.Created != ImportBatchSize {
+		t.Errorf("expected %d todos to be reported as created, got %+v", ImportBatchSize, got)
+	}
+}
+
+func TestUsecase_Import_Invalid(t *testing.T) {
+	t.Parallel()
+
+	uc := New(&mockRepo{}, nil, zap.NewNop())
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		format TransferFormat
+		input  string
+		want   error
+	}{
+		{"unspecified format", FormatUnspecified, "", ErrInvalidFormat},
+		{"csv without title column", FormatCSV, "done,notes\ntrue,x\n", ErrInvalidImportFile},
+		{"broken csv quote", FormatCSV, "title\n\"abc\n", ErrInvalidImportFile},
+		{"line too long", FormatJSONLines, strings.Repeat("a", maxImportLineLength+1), ErrInvalidImportFile},
+		{"too many rows", FormatMarkdown, strings.Repeat("- [ ] a\n", MaxImportRows+1), ErrImportTooLarge},
+	}
+	for _, tt := range tests {
+		got, err := uc.Import(ctx, "user-1", strings.NewReader(tt.input), ImportParams{Format: tt.format})
+		if !errors.Is(err, tt.want) || got != nil {
+			t.Errorf("%s: expected %v, got %v (%+v)", tt.name, tt.want, err, got)
+		}
+	}
+}
+
+func TestUsecase_Export_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	due := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Second)
+	past := time.Now().Add(-30 * 24 * time.Hour).UTC().Truncate(time.Second)
+	weekly, err := domain_todo.ParseRecurrence("FREQ=WEEKLY")
+	if err != nil {
+		t.Fatalf("ParseRecurrence returned error: %v", err)
+	}
+	todos := []*domain_todo.Todo{
+		{ID: 1, OwnerID: "user-1", Title: "牛乳, 2本", Done: true, Priority: domain_todo.PriorityHigh},
+		{ID: 2, OwnerID: "user-1", Title: "パン", DueAt: due, Notes: "朝\n\"食\"", Recurrence: weekly},
+		// 期限の過ぎたもの（期限切れ・完了済み）も戻せる
+		{ID: 3, OwnerID: "user-1", Title: "期限切れ", DueAt: past},
+		{ID: 4, OwnerID: "user-1", Title: "済んだ", Done: true, DueAt: past, Priority: domain_todo.PriorityLow},
+	}
+	repo := &mockRepo{
+		listFn: func(ctx context.Context, ownerID string, q domain_todo.ListQuery) ([]*domain_todo.Todo, error) {
+			if q.Filter.Deleted {
+				return nil, errors.New("export must not include deleted todos")
+			}
+			return todos, nil
+		},
+	}
+	uc := New(repo, nil, zap.NewNop())
+	ctx := context.Background()
+
+	for _, f := range []TransferFormat{FormatJSONLines, FormatCSV, FormatMarkdown} {
+		var b strings.Builder
+		n, err := uc.Export(ctx, "user-1", f, &b)
+		if err != nil || n != len(todos) {
+			t.Fatalf("format %d: Export returned %d, %v", f, n, err)
+		}
+
+		got, err := uc.Import(ctx, "user-1", strings.NewReader(b.String()), ImportParams{Format: f, DryRun: true})
+		if err != nil || got.ErrorCount != 0 || len(got.Preview) != len(todos) {
+			t.Fatalf("format %d: Import returned %+v, %v\n%s", f, got, err, b.String())
+		}
+		for i, want := range todos {
+			p := got.Preview[i]
+			if p.Title != want.Title || p.Done != want.Done {
+				t.Errorf("format %d: todo %d: expected %q done=%v, got %q done=%v", f, i, want.Title, want.Done, p.Title, p.Done)
+			}
+			// Markdown はタイトルと完了状態だけ
+			if f != FormatMarkdown && (!p.DueAt.Equal(want.DueAt) || p.Priority != want.Priority || p.Notes != want.Notes || p.Recurrence.String() != want.Recurrence.String()) {
+				t.Errorf("format %d: todo %d: expected %+v, got %+v", f, i, want, p)
+			}
+		}
+	}
+
+	if _, err := uc.Export(ctx, "user-1", FormatUnspecified, io.Discard); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("expected ErrInvalidFormat, got %v", err)
+	}
+}
+
+func TestUsecase_Export_CSVFormulaCells(t *testing.T) {
+	t.Parallel()
+
+	todos := []*domain_todo.Todo{
+		{ID: 1, OwnerID: "user-1", Title: "=HYPERLINK(\"http://example.com\")", Notes: "+1"},
+		{ID: 2, OwnerID: "user-1", Title: "-3", Notes: "@SUM(A1)"},
+		{ID: 3, OwnerID: "user-1", Title: "'=元から付いている", Notes: "'引用"},
+	}
+	repo := &mockRepo{
+		listFn: func(ctx context.Context, ownerID string, q domain_todo.ListQuery) ([]*domain_todo.Todo, error) {
+			return todos, nil
+		},
+	}
+	uc := New(repo, nil, zap.NewNop())
+	ctx := context.Background()
+
+	var b strings.Builder
+	if _, err := uc.Export(ctx, "user-1", FormatCSV, &b); err != nil {
+		t.Fatalf("Export returned error: %v", err)
+	}
+	records, err := csv.NewReader(strings.NewReader(b.String())).ReadAll()
+	if err != nil {
+		t.Fatalf("failed to read exported csv: %v\n%s", err, b.String())
+	}
+	for _, rec := range records[1:] {
+		for _, cell := range []string{rec[0], rec[4]} {
+			if !strings.HasPrefix(cell, "'") {
+				t.Errorf("expected %q to be escaped", cell)
+			}
+		}
+	}
+
+	got, err := uc.Import(ctx, "user-1", strings.NewReader(b.String()), ImportParams{Format: FormatCSV, DryRun: true})
+	if err != nil || got.ErrorCount != 0 || len(got.Preview) != len(todos) {
+		t.Fatalf("Import returned %+v, %v\n%s", got, err, b.String())
+	}
+	for i, want := range todos {
+		if p := got.Preview[i]; p.Title != want.Title || p.Notes != want.Notes {
+			t.Errorf("todo %d: expected %q / %q, got %q / %q", i, want.Title, want.Notes, p.Title, p.Notes)
+		}
+	}
+}
+
+func TestUsecase_Export_ICalendar(t *testing.T) {
+	t.Parallel()
+
+	due := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Second)
+	updated := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	weekly, err := domain_todo.ParseRecurrence("FREQ=WEEKLY")
+	if err != nil {
+		t.Fatalf("ParseRecurrence returned error: %v", err)
+	}
+	todos := []*domain_todo.Todo{
+		{ID: 1, OwnerID: "user-1", Title: "期限なし"},
+		{ID: 2, OwnerID: "user-1", Title: "牛乳; パン, 卵", Done: true, DueAt: due, Priority: domain_todo.PriorityHigh, UpdatedAt: updated,
+			Notes: strings.Repeat("とても長いメモ", 10) + "\n2 行目 \\ 終わり"},
+		{ID: 3, OwnerID: "user-1", Title: "ゴミ出し", DueAt: due, Recurrence: weekly},
+	}
+	repo := &mockRepo{
+		listFn: func(ctx context.Context, ownerID string, q domain_todo.ListQuery) ([]*domain_todo.Todo, error) {
+			return todos, nil
+		},
+	}
+	uc := New(repo, nil, zap.NewNop())
+	ctx := context.Background()
+
+	var b strings.Builder
+	n, err := uc.Export(ctx, "user-1", FormatICalendar, &b)
+	if err != nil || n != 2 {
+		t.Fatalf("expected only the todos with a due date, got %d, %v", n, err)
+	}
+	out := b.String()
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\nVERSION:2.0\r\n",
+		"UID:2@" + icalUIDDomain + "\r\n",
+		`SUMMARY:牛乳\; パン\, 卵` + "\r\n",
+		"STATUS:COMPLETED\r\n",
+		"DUE:" + due.Format(icalUTCLayout) + "\r\n",
+		"LAST-MODIFIED:20250102T030405Z\r\n",
+		"PRIORITY:1\r\n",
+		// 繰り返す Todo は期限を DTSTART にも入れる
+		"DTSTART:" + due.Format(icalUTCLayout) + "\r\nDUE:" + due.Format(icalUTCLayout) + "\r\n",
+		"RRULE:FREQ=WEEKLY\r\n",
+		"END:VTODO\r\nEND:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in\n%s", want, out)
+		}
+	}
+	for _, line := range strings.Split(out, "\r\n") {
+		if len(line) > icalLineLimit || !utf8.ValidString(line) {
+			t.Errorf("line must be folded at %d octets on a character boundary: %q", icalLineLimit, line)
+		}
+	}
+
+	if c := strings.Count(out, "DTSTART:"); c != 1 {
+		t.Errorf("expected DTSTART only on the recurring todo, got %d", c)
+	}
+
+	got, err := uc.Import(ctx, "user-1", strings.NewReader(out), ImportParams{Format: FormatICalendar, DryRun: true})
+	if err != nil || got.ErrorCount != 0 || len(got.Preview) != 2 {
+		t.Fatalf("Import returned %+v, %v", got, err)
+	}
+	if p, want := got.Preview[0], todos[1]; p.Title != want.Title || p.Notes != want.Notes || !p.Done || !p.DueAt.Equal(due) || p.Priority != want.Priority {
+		t.Errorf("expected %+v, got %+v", want, p)
+	}
+	if p := got.Preview[1]; !p.DueAt.Equal(due) || p.Recurrence.String() != "FREQ=WEEKLY" {
+		t.Errorf("expected a weekly todo due %v, got %+v", due, p)
+	}
+}
+
+func TestUsecase_Import_ICalendar(t *testing.T) {
+	t.Parallel()
+
+	uc := New(&mockRepo{}, nil, zap.NewNop())
+	input := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VTIMEZONE",
+		"TZID:Asia/Tokyo",
+		"END:VTIMEZONE",
+		"BEGIN:VEVENT",
+		"SUMMARY:予定は読まない",
+		"END:VEVENT",
+		"BEGIN:VTODO",
+		"UID:abc",
+		"SUMMARY:折り返",
+		" した行",
+		"DUE;TZID=\"Asia/Tokyo\":20990101T090000",
+		"PRIORITY:7",
+		"RRULE:FREQ=WEEKLY",
+		"BEGIN:VALARM",
+		"SUMMARY:通知は読まない",
+		"END:VALARM",
+		"END:VTODO",
+		"BEGIN:VTODO",
+		"SUMMARY:日付だけ",
+		"DUE;VALUE=DATE:20990102",
+		"STATUS:NEEDS-ACTION",
+		"END:VTODO",
+		"BEGIN:VTODO",
+		"SUMMARY:済んだ",
+		"DUE:20200301T000000Z",
+		"STATUS:COMPLETED",
+		"END:VTODO",
+		"BEGIN:VTODO",
+		"SUMMARY:不正な期限",
+		"DUE:tomorrow",
+		"END:VTODO",
+		"BEGIN:VTODO",
+		"DUE:20990101T000000Z",
+		"END:VTODO",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	got, err := uc.Import(context.Background(), "user-1", strings.NewReader(input), ImportParams{Format: FormatICalendar, DryRun: true})
+	if err != nil {
+		t.Fatalf("Import returned error: %v", err)
+	}
+	if len(got.Preview) != 3 {
+		t.Fatalf("expected 3 todos, got %+v", got)
+	}
+	first := got.Preview[0]
+	if first.Title != "折り返した行" || first.Priority != domain_todo.PriorityLow || first.Recurrence.String() != "FREQ=WEEKLY" {
+		t.Errorf("unexpected todo %+v", first)
+	}
+	if want := time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC); !first.DueAt.Equal(want) {
+		t.Errorf("expected due %v (09:00 in Asia/Tokyo), got %v", want, first.DueAt)
+	}
+	if second := got.Preview[1]; second.Title != "日付だけ" || !second.DueAt.Equal(time.Date(2099, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected todo %+v", second)
+	}
+	// カレンダーから書き出した完了済みの（期限の過ぎた）Todo も取り込める
+	if third := got.Preview[2]; third.Title != "済んだ" || !third.Done || !third.DueAt.Equal(time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected todo %+v", third)
+	}
+	var lines []int
+	for _, e := range got.RowErrors {
+		lines = append(lines, e.Line)
+	}
+	if want := []int{30, 34}; !slices.Equal(lines, want) {
+		t.Errorf("expected row errors at %v, got %v", want, got.RowErrors)
+	}
+
+	for _, bad := range []string{"SUMMARY:x\r\n", "BEGIN:VCALENDAR\r\nBEGIN:VTODO\r\nSUMMARY:x\r\n"} {
+		if _, err := uc.Import(context.Background(), "user-1", strings.NewReader(bad), ImportParams{Format: FormatICalendar}); !errors.Is(err, ErrInvalidImportFile) {
+			t.Errorf("%q: expected ErrInvalidImportFile, got %v", bad, err)
+		}
+	}
+}
+
+// fakeFeedTokenRepo はハッシュを所有者ごとに 1 つだけ持つ FeedTokenRepository
+type fakeFeedTokenRepo struct {
+	hashes map[string][]byte // ownerID -> hash
+}
+
+func (f *fakeFeedTokenRepo) SaveFeedToken(ctx context.Context, ownerID string, hash []byte) error {
+	f.hashes[ownerID] = hash
+	return nil
+}
+
+func (f *fakeFeedTokenRepo) DeleteFeedToken(ctx context.Context, ownerID string) (bool, error) {
+	_, ok := f.hashes[ownerID]
+	delete(f.hashes, ownerID)
+	return ok, nil
+}
+
+func (f *fakeFeedTokenRepo) FeedTokenOwner(ctx context.Context, hash []byte) (string, error) {
+	for owner, h := range f.hashes {
+		if slices.Equal(h, hash) {
+			return owner, nil
+		}
+	}
+	return "", domain_todo.ErrFeedTokenNotFound
+}
+
+func TestUsecase_CalendarFeed_FeedToken(t *testing.T) {
+	t.Parallel()
+
+	due := time.Now().Add(24 * time.Hour)
+	var listedOwner string
+	repo := &mockRepo{
+		listFn: func(ctx context.Context, ownerID string, q domain_todo.ListQuery) ([]*domain_todo.Todo, error) {
+			listedOwner = ownerID
+			return []*domain_todo.Todo{{ID: 1, OwnerID: ownerID, Title: "牛乳", DueAt: due}}, nil
+		},
+	}
+	tokens := &fakeFeedTokenRepo{hashes: map[string][]byte{}}
+	uc := New(repo, nil, zap.NewNop(), WithFeedTokenRepository(tokens))
+	ctx := context.Background()
+
+	first, err := uc.IssueFeedToken(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("IssueFeedToken returned error: %v", err)
+	}
+	if slices.Equal(tokens.hashes["user-1"], []byte(first)) {
+		t.Error("expected only the hash of the token to be stored")
+	}
+	token, err := uc.IssueFeedToken(ctx, "user-1")
+	if err != nil || token == first {
+		t.Fatalf("expected a new token, got %q, %v", token, err)
+	}
+
+	var b strings.Builder
+	if n, err := uc.CalendarFeed(ctx, token, &b); err != nil || n != 1 || listedOwner != "user-1" {
+		t.Fatalf("CalendarFeed returned %d, %v (owner %q)", n, err, listedOwner)
+	}
+	if !strings.Contains(b.String(), "SUMMARY:牛乳") {
+		t.Errorf("unexpected feed:\n%s", b.String())
+	}
+
+	// 発行し直す前のトークン・形の違うトークン・無効にしたトークンは使えない
+	for _, bad := range []string{first, "not-a-token", ""} {
+		if _, err := uc.CalendarFeed(ctx, bad, io.Discard); !errors.Is(err, ErrInvalidFeedToken) {
+			t.Errorf("%q: expected ErrInvalidFeedToken, got %v", bad, err)
+		}
+	}
+	if err := uc.RevokeFeedToken(ctx, "user-1"); err != nil {
+		t.Fatalf("RevokeFeedToken returned error: %v", err)
+	}
+	if _, err := uc.CalendarFeed(ctx, token, io.Discard); !errors.Is(err, ErrInvalidFeedToken) {
+		t.Errorf("expected ErrInvalidFeedToken after revoke, got %v", err)
+	}
+	if err := uc.RevokeFeedToken(ctx, "user-1"); !errors.Is(err, ErrFeedTokenNotFound) {
+		t.Errorf("expected ErrFeedTokenNotFound, got %v", err)
+	}
+}
+
+// fakeGrantRepo は共有を持つ GrantRepository。Todo とリストの所有者は todos / lists から引く。
+type fakeGrantRepo struct {
+	todos  map[int64]*domain_todo.Todo
+	lists  *mockListRepo
+	grants []*domain_todo.Grant
+}
+
+func (f *fakeGrantRepo) roleOf(granteeID string, r domain_todo.Resource) domain_todo.Role {
+	for _, g := range f.grants {
+		if g.Resource == r && g.GranteeID == granteeID {
+			return g.Role
+		}
+	}
+	return domain_todo.RoleNone
+}
+
+func (f *fakeGrantRepo) TodoAccess(ctx context.Context, granteeID string, todoID int64) (string, domain_todo.Role, error) {
+	t, ok := f.todos[todoID]
+	if !ok {
+		return "", domain_todo.RoleNone, domain_todo.ErrNotFound
+	}
+	role := f.roleOf(granteeID, domain_todo.Resource{Type: domain_todo.ResourceTodo, ID: todoID})
+	if t.ListID != 0 {
+		role = max(role, f.roleOf(granteeID, domain_todo.Resource{Type: domain_todo.ResourceList, ID: t.ListID}))
+	}
+	return t.OwnerID, role, nil
+}
+
+func (f *fakeGrantRepo) ListAccess(ctx context.Context, granteeID string, listID int64) (string, domain_todo.Role, error) {
+	l, ok := f.lists.lists[listID]
+	if !ok {
+		return "", domain_todo.RoleNone, domain_todo.ErrListNotFound
+	}
+	return l.OwnerID, f.roleOf(granteeID, domain_todo.Resource{Type: domain_todo.ResourceList, ID: listID}), nil
+}
+
+func (f *fakeGrantRepo) CreateGrant(ctx context.Context, g *domain_todo.Grant) error {
+	if f.roleOf(g.GranteeID, g.Resource) != domain_todo.RoleNone {
+		return domain_todo.ErrGrantAlreadyExists
+	}
+	f.grants = append(f.grants, g)
+	return nil
+}
+
+func (f *fakeGrantRepo) UpdateGrantRole(ctx context.Context, r domain_todo.Resource, granteeID string, role domain_todo.Role) (*domain_todo.Grant, error) {
+	for _, g := range f.grants {
+		if g.Resource == r && g.GranteeID == granteeID {
+			g.Role = role
+			return g, nil
+		}
+	}
+	return nil, domain_todo.ErrGrantNotFound
+}
+
+func (f *fakeGrantRepo) DeleteGrant(ctx context.Context, r domain_todo.Resource, granteeID string) (bool, error) {
+	n := len(f.grants)
+	f.grants = slices.DeleteFunc(f.grants, func(g *domain_todo.Grant) bool {
+		return g.Resource == r && g.GranteeID == granteeID
+	})
+	return len(f.grants) < n, nil
+}
+
+func (f *fakeGrantRepo) ListGrants(ctx context.Context, r domain_todo.Resource) ([]*domain_todo.Grant, error) {
+	var out []*domain_todo.Grant
+	for _, g := range f.grants {
+		if g.Resource == r {
+			out = append(out, g)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeGrantRepo) ListGrantsForGrantee(ctx context.Context, granteeID string) ([]*domain_todo.Grant, error) {
+	var out []*domain_todo.Grant
+	for _, g := range f.grants {
+		if g.GranteeID == granteeID {
+			out = append(out, g)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeGrantRepo) DeleteGrantsFor(ctx context.Context, rs ...domain_todo.Resource) error {
+	f.grants = slices.DeleteFunc(f.grants, func(g *domain_todo.Grant) bool {
+		return slices.Contains(rs, g.Resource)
+	})
+	return nil
+}
+
+// sharedFixture は user-2 のリスト 3 に入った Todo 1 と、それを共有された人たちを用意する。
+// viewer は Todo 1 の VIEWER、editor はリスト 3 の EDITOR、co-owner は Todo 1 の OWNER、stranger は何も無い。
+func sharedFixture(opts ...Option) (*mockRepo, *fakeGrantRepo, Usecase) {
+	todo := &domain_todo.Todo{ID: 1, OwnerID: "user-2", ListID: 3, Title: "共有", Version: 1}
+	lists := newMockListRepo()
+	grants := &fakeGrantRepo{
+		todos: map[int64]*domain_todo.Todo{1: todo},
+		lists: lists,
+		grants: []*domain_todo.Grant{
+			{Resource: domain_todo.Resource{Type: domain_todo.ResourceTodo, ID: 1}, OwnerID: "user-2", GranteeID: "viewer", Role: domain_todo.RoleViewer},
+			{Resource: domain_todo.Resource{Type: domain_todo.ResourceList, ID: 3}, OwnerID: "user-2", GranteeID: "editor", Role: domain_todo.RoleEditor},
+			{Resource: domain_todo.Resource{Type: domain_todo.ResourceTodo, ID: 1}, OwnerID: "user-2", GranteeID: "co-owner", Role: domain_todo.RoleOwner},
+		},
+	}
+	repo := &mockRepo{
+		// 所有者で絞った読み出し（他人の Todo は見えない）
+		getFn: func(ctx context.Context, ownerID string, id int64) (*domain_todo.Todo, error) {
+			if id != todo.ID || ownerID != todo.OwnerID {
+				return nil, domain_todo.ErrNotFound
+			}
+			cp := *todo
+			return &cp, nil
+		},
+	}
+	opts = append([]Option{WithTodoListRepository(lists), WithGrantRepository(grants)}, opts...)
+	uc := New(repo, nil, zap.NewNop(), opts...)
+	return repo, grants, uc
+}
+
+func TestUsecase_Sharing_Authorization(t *testing.T) {
+	t.Parallel()
+
+	title := "変更"
+	tests := []struct {
+		name string
+		call func(uc Usecase, caller string) error
+		// 呼び出し元ごとの期待するエラー（無ければ成功）
+		want map[string]error
+	}{
+		{
+			name: "Get",
+			call: func(uc Usecase, caller string) error {
+				_, err := uc.Get(context.Background(), caller, 1)
+				return err
+			},
+			want: map[string]error{"stranger": ErrNotFound},
+		},
+		{
+			name: "Update",
+			call: func(uc Usecase, caller string) error {
+				_, err := uc.Update(context.Background(), caller, 1, UpdateParams{Title: &title})
+				return err
+			},
+			want: map[string]error{"viewer": ErrPermissionDenied, "stranger": ErrNotFound},
+		},
+		{
+			name: "AddChecklistItem",
+			call: func(uc Usecase, caller string) error {
+				_, err := uc.AddChecklistItem(context.Background(), caller, 1, "項目", 0)
+				return err
+			},
+			want: map[string]error{"viewer": ErrPermissionDenied, "stranger": ErrNotFound},
+		},
+		{
+			name: "Delete",
+			call: func(uc Usecase, caller string) error {
+				return uc.Delete(context.Background(), caller, 1, 0)
+			},
+			want: map[string]error{"viewer": ErrPermissionDenied, "editor": ErrPermissionDenied, "stranger": ErrNotFound},
+		},
+		{
+			name: "MoveTodo",
+			call: func(uc Usecase, caller string) error {
+				_, err := uc.MoveTodo(context.Background(), caller, 1, MoveParams{ListID: 3})
+				return err
+			},
+			want: map[string]error{"viewer": ErrPermissionDenied, "editor": ErrPermissionDenied, "stranger": ErrNotFound},
+		},
+		{
+			name: "GetTodoList",
+			call: func(uc Usecase, caller string) error {
+				_, err := uc.GetTodoList(context.Background(), caller, 3)
+				return err
+			},
+			// Todo だけを共有された人にはリストは見えない
+			want: map[string]error{"viewer": ErrListNotFound, "co-owner": ErrListNotFound, "stranger": ErrListNotFound},
+		},
+		{
+			name: "UpdateTodoList",
+			call: func(uc Usecase, caller string) error {
+				_, err := uc.UpdateTodoList(context.Background(), caller, 3, TodoListUpdateParams{Name: &title})
+				return err
+			},
+			want: map[string]error{"viewer": ErrListNotFound, "editor": ErrPermissionDenied, "co-owner": ErrListNotFound, "stranger": ErrListNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		for _, caller := range []string{"user-2", "viewer", "editor", "co-owner", "stranger"} {
+			_, _, uc := sharedFixture()
+			if err := tt.call(uc, caller); !errors.Is(err, tt.want[caller]) {
+				t.Errorf("%s by %s: expected %v, got %v", tt.name, caller, tt.want[caller], err)
+			}
+		}
+	}
+}
+
+func TestUsecase_Sharing_ActsAsOwner(t *testing.T) {
+	t.Parallel()
+
+	repo, _, uc := sharedFixture()
+	var (
+		updatedOwner string
+		history      []*domain_todo.HistoryEntry
+		listedOwner  string
+	)
+	repo.updateFn = func(ctx context.Context, t *domain_todo.Todo) (*domain_todo.Todo, error) {
+		updatedOwner = t.OwnerID
+		return t, nil
+	}
+	repo.appendHistoryFn = func(ctx context.Context, e *domain_todo.HistoryEntry) error {
+		history = append(history, e)
+		return nil
+	}
+	repo.listFn = func(ctx context.Context, ownerID string, q domain_todo.ListQuery) ([]*domain_todo.Todo, error) {
+		listedOwner = ownerID
+		return nil, nil
+	}
+	ctx := context.Background()
+
+	// 共有された Todo は所有者の Todo のまま変わる（履歴も所有者のもの）
+	done := true
+	got, err := uc.Update(ctx, "editor", 1, UpdateParams{Done: &done})
+	if err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+	if got.OwnerID != "user-2" || updatedOwner != "user-2" {
+		t.Errorf("expected the todo to stay user-2's, got %q / %q", got.OwnerID, updatedOwner)
+	}
+	if len(history) != 1 || history[0].OwnerID != "user-2" {
+		t.Errorf("unexpected history: %+v", history)
+	}
+
+	// 共有されたリストに作る Todo はリストの所有者のもの
+	created, err := uc.Create(ctx, "editor", CreateParams{Title: "追加", ListID: 3})
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	if created.OwnerID != "user-2" || created.ListID != 3 {
+		t.Errorf("expected a todo of user-2 in list 3, got %q in %d", created.OwnerID, created.ListID)
+	}
+	if _, err := uc.Create(ctx, "viewer", CreateParams{Title: "追加", ListID: 3}); !errors.Is(err, ErrListNotFound) {
+		t.Errorf("expected ErrListNotFound, got %v", err)
+	}
+
+	// リストを指定した一覧は所有者の Todo から探すが、ページトークンは呼び出し元のもの
+	listID := int64(3)
+	if _, err := uc.List(ctx, "editor", ListParams{Filter: domain_todo.ListFilter{ListID: &listID}}); err != nil || listedOwner != "user-2" {
+		t.Errorf("List returned %v (owner %q)", err, listedOwner)
+	}
+	if _, err := uc.List(ctx, "viewer", ListParams{Filter: domain_todo.ListFilter{ListID: &listID}}); !errors.Is(err, ErrListNotFound) {
+		t.Errorf("expected ErrListNotFound, got %v", err)
+	}
+	// 自分の（存在しない）リストは今まで通り空の一覧
+	missing := int64(99)
+	if _, err := uc.List(ctx, "viewer", ListParams{Filter: domain_todo.ListFilter{ListID: &missing}}); err != nil || listedOwner != "viewer" {
+		t.Errorf("List returned %v (owner %q)", err, listedOwner)
+	}
+}
+
+func TestUsecase_Purge_DeletesGrants(t *testing.T) {
+	t.Parallel()
+
+	todoRes := domain_todo.Resource{Type: domain_todo.ResourceTodo, ID: 1}
+	listRes := domain_todo.Resource{Type: domain_todo.ResourceList, ID: 3}
+	ctx := context.Background()
+
+	// ゴミ箱から消した Todo への共有は消え、リストへの共有は残る
+	_, grants, uc := sharedFixture()
+	if err := uc.Purge(ctx, "co-owner", 1); err != nil {
+		t.Fatalf("Purge returned error: %v", err)
+	}
+	if got := grants.roleOf("viewer", todoRes); got != domain_todo.RoleNone {
+		t.Errorf("expected the todo grant to be deleted, got role %v", got)
+	}
+	if got := grants.roleOf("editor", listRes); got != domain_todo.RoleEditor {
+		t.Errorf("expected the list grant to stay, got role %v", got)
+	}
+
+	// 期限切れの一括削除でも消える
+	repo, grants, uc := sharedFixture()
+	repo.purgeDeletedBeforeFn = func(ctx context.Context, before time.Time, limit int) ([]*domain_todo.Todo, error) {
+		return []*domain_todo.Todo{{ID: 1, OwnerID: "user-2"}}, nil
+	}
+	if n, err := uc.PurgeExpired(ctx, time.Hour); err != nil || n != 1 {
+		t.Fatalf("PurgeExpired returned %d, %v", n, err)
+	}
+	if got := grants.roleOf("co-owner", todoRes); got != domain_todo.RoleNone {
+		t.Errorf("expected the todo grant to be deleted, got role %v", got)
+	}
+}
+
+func TestUsecase_Collaborators(t *testing.T) {
+	t.Parallel()
+
+	_, grants, uc := sharedFixture()
+	ctx := context.Background()
+	todo1 := domain_todo.Resource{Type: domain_todo.ResourceTodo, ID: 1}
+	list3 := domain_todo.Resource{Type: domain_todo.ResourceList, ID: 3}
+
+	g, err := uc.AddCollaborator(ctx, "user-2", list3, "newcomer", domain_todo.RoleViewer)
+	if err != nil {
+		t.Fatalf("AddCollaborator returned error: %v", err)
+	}
+	if g.OwnerID != "user-2" || g.GrantedBy != "user-2" || g.Role != domain_todo.RoleViewer {
+		t.Errorf("unexpected grant: %+v", g)
+	}
+	// OWNER で共有された人も共有できる（記録されるのは所有者と共有した人の両方）
+	if g, err := uc.AddCollaborator(ctx, "co-owner", todo1, "friend", domain_todo.RoleEditor); err != nil || g.OwnerID != "user-2" || g.GrantedBy != "co-owner" {
+		t.Errorf("AddCollaborator by co-owner returned %+v, %v", g, err)
+	}
+
+	errCases := []struct {
+		name    string
+		caller  string
+		r       domain_todo.Resource
+		grantee string
+		role    domain_todo.Role
+		want    error
+	}{
+		{"viewer cannot share", "viewer", todo1, "x", domain_todo.RoleViewer, ErrPermissionDenied},
+		{"editor cannot share", "editor", list3, "x", domain_todo.RoleViewer, ErrPermissionDenied},
+		{"stranger", "stranger", todo1, "x", domain_todo.RoleViewer, ErrNotFound},
+		{"missing list", "user-2", domain_todo.Resource{Type: domain_todo.ResourceList, ID: 99}, "x", domain_todo.RoleViewer, ErrListNotFound},
+		{"already shared", "user-2", list3, "newcomer", domain_todo.RoleEditor, ErrGrantAlreadyExists},
+		{"owner as grantee", "co-owner", todo1, "user-2", domain_todo.RoleViewer, ErrInvalidGrantee},
+		{"self as grantee", "user-2", todo1, "user-2", domain_todo.RoleViewer, ErrInvalidGrantee},
+		{"empty grantee", "user-2", todo1, "", domain_todo.RoleViewer, ErrInvalidGrantee},
+		{"invalid role", "user-2", todo1, "x", domain_todo.RoleNone, ErrInvalidRole},
+		{"invalid resource", "user-2", domain_todo.Resource{ID: 1}, "x", domain_todo.RoleViewer, ErrInvalidResource},
+		{"invalid id", "user-2", domain_todo.Resource{Type: domain_todo.ResourceTodo}, "x", domain_todo.RoleViewer, ErrInvalidID},
+	}
+	for _, tc := range errCases {
+		if _, err := uc.AddCollaborator(ctx, tc.caller, tc.r, tc.grantee, tc.role); !errors.Is(err, tc.want) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.want, err)
+		}
+	}
+
+	// 役割の変更
+	if g, err := uc.ChangeCollaboratorRole(ctx, "user-2", list3, "newcomer", domain_todo.RoleEditor); err != nil || g.Role != domain_todo.RoleEditor {
+		t.Errorf("ChangeCollaboratorRole returned %+v, %v", g, err)
+	}
+	if _, err := uc.ChangeCollaboratorRole(ctx, "editor", list3, "newcomer", domain_todo.RoleOwner); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied, got %v", err)
+	}
+	if _, err := uc.ChangeCollaboratorRole(ctx, "user-2", list3, "nobody", domain_todo.RoleEditor); !errors.Is(err, ErrGrantNotFound) {
+		t.Errorf("expected ErrGrantNotFound, got %v", err)
+	}
+
+	// 一覧は見える人なら誰でも
+	if got, err := uc.ListCollaborators(ctx, "viewer", todo1); err != nil || len(got) != 3 {
+		t.Errorf("ListCollaborators returned %d grants, %v", len(got), err)
+	}
+	if _, err := uc.ListCollaborators(ctx, "stranger", todo1); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	// 共有された本人は自分の分だけやめられる
+	if err := uc.RemoveCollaborator(ctx, "viewer", todo1, "friend"); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied, got %v", err)
+	}
+	if err := uc.RemoveCollaborator(ctx, "viewer", todo1, "viewer"); err != nil {
+		t.Fatalf("RemoveCollaborator returned error: %v", err)
+	}
+	if _, err := uc.Get(ctx, "viewer", 1); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound after leaving, got %v", err)
+	}
+	if err := uc.RemoveCollaborator(ctx, "user-2", todo1, "viewer"); !errors.Is(err, ErrGrantNotFound) {
+		t.Errorf("expected ErrGrantNotFound, got %v", err)
+	}
+
+	if got, err := uc.ListSharedWithMe(ctx, "newcomer"); err != nil || len(got) != 1 || got[0].Resource != list3 {
+		t.Errorf("ListSharedWithMe returned %+v, %v", got, err)
+	}
+	if len(grants.grants) != 4 {
+		t.Errorf("expected 4 grants left, got %d", len(grants.grants))
+	}
+}
+
+func TestUsecase_Sharing_NotConfigured(t *testing.T) {
+	t.Parallel()
+
+	uc := New(&mockRepo{}, nil, zap.NewNop())
+	r := domain_todo.Resource{Type: domain_todo.ResourceTodo, ID: 1}
+	if _, err := uc.AddCollaborator(context.Background(), "user-1", r, "user-2", domain_todo.RoleViewer); !errors.Is(err, errNoGrantRepository) {
+		t.Errorf("expected errNoGrantRepository, got %v", err)
+	}
+}
+
+// fakeUserDirectory は users に入っているユーザーだけがいるものとして答える
+type fakeUserDirectory struct {
+	users map[string]bool
+}
+
+func (d *fakeUserDirectory) UserExists(ctx context.Context, userID string) (bool, error) {
+	return d.users[userID], nil
+}
+
+func TestUsecase_AssignTodo(t *testing.T) {
+	t.Parallel()
+
+	var history []*domain_todo.HistoryEntry
+	stored := &domain_todo.Todo{ID: 1, OwnerID: "user-1", Title: "担当", Version: 1}
+	repo := &mockRepo{
+		getFn: func(ctx context.Context, ownerID string, id int64) (*domain_todo.Todo, error) {
+			cp := *stored
+			return &cp, nil
+		},
+		updateFn: func(ctx context.Context, t *domain_todo.Todo) (*domain_todo.Todo, error) {
+			stored = t
+			return t, nil
+		},
+		appendHistoryFn: func(ctx context.Context, e *domain_todo.HistoryEntry) error {
+			history = append(history, e)
+			return nil
+		},
+	}
+	users := &fakeUserDirectory{users: map[string]bool{"user-1": true, "user-2": true}}
+	uc := New(repo, nil, zap.NewNop(), WithUserDirectory(users))
+	ctx := context.Background()
+
+	got, err := uc.AssignTodo(ctx, "user-1", 1, "user-1", 0)
+	if err != nil {
+		t.Fatalf("AssignTodo returned error: %v", err)
+	}
+	if got.AssigneeID != "user-1" || got.OwnerID != "user-1" {
+		t.Errorf("expected user-1's todo assigned to user-1, got owner %q / assignee %q", got.OwnerID, got.AssigneeID)
+	}
+	// 履歴に担当者の変更が残る
+	if len(history) != 1 || history[0].Before.AssigneeID != "" || history[0].After.AssigneeID != "user-1" {
+		t.Errorf("unexpected history: %+v", history)
+	}
+
+	got, err = uc.UnassignTodo(ctx, "user-1", 1, 0)
+	if err != nil {
+		t.Fatalf("UnassignTodo returned error: %v", err)
+	}
+	if got.IsAssigned() {
+		t.Errorf("expected the todo to be unassigned, got %q", got.AssigneeID)
+	}
+	if len(history) != 2 || history[1].Before.AssigneeID != "user-1" || history[1].After.AssigneeID != "" {
+		t.Errorf("unexpected history: %+v", history)
+	}
+
+	tests := []struct {
+		name     string
+		assignee string
+		want     error
+	}{
+		{name: "empty", assignee: "", want: ErrInvalidAssignee},
+		{name: "unknown user", assignee: "nobody", want: ErrAssigneeNotFound},
+		// 共有していなければ、ユーザーとしていても割り当てられない
+		{name: "not a collaborator", assignee: "user-2", want: ErrAssigneeNotFound},
+	}
+	for _, tt := range tests {
+		if _, err := uc.AssignTodo(ctx, "user-1", 1, tt.assignee, 0); !errors.Is(err, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, err)
+		}
+	}
+	if len(history) != 2 {
+		t.Errorf("expected no history for rejected assignments, got %d entries", len(history))
+	}
+
+	// ユーザーのディレクトリが無ければ割り当てられない（外すのはできる）
+	uc = New(repo, nil, zap.NewNop())
+	if _, err := uc.AssignTodo(ctx, "user-1", 1, "user-2", 0); !errors.Is(err, errNoUserDirectory) {
+		t.Errorf("expected errNoUserDirectory, got %v", err)
+	}
+	if _, err := uc.UnassignTodo(ctx, "user-1", 1, 0); err != nil {
+		t.Errorf("UnassignTodo returned error: %v", err)
+	}
+}
+
+func TestUsecase_AssignTodo_Sharing(t *testing.T) {
+	t.Parallel()
+
+	repo, _, uc := sharedFixture(WithUserDirectory(&fakeUserDirectory{users: map[string]bool{"user-2": true, "viewer": true, "editor": true, "stranger": true}}))
+	var updated *domain_todo.Todo
+	repo.updateFn = func(ctx context.Context, t *domain_todo.Todo) (*domain_todo.Todo, error) {
+		updated = t
+		return t, nil
+	}
+	ctx := context.Background()
+
+	// 担当者の割り当ては Todo の中身の変更なので、EDITOR 以上で共有された人ができる
+	if _, err := uc.AssignTodo(ctx, "viewer", 1, "viewer", 0); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied, got %v", err)
+	}
+	if _, err := uc.AssignTodo(ctx, "stranger", 1, "viewer", 0); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if _, err := uc.AssignTodo(ctx, "editor", 1, "viewer", 0); err != nil {
+		t.Fatalf("AssignTodo returned error: %v", err)
+	}
+	if updated == nil || updated.OwnerID != "user-2" || updated.AssigneeID != "viewer" {
+		t.Errorf("expected user-2's todo assigned to viewer, got %+v", updated)
+	}
+
+	// 担当者にできるのは所有者とコラボレーター（ユーザーとしていること）だけ
+	tests := []struct {
+		assignee string
+		want     error
+	}{
+		{assignee: "user-2"},
+		{assignee: "editor"},
+		{assignee: "stranger", want: ErrAssigneeNotFound},
+		{assignee: "co-owner", want: ErrAssigneeNotFound},
+	}
+	for _, tt := range tests {
+		if _, err := uc.AssignTodo(ctx, "editor", 1, tt.assignee, 0); !errors.Is(err, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.assignee, tt.want, err)
+		}
+	}
+	// 見えない Todo では、担当者の候補がいるかどうかも分からない
+	if _, err := uc.AssignTodo(ctx, "stranger", 1, "nobody", 0); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestUsecase_List_AssignedToMe(t *testing.T) {
+	t.Parallel()
+
+	repo, _, uc := sharedFixture()
+	var (
+		listedOwner string
+		listedQuery domain_todo.ListQuery
+	)
+	repo.listFn = func(ctx context.Context, ownerID string, q domain_todo.ListQuery) ([]*domain_todo.Todo, error) {
+		listedOwner, listedQuery = ownerID, q
+		return nil, nil
+	}
+	ctx := context.Background()
+
+	// 担当での絞り込みは、共有されたリストを指定しても呼び出し元として探す（見えるかどうかは repo で絞る）
+	listID := int64(3)
+	if _, err := uc.List(ctx, "editor", ListParams{Filter: domain_todo.ListFilter{ListID: &listID, AssignedToMe: true}}); err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if listedOwner != "editor" || !listedQuery.Filter.AssignedToMe {
+		t.Errorf("expected an assigned-to-me query as editor, got %q (%+v)", listedOwner, listedQuery.Filter)
+	}
+
+	// ゴミ箱は自分の Todo だけなので組み合わせられない
+	if _, err := uc.ListDeleted(ctx, "editor", ListParams{Filter: domain_todo.ListFilter{AssignedToMe: true}}); !errors.Is(err, ErrInvalidFilter) {
+		t.Errorf("expected ErrInvalidFilter, got %v", err)
+	}
+}
+
+// fakeCommentRepo はコメントをメモリに持つ（ID は 1 から連番）
+type fakeCommentRepo struct {
+	comments []*domain_todo.Comment
+	nextID   int64
+}
+
+func (r *fakeCommentRepo) CreateComment(ctx context.Context, c *domain_todo.Comment) error {
+	r.nextID++
+	c.ID = r.nextID
+	c.CreatedAt = time.Now()
+	c.UpdatedAt = c.CreatedAt
+	cp := *c
+	r.comments = append(r.comments, &cp)
+	return nil
+}
+
+func (r *fakeCommentRepo) GetComment(ctx context.Context, todoID, id int64) (*domain_todo.Comment, error) {
+	for _, c := range r.comments {
+		if c.TodoID == todoID && c.ID == id {
+			cp := *c
+			return &cp, nil
+		}
+	}
+	return nil, domain_todo.ErrCommentNotFound
+}
+
+func (r *fakeCommentRepo) UpdateComment(ctx context.Context, c *domain_todo.Comment) error {
+	for i, stored := range r.comments {
+		if stored.TodoID == c.TodoID && stored.ID == c.ID {
+			// DB と同じく秒精度なので、作成と同じ秒の編集では時刻が変わらない
+			c.UpdatedAt = stored.CreatedAt
+			cp := *c
+			r.comments[i] = &cp
+			return nil
+		}
+	}
+	return domain_todo.ErrCommentNotFound
+}
+
+func (r *fakeCommentRepo) DeleteComment(ctx context.Context, todoID, id int64) (bool, error) {
+	for i, c := range r.comments {
+		if c.TodoID == todoID && c.ID == id {
+			r.comments = append(r.comments[:i], r.comments[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeCommentRepo) ListComments(ctx context.Context, todoID int64, q domain_todo.CommentQuery) ([]*domain_todo.Comment, error) {
+	var out []*domain_todo.Comment
+	for _, c := range r.comments {
+		if c.TodoID == todoID && c.ID > q.AfterID && (q.Limit <= 0 || len(out) < q.Limit) {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+func TestUsecase_Comments(t *testing.T) {
+	t.Parallel()
+
+	comments := &fakeCommentRepo{}
+	_, grants, uc := sharedFixture(WithCommentRepository(comments))
+	ctx := context.Background()
+
+	// 書けるのは所有者と EDITOR 以上。VIEWER は読むだけ
+	if _, err := uc.AddComment(ctx, "viewer", 1, "見ました"); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied, got %v", err)
+	}
+	if _, err := uc.AddComment(ctx, "stranger", 1, "こんにちは"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if _, err := uc.AddComment(ctx, "editor", 1, "  "); !errors.Is(err, ErrEmptyComment) {
+		t.Errorf("expected ErrEmptyComment, got %v", err)
+	}
+	byEditor, err := uc.AddComment(ctx, "editor", 1, "やります")
+	if err != nil {
+		t.Fatalf("AddComment returned error: %v", err)
+	}
+	if byEditor.AuthorID != "editor" || byEditor.TodoID != 1 {
+		t.Errorf("unexpected comment: %+v", byEditor)
+	}
+	byOwner, err := uc.AddComment(ctx, "user-2", 1, "お願いします")
+	if err != nil {
+		t.Fatalf("AddComment returned error: %v", err)
+	}
+
+	// 編集は書いた本人だけ
+	if _, err := uc.EditComment(ctx, "user-2", 1, byEditor.ID, "書き換え"); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied, got %v", err)
+	}
+	edited, err := uc.EditComment(ctx, "editor", 1, byEditor.ID, "明日やります")
+	if err != nil {
+		t.Fatalf("EditComment returned error: %v", err)
+	}
+	if edited.Body != "明日やります" || !edited.Edited {
+		t.Errorf("unexpected edited comment: %+v", edited)
+	}
+	if _, err := uc.EditComment(ctx, "editor", 1, 99, "無い"); !errors.Is(err, ErrCommentNotFound) {
+		t.Errorf("expected ErrCommentNotFound, got %v", err)
+	}
+
+	// 削除は本人と Todo の OWNER。EDITOR は他人のコメントを消せない
+	if err := uc.DeleteComment(ctx, "editor", 1, byOwner.ID); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied, got %v", err)
+	}
+	if err := uc.DeleteComment(ctx, "co-owner", 1, byEditor.ID); err != nil {
+		t.Errorf("DeleteComment by co-owner returned error: %v", err)
+	}
+	if err := uc.DeleteComment(ctx, "user-2", 1, byOwner.ID); err != nil {
+		t.Errorf("DeleteComment by author returned error: %v", err)
+	}
+
+	// VIEWER に下げられた人は、自分のコメントを直せないが消せる
+	again, err := uc.AddComment(ctx, "editor", 1, "やっぱりやります")
+	if err != nil {
+		t.Fatalf("AddComment returned error: %v", err)
+	}
+	for _, g := range grants.grants {
+		if g.GranteeID == "editor" {
+			g.Role = domain_todo.RoleViewer
+		}
+	}
+	if _, err := uc.EditComment(ctx, "editor", 1, again.ID, "やめます"); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("expected ErrPermissionDenied, got %v", err)
+	}
+	if err := uc.DeleteComment(ctx, "editor", 1, again.ID); err != nil {
+		t.Errorf("DeleteComment by downgraded author returned error: %v", err)
+	}
+	if len(comments.comments) != 0 {
+		t.Errorf("expected all comments to be deleted, got %d", len(comments.comments))
+	}
+}
+
+func TestUsecase_ListComments_Paging(t *testing.T) {
+	t.Parallel()
+
+	comments := &fakeCommentRepo{}
+	_, _, uc := sharedFixture(WithCommentRepository(comments))
+	ctx := context.Background()
+	for i := range 5 {
+		if _, err := uc.AddComment(ctx, "user-2", 1, "コメント "+strconv.Itoa(i)); err != nil {
+			t.Fatalf("AddComment returned error: %v", err)
+		}
+	}
+
+	// VIEWER も読める（古い順）
+	var (
+		got   []int64
+		token string
+	)
+	for {
+		res, err := uc.ListComments(ctx, "viewer", 1, CommentListParams{PageSize: 2, PageToken: token})
+		if err != nil {
+			t.Fatalf("ListComments returned error: %v", err)
+		}
+		for _, c := range res.Comments {
+			got = append(got, c.ID)
+		}
+		if res.NextPageToken == "" {
+			break
+		}
+		token = res.NextPageToken
+	}
+	if !slices.Equal(got, []int64{1, 2, 3, 4, 5}) {
+		t.Errorf("unexpected comments: %v", got)
+	}
+
+	// 他の人のトークンは使えず、見えない Todo のコメントは NotFound
+	res, err := uc.ListComments(ctx, "viewer", 1, CommentListParams{PageSize: 2})
+	if err != nil {
+		t.Fatalf("ListComments returned error: %v", err)
+	}
+	if _, err := uc.ListComments(ctx, "editor", 1, CommentListParams{PageToken: res.NextPageToken}); !errors.Is(err, ErrInvalidPageToken) {
+		t.Errorf("expected ErrInvalidPageToken, got %v", err)
+	}
+	if _, err := uc.ListComments(ctx, "stranger", 1, CommentListParams{}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	uc = New(&mockRepo{}, nil, zap.NewNop())
+	if _, err := uc.ListComments(ctx, "user-1", 1, CommentListParams{}); !errors.Is(err, errNoCommentRepository) {
+		t.Errorf("expected errNoCommentRepository, got %v", err)
+	}
+}
@@ -0,0 +1,201 @@
+package todo_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	domain_todo "github.com/hijjiri/grpc-echo/internal/domain/todo"
+	"go.uber.org/zap"
+)
+
+// 変更の購読（Watch）。
+// 変更履歴を追記するときに同じ内容のイベントを Tx ごとの outbox に積み、コミットできたら EventBroker に流す
+// （ロールバックした・デッドロックでやり直した試行のイベントは捨てる）。
+// ブローカーはプロセス内なので、別のレプリカで起きた変更は届かない。
+
+var (
+	ErrInvalidResumeToken = errors.New("invalid resume token")
+	ErrSlowSubscriber     = domain_todo.ErrSlowSubscriber
+)
+
+// errNoEventBroker は WithEventBroker を渡さずに New したときのエラー（設定ミスなので Internal 扱い）
+var errNoEventBroker = errors.New("event broker is not configured")
+
+// resumeTokenPrefix は再開トークンの Query に付ける接頭辞（page_token と取り違えないため）
+const resumeTokenPrefix = "watch:"
+
+// WatchEvent は Watcher.Next が返す 1 件の変更。
+type WatchEvent struct {
+	Type        domain_todo.EventType
+	Todo        *domain_todo.Todo // 削除では削除前の Todo
+	ResumeToken string            // このイベントまで受け取ったことを表す再開トークン
+}
+
+// Watcher は 1 クライアント分の購読。使い終わったら Close すること。
+type Watcher struct {
+	sub       domain_todo.EventSubscription
+	ownerID   string
+	resumed   bool
+	epoch     string
+	codec     pageTokenCodec
+	lastToken string
+}
+
+// Resumed は再開トークンの続きから流しているかどうか。
+// false なら（トークンが無い・古すぎる）、購読を始めた時点の一覧をクライアントに送り直すこと。
+func (w *Watcher) Resumed() bool {
+	return w.resumed
+}
+
+// ResumeToken は最後に Next で返したイベント（まだ無ければ購読を始めた位置）の再開トークン。
+func (w *Watcher) ResumeToken() string {
+	return w.lastToken
+}
+
+// Next は次の変更を待って返す。ブローカーに切られたら ErrSlowSubscriber（ResumeToken から再開できる）。
+func (w *Watcher) Next(ctx context.Context) (*WatchEvent, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case ev, ok := <-w.sub.Events():
+		if !ok {
+			if err := w.sub.Err(); err != nil {
+				return nil, err
+			}
+			return nil, context.Canceled
+		}
+		token, err := w.encodeToken(ev.Seq)
+		if err != nil {
+			return nil, err
+		}
+		w.lastToken = token
+		return &WatchEvent{Type: ev.Type, Todo: ev.Todo, ResumeToken: token}, nil
+	}
+}
+
+func (w *Watcher) Close() {
+	w.sub.Close()
+}
+
+// encodeToken は seq まで受け取ったことを表す再開トークンを作る（page_token と同じ署名付きの形式）。
+func (w *Watcher) encodeToken(seq uint64) (string, error) {
+	token, err := w.codec.encode(pageCursor{OwnerID: w.ownerID, Query: resumeTokenPrefix + w.epoch, AfterID: int64(seq)})
+	if err != nil {
+		return "", fmt.Errorf("encode resume token: %w", err)
+	}
+	return token, nil
+}
+
+func (u *usecase) Watch(ctx context.Context, ownerID string, resumeToken string) (*Watcher, error) {
+	if err := domain_todo.ValidateOwnerID(ownerID); err != nil {
+		return nil, ErrEmptyOwner
+	}
+	if u.broker == nil {
+		return nil, errNoEventBroker
+	}
+
+	var after *domain_todo.EventCursor
+	if resumeToken != "" {
+		cur, err := u.pageToken.decode(resumeToken)
+		if err != nil || cur.OwnerID != ownerID || cur.AfterID < 0 {
+			return nil, ErrInvalidResumeToken
+		}
+		epoch, ok := strings.CutPrefix(cur.Query, resumeTokenPrefix)
+		if !ok || epoch == "" {
+			return nil, ErrInvalidResumeToken
+		}
+		after = &domain_todo.EventCursor{Epoch: epoch, Seq: uint64(cur.AfterID)}
+	}
+
+	sub, err := u.broker.Subscribe(ownerID, after)
+	if errors.Is(err, domain_todo.ErrEventsExpired) {
+		// 再起動した・間が空きすぎた。最初から（一覧を送り直して）やり直してもらう
+		u.logger.Info("watch resume token expired, restarting from snapshot (usecase)",
+			zap.String("owner_id", ownerID),
+		)
+		after = nil
+		sub, err = u.broker.Subscribe(ownerID, nil)
+	}
+	if err != nil {
+		u.logger.Error("failed to subscribe todo events",
+			zap.String("owner_id", ownerID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	start := sub.Start()
+	w := &Watcher{sub: sub, ownerID: ownerID, resumed: after != nil, epoch: start.Epoch, codec: u.pageToken}
+	if w.lastToken, err = w.encodeToken(start.Seq); err != nil {
+		sub.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+// --------- コミット後の配信 ---------
+
+type outboxKey struct{}
+
+// outbox は 1 回の Tx の試行で積んだイベント。
+type outbox struct {
+	events []domain_todo.Event
+}
+
+// emitEvent は履歴と同じ変更をイベントとして outbox に積む（ブローカー・検索索引が無ければ何もしない）。WithinTx の中で呼ぶ。
+func emitEvent(txCtx context.Context, action domain_todo.HistoryAction, ownerID string, before, after *domain_todo.Todo) {
+	box, ok := txCtx.Value(outboxKey{}).(*outbox)
+	if !ok {
+		return
+	}
+	ev := domain_todo.Event{OwnerID: ownerID}
+	switch action {
+	case domain_todo.HistoryActionCreate, domain_todo.HistoryActionRestore:
+		ev.Type, ev.Todo = domain_todo.EventTypeCreated, after
+	case domain_todo.HistoryActionUpdate:
+		ev.Type, ev.Todo = domain_todo.EventTypeUpdated, after
+	case domain_todo.HistoryActionDelete:
+		ev.Type, ev.Todo = domain_todo.EventTypeDeleted, before
+	default:
+		// 物理削除はゴミ箱の中の Todo なので、購読者にも検索にも関係ない
+		return
+	}
+	box.events = append(box.events, ev)
+}
+
+// publishingTxManager は、Tx がコミットできたときだけ、その中で積んだイベントを
+// ブローカーに流し、検索索引に反映する TxManager。
+type publishingTxManager struct {
+	inner       TxManager
+	broker      domain_todo.EventBroker // nil なら流さない
+	searchIndex domain_todo.SearchIndex // nil なら反映しない
+	logger      *zap.Logger
+}
+
+func (m *publishingTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	var box *outbox
+	err := m.inner.WithinTx(ctx, func(txCtx context.Context) error {
+		// デッドロックでやり直すたびに fn が呼ばれるので、試行ごとに空の outbox から始める
+		box = &outbox{}
+		return fn(context.WithValue(txCtx, outboxKey{}, box))
+	})
+	if err != nil || box == nil || len(box.events) == 0 {
+		return err
+	}
+
+	if m.searchIndex != nil {
+		// 変更はコミット済みなので、索引に反映できなくても呼び出し元には成功を返す（索引が遅れるだけ）
+		if err := m.searchIndex.Apply(context.WithoutCancel(ctx), box.events...); err != nil {
+			m.logger.Error("failed to apply changes to search index",
+				zap.Int("events", len(box.events)),
+				zap.Error(err),
+			)
+		}
+	}
+	if m.broker != nil {
+		m.broker.Publish(box.events...)
+	}
+	return nil
+}